@@ -12,7 +12,13 @@ import (
 	"space/internal/config"
 
 	"space/internal/adapters/input/http"
+	"space/internal/adapters/input/scheduler"
 	"space/internal/adapters/output"
+	"space/internal/adapters/output/cache"
+	"space/internal/adapters/output/changelog"
+	"space/internal/adapters/output/copyjob"
+	"space/internal/adapters/output/snapshot"
+	"space/internal/adapters/output/sqlite"
 	"space/internal/core/service"
 )
 
@@ -63,8 +69,26 @@ func main() {
 	log.Println("Creating Connection Manager...")
 	connManager := output.NewConnectionManager()
 
+	log.Println("Creating Federation Engine...")
+	federationEngine := sqlite.NewFederationEngine(cfg.Federation.ToDomain())
+
+	log.Println("Creating Result Cache...")
+	resultCache := cache.NewMemoryCache(cfg.Cache.MaxEntries, cfg.Cache.GetMaxBytes())
+
+	log.Println("Creating Metadata Cache...")
+	metadataCache := cache.NewMetadataCache(cfg.Metadata.GetTTL(), cfg.Metadata.MaxEntries, cfg.Metadata.GetMaxBytes())
+
+	log.Println("Creating Snapshot Store...")
+	snapshotStore := snapshot.NewFileStore(cfg.Snapshots.Directory, cfg.Snapshots.MaxVersions)
+
+	log.Println("Creating Change Log Store...")
+	changeLogStore := changelog.NewFileStore(cfg.ChangeLog.Directory)
+
+	log.Println("Creating Copy Job Store...")
+	copyJobStore := copyjob.NewFileStore(cfg.CopyJobs.Directory)
+
 	log.Println("Creating Database Service...")
-	dbService := service.NewDatabaseService(connManager)
+	dbService := service.NewDatabaseService(connManager, federationEngine, resultCache, snapshotStore, metadataCache, changeLogStore, copyJobStore)
 
 	log.Println("Creating HTTP Handler...")
 	handler := http.NewHandler(dbService)
@@ -112,6 +136,21 @@ func main() {
 		cancel()
 	}
 
+	// 스키마 스냅샷 스케줄러 (DB 연결이 끝난 뒤 시작)
+	// 종료 시그널을 받으면 snapshotCancel로 멈춥니다.
+	snapshotCtx, snapshotCancel := context.WithCancel(ctx)
+	defer snapshotCancel()
+
+	if cfg.Snapshots.Enabled {
+		log.Printf("Starting schema snapshot scheduler (interval %s)...", cfg.Snapshots.Interval)
+		scheduler.NewSnapshotScheduler(
+			dbService,
+			cfg.Snapshots.GetInterval(),
+			cfg.Snapshots.GetTimeout(),
+			cfg.Snapshots.Databases,
+		).Start(snapshotCtx)
+	}
+
 	// ==========================================
 	// 8단계: 서버 포트 설정 (TOML 기반으로 변경!)
 	// ==========================================
@@ -147,6 +186,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	snapshotCancel()
 
 	// ==========================================
 	// 11단계: DB 연결 종료 (TOML 타임아웃 사용!)
@@ -0,0 +1,87 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// InvalidateDatabaseCache는 DB 하나의 결과 캐시를 비웁니다.
+// HTTP: DELETE /databases/:dbID/cache
+func (h *Handler) InvalidateDatabaseCache(c *gin.Context) {
+	dbID := c.Param("dbID")
+
+	removed, err := h.service.InvalidateCache(c.Request.Context(), dbID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "cache invalidation failed",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CacheInvalidateResponse{
+		DatabaseID: dbID,
+		Removed:    removed,
+	})
+}
+
+// InvalidateAllCache는 모든 DB의 결과 캐시를 비웁니다.
+// HTTP: DELETE /cache
+func (h *Handler) InvalidateAllCache(c *gin.Context) {
+	removed, err := h.service.InvalidateCache(c.Request.Context(), "")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "cache invalidation failed",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CacheInvalidateResponse{Removed: removed})
+}
+
+// GetCacheStats는 결과 캐시의 항목 수, 크기, 적중률을 반환합니다.
+// HTTP: GET /cache/stats
+func (h *Handler) GetCacheStats(c *gin.Context) {
+	stats := h.service.CacheStats(c.Request.Context())
+	c.JSON(http.StatusOK, dto.FromDomainCacheStats(stats))
+}
+
+// writeCacheHeaders는 쿼리 결과에 맞는 HTTP 캐시 헤더를 설정합니다.
+//
+//   - 캐시 안 한 결과: Cache-Control: no-store
+//   - 캐시된 결과: Cache-Control: private, max-age=N + ETag + X-Cache(HIT/MISS)
+//
+// 클라이언트가 보낸 If-None-Match가 ETag와 같으면 304를 응답하고 true를 반환합니다.
+// (이 경우 호출자는 본문을 쓰면 안 됩니다.)
+func writeCacheHeaders(c *gin.Context, info *domain.CacheInfo) bool {
+	if info == nil {
+		c.Header("Cache-Control", "no-store")
+		return false
+	}
+
+	maxAge := int(info.MaxAge(time.Now()).Seconds())
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", maxAge))
+	c.Header("ETag", info.ETag)
+	if info.Hit {
+		c.Header("X-Cache", "HIT")
+	} else {
+		c.Header("X-Cache", "MISS")
+	}
+
+	for _, tag := range strings.Split(c.GetHeader("If-None-Match"), ",") {
+		if strings.TrimSpace(tag) == info.ETag {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
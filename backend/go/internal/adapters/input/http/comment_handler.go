@@ -0,0 +1,126 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// AuthorHeader는 변경한 사람을 알려주는 요청 헤더입니다.
+// DMS는 직접 로그인을 처리하지 않으므로, 앞단의 인증 프록시(SSO 게이트웨이 등)가
+// 인증된 사용자 이름을 이 헤더에 채워서 넘겨야 합니다.
+const AuthorHeader = "X-DMS-User"
+
+// SetTableComment는 테이블 주석을 바꿉니다.
+// HTTP: PUT /databases/:dbID/tables/:table/comment?schema=hr
+//
+// Request Body 예시:
+//
+//	{"comment": "직원 기본 정보"}
+//
+// 헤더 X-DMS-User의 사용자가 DB 설정의 comments 쓰기 권한에 있어야 합니다.
+func (h *Handler) SetTableComment(c *gin.Context) {
+	h.setComment(c, "")
+}
+
+// ClearTableComment는 테이블 주석을 지웁니다.
+// HTTP: DELETE /databases/:dbID/tables/:table/comment?schema=hr
+func (h *Handler) ClearTableComment(c *gin.Context) {
+	h.clearComment(c, "")
+}
+
+// SetColumnComment는 컬럼 주석을 바꿉니다.
+// HTTP: PUT /databases/:dbID/tables/:table/columns/:column/comment?schema=hr
+func (h *Handler) SetColumnComment(c *gin.Context) {
+	h.setComment(c, c.Param("column"))
+}
+
+// ClearColumnComment는 컬럼 주석을 지웁니다.
+// HTTP: DELETE /databases/:dbID/tables/:table/columns/:column/comment?schema=hr
+func (h *Handler) ClearColumnComment(c *gin.Context) {
+	h.clearComment(c, c.Param("column"))
+}
+
+// setComment는 요청 본문의 주석으로 테이블(column이 비어 있으면) 또는 컬럼 주석을 바꿉니다.
+func (h *Handler) setComment(c *gin.Context, column string) {
+	var req dto.CommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.changeComment(c, column, req.Comment)
+}
+
+func (h *Handler) clearComment(c *gin.Context, column string) {
+	h.changeComment(c, column, "")
+}
+
+func (h *Handler) changeComment(c *gin.Context, column string, comment string) {
+	change := domain.CommentChange{
+		Schema:  c.Query("schema"),
+		Table:   c.Param("table"),
+		Column:  column,
+		Comment: comment,
+		Author:  c.GetHeader(AuthorHeader),
+	}
+
+	entry, err := h.service.SetComment(c.Request.Context(), c.Param("dbID"), change)
+	if err != nil {
+		respondSchemaError(c, "failed to change comment", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainChangeLogEntry(entry))
+}
+
+// ListChangeLog는 DMS로 바꾼 내용의 변경 이력을 반환합니다 (최신순).
+// HTTP: GET /databases/:dbID/change-log?schema=hr&table=employees&author=kim&since=2024-01-01T00:00:00Z&limit=100
+func (h *Handler) ListChangeLog(c *gin.Context) {
+	query := domain.ChangeLogQuery{
+		DatabaseID: c.Param("dbID"),
+		Schema:     c.Query("schema"),
+		Table:      c.Query("table"),
+		Author:     c.Query("author"),
+	}
+
+	if value := c.Query("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid since",
+				Message: "since must be an RFC3339 timestamp (e.g. 2024-01-01T00:00:00Z)",
+			})
+			return
+		}
+		query.Since = parsed
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid limit",
+				Message: "limit must be a positive integer",
+			})
+			return
+		}
+		query.Limit = limit
+	}
+
+	entries, err := h.service.ListChangeLog(c.Request.Context(), query)
+	if err != nil {
+		respondSchemaError(c, "failed to list change log", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainChangeLog(entries))
+}
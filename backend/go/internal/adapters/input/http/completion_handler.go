@@ -0,0 +1,37 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+)
+
+// CompleteSQL은 SQL 에디터의 자동완성 후보를 반환합니다.
+// HTTP: POST /databases/:dbID/completions
+//
+// 요청: {"sql": "SELECT s. FROM student s", "cursor": 9}
+// 응답의 replace_start ~ replace_end(문자 단위)를 고른 후보의 insert_text로 바꾸면 됩니다.
+// 커서가 문자열이나 주석 안이면 후보 없이 context = "none"을 반환합니다.
+func (h *Handler) CompleteSQL(c *gin.Context) {
+	dbID := c.Param("dbID")
+
+	var req dto.CompletionRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	result, err := h.service.CompleteSQL(c.Request.Context(), dbID, req.ToDomain())
+	if err != nil {
+		respondSchemaError(c, "completion failed", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainCompletion(result))
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+)
+
+// StartCopyJob은 등록된 두 DB 사이에서 테이블 하나를 복사하는 작업을 시작합니다.
+// HTTP: POST /copy-jobs
+//
+// Request Body 예시 (Oracle → Postgres):
+//
+//	{
+//	  "source": {"database_id": "oracle-prod", "schema": "hr", "table": "employees"},
+//	  "target": {"database_id": "postgres-prod", "schema": "hr"},
+//	  "batch_size": 5000
+//	}
+//
+// 대상 테이블이 없으면 원본 구조를 대상 타입으로 바꿔서 만들고 (응답의 ddl, warnings),
+// 데이터는 백그라운드에서 복사합니다. 202와 함께 작업을 반환하므로
+// GET /copy-jobs/:id 로 진행 상황을 확인합니다.
+//
+// 원본은 기본 키 순서로 읽습니다. 기본 키가 없는 테이블은 "key_columns"에
+// NOT NULL 유니크 제약조건의 컬럼을 지정해야 합니다 (지정하지 않으면 400).
+//
+// 헤더 X-DMS-User의 사용자가 대상 DB 설정의 rows 쓰기 권한에,
+// 대상 테이블을 만들어야 하면 tables 쓰기 권한에도 있어야 합니다.
+func (h *Handler) StartCopyJob(c *gin.Context) {
+	var req dto.CopyJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	job, err := h.service.StartCopyJob(c.Request.Context(), req.ToDomain(c.GetHeader(AuthorHeader)))
+	if err != nil {
+		respondSchemaError(c, "failed to start copy job", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.FromDomainCopyJob(job))
+}
+
+// ListCopyJobs는 모든 복사 작업을 최신순으로 반환합니다.
+// HTTP: GET /copy-jobs
+func (h *Handler) ListCopyJobs(c *gin.Context) {
+	jobs, err := h.service.ListCopyJobs(c.Request.Context())
+	if err != nil {
+		respondSchemaError(c, "failed to list copy jobs", err)
+		return
+	}
+
+	resp := make([]dto.CopyJobResponse, len(jobs))
+	for i := range jobs {
+		resp[i] = dto.FromDomainCopyJob(&jobs[i])
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"jobs":  resp,
+		"count": len(resp),
+	})
+}
+
+// GetCopyJob은 복사 작업의 단계, 복사한 row 수, 진행률, 검증 결과를 반환합니다.
+// HTTP: GET /copy-jobs/:id
+//
+// 복사가 끝나면 원본과 대상의 row 수를 비교하고 (verification),
+// 맞지 않으면 status가 failed이고 error에 두 row 수가 담깁니다.
+func (h *Handler) GetCopyJob(c *gin.Context) {
+	job, err := h.service.GetCopyJob(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		respondSchemaError(c, "failed to get copy job", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainCopyJob(job))
+}
+
+// ResumeCopyJob은 실패한 작업을 마지막으로 커밋한 묶음 다음부터 이어서 실행합니다.
+// HTTP: POST /copy-jobs/:id/resume
+//
+// 서버가 복사 도중에 멈춘 작업도 failed로 보이며 같은 방법으로 이어서 실행합니다.
+// 이미 실행 중이면 409를 반환합니다.
+func (h *Handler) ResumeCopyJob(c *gin.Context) {
+	job, err := h.service.ResumeCopyJob(c.Request.Context(), c.Param("id"), c.GetHeader(AuthorHeader))
+	if err != nil {
+		respondSchemaError(c, "failed to resume copy job", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.FromDomainCopyJob(job))
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/domain"
+)
+
+// fileNameUnsafe는 다운로드 파일 이름에 쓰지 않을 문자입니다 (DB ID의 ':' 등).
+var fileNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// GetDataDictionary는 데이터 사전 문서를 파일로 내려줍니다.
+// HTTP: GET /databases/:dbID/data-dictionary?schema=hr&format=xlsx&tables=stu_*&exclude=*_bak
+//
+// 쿼리 파라미터:
+//   - schema: 스키마 이름 또는 패턴 (쉼표로 구분, 없으면 기본 스키마, *이면 모든 스키마)
+//   - tables: 포함할 테이블 이름 패턴 (쉼표로 구분, *, ? 와일드카드)
+//   - exclude: 뺄 테이블 이름 패턴 (tables보다 우선)
+//   - types: table(기본값), view, materialized_view, foreign_table (쉼표로 구분)
+//   - format: markdown(기본값), html, xlsx
+//
+// 응답은 JSON이 아니라 Content-Disposition: attachment가 붙은 문서 파일입니다.
+// 메타데이터 캐시를 사용하므로, 다른 도구로 바꾼 주석까지 반영하려면
+// 먼저 POST /databases/:dbID/metadata/refresh를 호출합니다.
+func (h *Handler) GetDataDictionary(c *gin.Context) {
+	dbID := c.Param("dbID")
+
+	format, err := domain.ParseDictionaryFormat(c.Query("format"))
+	if err != nil {
+		respondSchemaError(c, "invalid format", err)
+		return
+	}
+
+	types, err := domain.ParseTableTypes(c.Query("types"))
+	if err != nil {
+		respondSchemaError(c, "invalid types", err)
+		return
+	}
+
+	options := domain.DataDictionaryOptions{
+		Schemas: splitList(c.Query("schema")),
+		Tables:  splitList(c.Query("tables")),
+		Exclude: splitList(c.Query("exclude")),
+		Types:   types,
+		Format:  format,
+	}
+
+	dictionary, err := h.service.GenerateDataDictionary(c.Request.Context(), dbID, options)
+	if err != nil {
+		respondSchemaError(c, "failed to generate data dictionary", err)
+		return
+	}
+
+	document, err := domain.RenderDataDictionary(dictionary, format)
+	if err != nil {
+		respondSchemaError(c, "failed to render data dictionary", err)
+		return
+	}
+
+	fileName := fmt.Sprintf("%s-data-dictionary.%s", fileNameUnsafe.ReplaceAllString(dbID, "_"), format.Extension())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, fileName))
+	c.Data(http.StatusOK, format.ContentType(), document)
+}
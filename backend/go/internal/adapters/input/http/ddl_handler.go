@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// GetDDL은 객체를 다시 만드는 DDL(CREATE 문)을 반환합니다.
+// HTTP: GET /databases/:dbID/ddl/:type/:name?schema=hr&dialect=postgres&format=sql
+//
+// 쿼리 파라미터:
+//   - dialect: 대상 방언 (postgres, oracle). 없으면 DB 자신의 방언
+//   - format=sql: JSON 대신 DDL 문만 text/plain으로 반환 (파일로 저장하기 편하게)
+//
+// 변환 경고는 format=sql일 때 X-DDL-Warnings 헤더에 개수로 알려줍니다.
+func (h *Handler) GetDDL(c *gin.Context) {
+	dbID := c.Param("dbID")
+	objectType := domain.ObjectType(c.Param("type"))
+	name := c.Param("name")
+	schema := c.Query("schema")
+
+	dialect, err := domain.ParseDialect(c.Query("dialect"))
+	if err != nil {
+		respondSchemaError(c, "invalid dialect", err)
+		return
+	}
+
+	result, err := h.service.GenerateDDL(c.Request.Context(), dbID, schema, objectType, name, dialect)
+	if err != nil {
+		respondSchemaError(c, "failed to generate DDL", err)
+		return
+	}
+
+	if c.Query("format") == "sql" {
+		c.Header("X-DDL-Warnings", strconv.Itoa(len(result.Warnings)))
+		c.String(http.StatusOK, result.DDL)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainDDL(result))
+}
@@ -7,6 +7,13 @@
 // → 유효성 검사 태그 등 HTTP 전용 기능 사용
 package dto
 
+import (
+	"fmt"
+	"time"
+
+	"space/internal/domain"
+)
+
 // RegisterDatabaseRequest는 DB 등록 API의 요청 구조체입니다.
 // JSON으로 받은 데이터를 이 구조체로 파싱합니다.
 type RegisterDatabaseRequest struct {
@@ -49,12 +56,238 @@ type RegisterDatabaseRequest struct {
 
 	// Password는 비밀번호입니다.
 	Password string `json:"password" binding:"required"`
+
+	// Guard는 실행 전 비용 검사 설정입니다 (선택사항).
+	Guard *QueryGuardRequest `json:"guard,omitempty"`
+
+	// CacheTTL은 SELECT 결과 캐시 유지 시간입니다 (예: "30s", 선택사항).
+	// 비어 있으면 캐시하지 않습니다.
+	CacheTTL string `json:"cache_ttl,omitempty"`
+
+	// Write는 DMS가 대신 실행하는 쓰기 작업별 허용 사용자입니다 (선택사항, 없으면 읽기 전용).
+	// 예: {"comments": ["kim", "lee"], "rows": ["dba"]}
+	Write map[string][]string `json:"write,omitempty"`
+}
+
+// WritePolicy는 Write를 domain.WritePolicy로 변환합니다 (없으면 nil).
+func (r *RegisterDatabaseRequest) WritePolicy() domain.WritePolicy {
+	if len(r.Write) == 0 {
+		return nil
+	}
+	policy := make(domain.WritePolicy, len(r.Write))
+	for scope, users := range r.Write {
+		policy[domain.WriteScope(scope)] = users
+	}
+	return policy
+}
+
+// QueryGuardRequest는 비용 기반 쿼리 가드 설정입니다.
+type QueryGuardRequest struct {
+	MaxCost float64 `json:"max_cost,omitempty" binding:"min=0"`
+	MaxRows float64 `json:"max_rows,omitempty" binding:"min=0"`
+	Action  string  `json:"action" binding:"required,oneof=reject confirm"`
+}
+
+// ToDomain은 QueryGuardRequest를 domain.QueryGuard로 변환합니다.
+// nil 요청은 nil(가드 없음)로 변환됩니다.
+func (r *QueryGuardRequest) ToDomain() *domain.QueryGuard {
+	if r == nil {
+		return nil
+	}
+	return &domain.QueryGuard{
+		MaxCost: r.MaxCost,
+		MaxRows: r.MaxRows,
+		Action:  domain.GuardAction(r.Action),
+	}
 }
 
 // ExecuteQueryRequest는 쿼리 실행 API의 요청 구조체입니다.
 type ExecuteQueryRequest struct {
 	// Query는 실행할 SQL 쿼리입니다.
 	Query string `json:"query" binding:"required"`
+
+	// Confirm은 비용 가드 경고를 확인하고 실행하겠다는 표시입니다.
+	// 가드 동작이 "confirm"일 때만 의미가 있습니다.
+	Confirm bool `json:"confirm,omitempty"`
+
+	// CacheTTL은 이 요청에만 적용할 결과 캐시 유지 시간입니다 (예: "30s").
+	// 비어 있으면 DB 설정을 따르고, "0s"면 캐시를 사용하지 않습니다.
+	CacheTTL string `json:"cache_ttl,omitempty"`
+}
+
+// ToOptions는 요청을 domain.QueryOptions로 변환합니다.
+// refresh가 true면 캐시를 무시하고 다시 실행합니다 (Cache-Control: no-cache).
+func (r *ExecuteQueryRequest) ToOptions(refresh bool) (domain.QueryOptions, error) {
+	opts := domain.QueryOptions{
+		Confirmed:    r.Confirm,
+		RefreshCache: refresh,
+	}
+
+	if r.CacheTTL != "" {
+		ttl, err := time.ParseDuration(r.CacheTTL)
+		if err != nil || ttl < 0 {
+			return opts, fmt.Errorf("invalid cache_ttl: %q", r.CacheTTL)
+		}
+		opts.CacheTTL = &ttl
+	}
+
+	return opts, nil
+}
+
+// CompletionRequest는 SQL 자동완성 API의 요청 구조체입니다.
+type CompletionRequest struct {
+	// SQL은 에디터의 전체 내용입니다 (여러 문장이면 커서가 있는 문장만 봅니다).
+	SQL string `json:"sql"`
+
+	// Cursor는 커서 위치입니다 (문자 단위, 0 = 맨 앞).
+	Cursor int `json:"cursor" binding:"min=0"`
+
+	// Schema는 스키마 없이 쓴 테이블 이름의 기준 스키마입니다 (없으면 현재 스키마).
+	Schema string `json:"schema,omitempty"`
+
+	// Limit은 최대 후보 수입니다 (없으면 100).
+	Limit int `json:"limit,omitempty" binding:"min=0"`
+}
+
+// ToDomain은 CompletionRequest를 domain.CompletionRequest로 변환합니다.
+func (r *CompletionRequest) ToDomain() domain.CompletionRequest {
+	return domain.CompletionRequest{
+		SQL:    r.SQL,
+		Cursor: r.Cursor,
+		Schema: r.Schema,
+		Limit:  r.Limit,
+	}
+}
+
+// ExplainRequest는 실행 계획 조회 API의 요청 구조체입니다.
+type ExplainRequest struct {
+	// Query는 계획을 볼 SQL 쿼리입니다.
+	Query string `json:"query" binding:"required"`
+
+	// Analyze가 true면 실제로 실행해서 실측값을 포함합니다.
+	// 트랜잭션 안에서 실행하고 롤백하므로 데이터는 바뀌지 않습니다.
+	Analyze bool `json:"analyze,omitempty"`
+}
+
+// FederatedQueryRequest는 페더레이션 쿼리 API의 요청 구조체입니다.
+type FederatedQueryRequest struct {
+	// Sources는 DB별 서브 쿼리 목록입니다 (2개 이상).
+	// dive: 슬라이스의 각 요소도 유효성 검사
+	Sources []FederatedSourceRequest `json:"sources" binding:"required,min=2,dive"`
+
+	// Query는 소스 테이블 위에서 실행할 최종 SQL입니다 (SQLite 문법).
+	Query string `json:"query" binding:"required"`
+
+	// MaxRowsPerSource는 소스당 최대 row 수입니다 (선택, 서버 상한 이내).
+	MaxRowsPerSource int `json:"max_rows_per_source,omitempty" binding:"omitempty,min=1"`
+
+	// MaxMemoryMB는 임베디드 엔진 메모리 한도입니다 (선택, 서버 상한 이내).
+	MaxMemoryMB int `json:"max_memory_mb,omitempty" binding:"omitempty,min=1"`
+}
+
+// FederatedSourceRequest는 페더레이션 쿼리의 서브 쿼리 하나입니다.
+type FederatedSourceRequest struct {
+	Alias      string `json:"alias" binding:"required"`
+	DatabaseID string `json:"database_id" binding:"required"`
+	Query      string `json:"query" binding:"required"`
+}
+
+// ToDomain은 FederatedQueryRequest를 domain.FederatedQuery로 변환합니다.
+func (r *FederatedQueryRequest) ToDomain() *domain.FederatedQuery {
+	sources := make([]domain.FederatedSource, 0, len(r.Sources))
+	for _, src := range r.Sources {
+		sources = append(sources, domain.FederatedSource{
+			Alias:      src.Alias,
+			DatabaseID: src.DatabaseID,
+			Query:      src.Query,
+		})
+	}
+
+	return &domain.FederatedQuery{
+		Sources: sources,
+		Query:   r.Query,
+		Limits: domain.FederationLimits{
+			MaxRowsPerSource: r.MaxRowsPerSource,
+			MaxMemoryBytes:   int64(r.MaxMemoryMB) * 1024 * 1024,
+		},
+	}
+}
+
+// ResultDiffRequest는 결과 비교 API의 요청 구조체입니다.
+type ResultDiffRequest struct {
+	Source DiffSideRequest `json:"source" binding:"required"`
+
+	// Target.Query를 비우면 Source.Query를 그대로 사용합니다.
+	Target DiffSideRequest `json:"target" binding:"required"`
+
+	// KeyColumns는 row를 식별하는 키 컬럼입니다 (대소문자 무시).
+	KeyColumns []string `json:"key_columns" binding:"required,min=1"`
+
+	// Mode는 "full"(기본값, 컬럼별 차이 포함) 또는 "hash"(대용량용)입니다.
+	Mode string `json:"mode,omitempty" binding:"omitempty,oneof=full hash"`
+
+	// MaxRows는 응답에 담을 최대 차이 row 수입니다 (개수는 항상 전체 집계).
+	MaxRows int `json:"max_rows,omitempty" binding:"omitempty,min=1"`
+
+	// MaxRowsPerSide는 한쪽에서 읽을 최대 row 수입니다 (선택, 서버 상한 이내).
+	// 넘으면 비교하지 않고 422를 반환합니다.
+	MaxRowsPerSide int `json:"max_rows_per_side,omitempty" binding:"omitempty,min=1"`
+
+	EmptyAsNull        bool     `json:"empty_as_null,omitempty"`
+	TrimTrailingSpaces bool     `json:"trim_trailing_spaces,omitempty"`
+	IgnoreColumns      []string `json:"ignore_columns,omitempty"`
+
+	// Confirm은 비용 가드 경고를 확인하고 실행하겠다는 표시입니다.
+	Confirm bool `json:"confirm,omitempty"`
+}
+
+// DiffSideRequest는 비교할 한쪽(DB + 쿼리)입니다.
+type DiffSideRequest struct {
+	DatabaseID string `json:"database_id" binding:"required"`
+	Query      string `json:"query"`
+}
+
+// ToDomain은 ResultDiffRequest를 domain.ResultDiffRequest로 변환합니다.
+func (r *ResultDiffRequest) ToDomain() *domain.ResultDiffRequest {
+	return &domain.ResultDiffRequest{
+		Source:         domain.DiffSide{DatabaseID: r.Source.DatabaseID, Query: r.Source.Query},
+		Target:         domain.DiffSide{DatabaseID: r.Target.DatabaseID, Query: r.Target.Query},
+		KeyColumns:     r.KeyColumns,
+		Mode:           domain.DiffMode(r.Mode),
+		MaxRows:        r.MaxRows,
+		MaxRowsPerSide: r.MaxRowsPerSide,
+		Options: domain.DiffOptions{
+			EmptyAsNull:        r.EmptyAsNull,
+			TrimTrailingSpaces: r.TrimTrailingSpaces,
+			IgnoreColumns:      r.IgnoreColumns,
+		},
+		Confirmed: r.Confirm,
+	}
+}
+
+// SchemaDiffRequest는 스키마 비교 API의 요청 구조체입니다.
+// Source가 기준이고, 마이그레이션 DDL은 Target을 Source와 같게 만듭니다.
+type SchemaDiffRequest struct {
+	Source SchemaSideRequest `json:"source" binding:"required"`
+	Target SchemaSideRequest `json:"target" binding:"required"`
+
+	// AllowDrop이 true면 Target에만 있는 테이블/컬럼 등을 지우는 DDL도 만듭니다.
+	AllowDrop bool `json:"allow_drop,omitempty"`
+}
+
+// SchemaSideRequest는 비교할 한쪽(DB + 스키마)입니다.
+// Schema를 비우면 DB 설정의 기본 스키마(또는 세션의 현재 스키마)를 씁니다.
+type SchemaSideRequest struct {
+	DatabaseID string `json:"database_id" binding:"required"`
+	Schema     string `json:"schema,omitempty"`
+}
+
+// ToDomain은 SchemaDiffRequest를 비교 대상과 옵션으로 변환합니다.
+func (r *SchemaDiffRequest) ToDomain() (source, target domain.SchemaRef, options domain.SchemaDiffOptions) {
+	source = domain.SchemaRef{DatabaseID: r.Source.DatabaseID, Schema: r.Source.Schema}
+	target = domain.SchemaRef{DatabaseID: r.Target.DatabaseID, Schema: r.Target.Schema}
+	options = domain.SchemaDiffOptions{AllowDrop: r.AllowDrop}
+	return source, target, options
 }
 
 // 예시 JSON:
@@ -73,3 +306,113 @@ type ExecuteQueryRequest struct {
 // {
 //   "query": "SELECT * FROM users LIMIT 10"
 // }
+//
+// POST /databases/postgres-prod/query (30초간 결과 캐시)
+// {
+//   "query": "SELECT * FROM codes",
+//   "cache_ttl": "30s"
+// }
+//
+// POST /databases/postgres-prod/explain
+// {
+//   "query": "SELECT * FROM users WHERE id = 1",
+//   "analyze": true
+// }
+//
+// POST /federated-query
+// {
+//   "sources": [
+//     {"alias": "ora_students", "database_id": "oracle-prod", "query": "SELECT student_no, name FROM students"},
+//     {"alias": "pg_grades", "database_id": "postgres-prod", "query": "SELECT student_no, grade FROM grades"}
+//   ],
+//   "query": "SELECT s.name, g.grade FROM ora_students s JOIN pg_grades g ON g.student_no = s.STUDENT_NO"
+// }
+//
+// POST /result-diff
+// {
+//   "source": {"database_id": "oracle-prod", "query": "SELECT student_no, name, status FROM students"},
+//   "target": {"database_id": "postgres-prod"},
+//   "key_columns": ["student_no"],
+//   "empty_as_null": true
+// }
+
+// CommentRequest는 테이블/컬럼 주석 설정 요청입니다.
+// 주석을 지울 때는 DELETE 요청을 씁니다.
+type CommentRequest struct {
+	Comment string `json:"comment" binding:"required"`
+}
+
+// RowChangeRequest는 row 추가/수정/삭제 요청입니다.
+// 작업은 HTTP 메서드로 정합니다 (POST: insert, PATCH: update, DELETE: delete).
+//
+//	{"key": {"student_no": 20240001}, "values": {"status": "graduated"}}
+//
+// 핸들러가 UseNumber로 읽으므로 숫자는 float64가 아니라 json.Number로 들어옵니다.
+type RowChangeRequest struct {
+	Key    map[string]interface{} `json:"key,omitempty"`    // 기본 키 (update, delete)
+	Values map[string]interface{} `json:"values,omitempty"` // 넣을/바꿀 값 (insert, update), null은 NULL
+}
+
+// CopyJobRequest는 테이블 복사 작업 시작 요청입니다.
+//
+//	{"source": {"database_id": "oracle-prod", "schema": "hr", "table": "employees"},
+//	 "target": {"database_id": "postgres-prod", "schema": "hr"}, "batch_size": 5000}
+type CopyJobRequest struct {
+	Source CopyTableRequest `json:"source" binding:"required"`
+	Target CopyTableRequest `json:"target" binding:"required"`
+
+	Truncate  bool `json:"truncate,omitempty"`                                       // 대상 테이블이 이미 있으면 먼저 비움
+	BatchSize int  `json:"batch_size,omitempty" binding:"omitempty,min=1,max=10000"` // 기본값 1000
+
+	// KeyColumns는 원본을 읽는 순서로 쓸 키입니다 (비어 있으면 기본 키).
+	// 기본 키가 없는 테이블은 NOT NULL 유니크 제약조건의 컬럼을 지정해야 합니다.
+	KeyColumns []string `json:"key_columns,omitempty"`
+}
+
+// CopyTableRequest는 복사의 한쪽(DB + 테이블)입니다.
+// 대상의 Table을 비우면 원본과 같은 이름, Schema를 비우면 DB 기본 스키마입니다.
+type CopyTableRequest struct {
+	DatabaseID string `json:"database_id" binding:"required"`
+	Schema     string `json:"schema,omitempty"`
+	Table      string `json:"table,omitempty"`
+}
+
+// ToDomain은 CopyJobRequest를 domain.CopyRequest로 변환합니다.
+func (r *CopyJobRequest) ToDomain(author string) domain.CopyRequest {
+	return domain.CopyRequest{
+		SourceDatabaseID: r.Source.DatabaseID,
+		SourceSchema:     r.Source.Schema,
+		SourceTable:      r.Source.Table,
+		TargetDatabaseID: r.Target.DatabaseID,
+		TargetSchema:     r.Target.Schema,
+		TargetTable:      r.Target.Table,
+		Truncate:         r.Truncate,
+		BatchSize:        r.BatchSize,
+		KeyColumns:       r.KeyColumns,
+		Author:           author,
+	}
+}
+
+// ProfileQueryRequest는 쿼리 결과 프로파일링 요청입니다.
+// 테이블은 GET /databases/:dbID/tables/:table/profile 을 씁니다 (표본은 테이블에만 가능).
+type ProfileQueryRequest struct {
+	Query   string   `json:"query" binding:"required"`
+	Columns []string `json:"columns,omitempty"` // 비어 있으면 전체
+
+	Top     int `json:"top,omitempty" binding:"omitempty,min=1"`     // 최빈값 개수 (기본값 10)
+	Buckets int `json:"buckets,omitempty" binding:"omitempty,min=1"` // 길이 분포 구간 수 (기본값 10)
+
+	// ApproxDistinct는 고유값 개수를 근사치로 셉니다 (Oracle 19c만, 그 외는 정확한 값).
+	ApproxDistinct bool `json:"approx_distinct,omitempty"`
+}
+
+// ToDomain은 ProfileQueryRequest를 domain.ProfileRequest로 변환합니다.
+func (r *ProfileQueryRequest) ToDomain() domain.ProfileRequest {
+	return domain.ProfileRequest{
+		Query:          r.Query,
+		Columns:        r.Columns,
+		TopN:           r.Top,
+		LengthBuckets:  r.Buckets,
+		ApproxDistinct: r.ApproxDistinct,
+	}
+}
@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"strings"
+	"time"
+
 	"space/internal/domain"
 )
 
@@ -15,15 +18,128 @@ type DatabaseResponse struct {
 	Username string `json:"username"`
 	Status   string `json:"status"`
 
+	// Guard는 비용 가드 설정입니다 (없으면 JSON에서 제외)
+	Guard *QueryGuardResponse `json:"guard,omitempty"`
+
+	// CacheTTL은 결과 캐시 유지 시간입니다 (예: "30s", 없으면 JSON에서 제외)
+	CacheTTL string `json:"cache_ttl,omitempty"`
+
+	// Write는 쓰기 작업별 허용 사용자입니다 (없으면 JSON에서 제외 = 읽기 전용)
+	Write map[string][]string `json:"write,omitempty"`
+
 	// 비밀번호는 응답에 포함하지 않습니다! (보안)
 }
 
+// QueryGuardResponse는 비용 가드 설정 응답입니다.
+type QueryGuardResponse struct {
+	MaxCost float64 `json:"max_cost,omitempty"`
+	MaxRows float64 `json:"max_rows,omitempty"`
+	Action  string  `json:"action"`
+}
+
 // QueryResultResponse는 쿼리 실행 결과를 반환하는 응답 구조체입니다.
 type QueryResultResponse struct {
 	Columns       []string                 `json:"columns"`
+	ColumnTypes   []string                 `json:"column_types,omitempty"` // DB 원본 타입 이름
 	Rows          []map[string]interface{} `json:"rows"`
 	RowCount      int                      `json:"row_count"`
 	ExecutionTime string                   `json:"execution_time"` // "15ms" 형태
+
+	// Cache는 결과 캐시 정보입니다 (캐시를 사용하지 않았으면 JSON에서 제외)
+	Cache *CacheInfoResponse `json:"cache,omitempty"`
+}
+
+// CacheInfoResponse는 캐시된 결과의 메타데이터입니다.
+type CacheInfoResponse struct {
+	Hit       bool   `json:"hit"`
+	StoredAt  string `json:"stored_at"`  // RFC3339
+	ExpiresAt string `json:"expires_at"` // RFC3339
+}
+
+// CacheStatsResponse는 결과 캐시 상태 응답입니다.
+type CacheStatsResponse struct {
+	Entries    int     `json:"entries"`
+	SizeBytes  int64   `json:"size_bytes"`
+	MaxEntries int     `json:"max_entries"`
+	MaxBytes   int64   `json:"max_bytes"`
+	Hits       int64   `json:"hits"`
+	Misses     int64   `json:"misses"`
+	Evictions  int64   `json:"evictions"`
+	HitRatio   float64 `json:"hit_ratio"` // hits / (hits + misses)
+}
+
+// CacheInvalidateResponse는 캐시 무효화 응답입니다.
+type CacheInvalidateResponse struct {
+	DatabaseID string `json:"database_id,omitempty"` // 비어 있으면 전체
+	Removed    int    `json:"removed"`
+}
+
+// MetadataRefreshResponse는 메타데이터 캐시 새로 고침 응답입니다.
+type MetadataRefreshResponse struct {
+	DatabaseID  string    `json:"database_id"`
+	Invalidated int       `json:"invalidated"` // 지운 캐시 항목 수
+	Tables      int       `json:"tables"`      // 새로 읽은 테이블/뷰 수
+	Columns     int       `json:"columns"`
+	LoadedAt    time.Time `json:"loaded_at"`
+}
+
+// FromDomainMetadataRefresh는 domain.MetadataRefresh를 MetadataRefreshResponse로 변환합니다.
+func FromDomainMetadataRefresh(refresh *domain.MetadataRefresh) MetadataRefreshResponse {
+	return MetadataRefreshResponse{
+		DatabaseID:  refresh.DatabaseID,
+		Invalidated: refresh.Invalidated,
+		Tables:      refresh.Tables,
+		Columns:     refresh.Columns,
+		LoadedAt:    refresh.LoadedAt,
+	}
+}
+
+// GuardErrorResponse는 비용 가드에 걸린 쿼리의 응답입니다.
+// 어떤 계획 노드가 한도를 넘었는지 알려줍니다.
+type GuardErrorResponse struct {
+	Error         string                   `json:"error"`
+	Message       string                   `json:"message,omitempty"`
+	Action        string                   `json:"action"` // "reject" 또는 "confirm"
+	TotalCost     float64                  `json:"total_cost"`
+	EstimatedRows float64                  `json:"estimated_rows"`
+	Violations    []GuardViolationResponse `json:"violations"`
+}
+
+// GuardViolationResponse는 한도를 넘은 계획 노드 하나입니다.
+type GuardViolationResponse struct {
+	Operation string  `json:"operation"`
+	Object    string  `json:"object,omitempty"`
+	Metric    string  `json:"metric"` // "cost" 또는 "rows"
+	Value     float64 `json:"value"`
+	Limit     float64 `json:"limit"`
+}
+
+// FromDomainGuardError는 domain.QueryGuardError를 GuardErrorResponse로 변환합니다.
+func FromDomainGuardError(e *domain.QueryGuardError) *GuardErrorResponse {
+	violations := make([]GuardViolationResponse, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, GuardViolationResponse{
+			Operation: v.Node.Operation,
+			Object:    v.Node.Object,
+			Metric:    v.Metric,
+			Value:     v.Value,
+			Limit:     v.Limit,
+		})
+	}
+
+	resp := &GuardErrorResponse{
+		Error:         "query rejected by cost guard",
+		Message:       e.Error(),
+		Action:        string(e.Action),
+		TotalCost:     e.TotalCost,
+		EstimatedRows: e.EstimatedRows,
+		Violations:    violations,
+	}
+	if e.Action == domain.GuardConfirm {
+		resp.Error = "query requires confirmation"
+	}
+
+	return resp
 }
 
 // ErrorResponse는 에러를 반환하는 응답 구조체입니다.
@@ -47,7 +163,7 @@ type SuccessResponse struct {
 // 이것은 "변환 함수" 또는 "매퍼(Mapper)"라고 부릅니다.
 func FromDomain(db *domain.Database) *DatabaseResponse {
 	// &DatabaseResponse{...}는 구조체 리터럴 + 포인터 생성
-	return &DatabaseResponse{
+	response := &DatabaseResponse{
 		ID:       db.ID,
 		Name:     db.Name,
 		Type:     string(db.Type), // DatabaseType → string 변환
@@ -58,16 +174,449 @@ func FromDomain(db *domain.Database) *DatabaseResponse {
 		Status:   string(db.Status), // ConnectionStatus → string 변환
 		// Password는 의도적으로 제외! (보안)
 	}
+
+	if db.Guard != nil {
+		response.Guard = &QueryGuardResponse{
+			MaxCost: db.Guard.MaxCost,
+			MaxRows: db.Guard.MaxRows,
+			Action:  string(db.Guard.Action),
+		}
+	}
+
+	if db.CacheTTL > 0 {
+		response.CacheTTL = db.CacheTTL.String()
+	}
+
+	if len(db.Write) > 0 {
+		response.Write = make(map[string][]string, len(db.Write))
+		for scope, users := range db.Write {
+			response.Write[string(scope)] = users
+		}
+	}
+
+	return response
 }
 
 // FromDomainQueryResult는 domain.QueryResult를 QueryResultResponse로 변환합니다.
 func FromDomainQueryResult(result *domain.QueryResult) *QueryResultResponse {
-	return &QueryResultResponse{
+	response := &QueryResultResponse{
 		Columns:       result.Columns,
+		ColumnTypes:   result.ColumnTypes,
 		Rows:          result.Rows,
 		RowCount:      result.RowCount(),
 		ExecutionTime: result.FormatExecutionTime(),
 	}
+
+	if result.Cache != nil {
+		response.Cache = &CacheInfoResponse{
+			Hit:       result.Cache.Hit,
+			StoredAt:  result.Cache.StoredAt.Format(time.RFC3339),
+			ExpiresAt: result.Cache.ExpiresAt.Format(time.RFC3339),
+		}
+	}
+
+	return response
+}
+
+// FromDomainCacheStats는 domain.CacheStats를 CacheStatsResponse로 변환합니다.
+func FromDomainCacheStats(stats domain.CacheStats) *CacheStatsResponse {
+	response := &CacheStatsResponse{
+		Entries:    stats.Entries,
+		SizeBytes:  stats.SizeBytes,
+		MaxEntries: stats.MaxEntries,
+		MaxBytes:   stats.MaxBytes,
+		Hits:       stats.Hits,
+		Misses:     stats.Misses,
+		Evictions:  stats.Evictions,
+	}
+
+	if total := stats.Hits + stats.Misses; total > 0 {
+		response.HitRatio = float64(stats.Hits) / float64(total)
+	}
+
+	return response
+}
+
+// TableResponse는 스키마 브라우저의 테이블(또는 뷰) 하나입니다.
+type TableResponse struct {
+	Schema string `json:"schema"`
+	Name   string `json:"name"`
+	Type   string `json:"type"` // table, view, materialized_view, foreign_table
+}
+
+// ColumnResponse는 테이블의 컬럼 하나입니다.
+type ColumnResponse struct {
+	Name               string                  `json:"name"`
+	Position           int                     `json:"position"`
+	DataType           string                  `json:"data_type"` // "numeric(10,2)", "VARCHAR2(100 CHAR)"
+	BaseType           string                  `json:"base_type"` // "numeric", "VARCHAR2"
+	Length             *int64                  `json:"length,omitempty"`
+	Precision          *int                    `json:"precision,omitempty"`
+	Scale              *int                    `json:"scale,omitempty"`
+	Nullable           bool                    `json:"nullable"`
+	Default            *string                 `json:"default,omitempty"`
+	Comment            string                  `json:"comment,omitempty"`
+	PrimaryKey         bool                    `json:"primary_key"`
+	PrimaryKeyPosition int                     `json:"primary_key_position,omitempty"`
+	Identity           *ColumnIdentityResponse `json:"identity,omitempty"`
+}
+
+// ColumnIdentityResponse는 자동 증가 컬럼 정보입니다.
+type ColumnIdentityResponse struct {
+	Generation string `json:"generation"` // ALWAYS, BY DEFAULT, SEQUENCE
+	Sequence   string `json:"sequence,omitempty"`
+}
+
+// FromDomainTables는 []domain.TableInfo를 []TableResponse로 변환합니다.
+func FromDomainTables(tables []domain.TableInfo) []TableResponse {
+	responses := make([]TableResponse, 0, len(tables))
+	for _, t := range tables {
+		responses = append(responses, TableResponse{
+			Schema: t.Schema,
+			Name:   t.Name,
+			Type:   string(t.Type),
+		})
+	}
+	return responses
+}
+
+// FromDomainColumns는 []domain.ColumnInfo를 []ColumnResponse로 변환합니다.
+func FromDomainColumns(columns []domain.ColumnInfo) []ColumnResponse {
+	responses := make([]ColumnResponse, 0, len(columns))
+	for _, col := range columns {
+		response := ColumnResponse{
+			Name:               col.Name,
+			Position:           col.Position,
+			DataType:           col.DataType,
+			BaseType:           col.BaseType,
+			Length:             col.Length,
+			Precision:          col.Precision,
+			Scale:              col.Scale,
+			Nullable:           col.Nullable,
+			Default:            col.Default,
+			Comment:            col.Comment,
+			PrimaryKey:         col.PrimaryKey,
+			PrimaryKeyPosition: col.PrimaryKeyPosition,
+		}
+
+		if col.Identity != nil {
+			response.Identity = &ColumnIdentityResponse{
+				Generation: string(col.Identity.Generation),
+				Sequence:   col.Identity.Sequence,
+			}
+		}
+
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// IndexResponse는 인덱스 하나입니다.
+type IndexResponse struct {
+	Name      string   `json:"name"`
+	Columns   []string `json:"columns"` // 함수 기반 인덱스는 식
+	Unique    bool     `json:"unique"`
+	Primary   bool     `json:"primary"`
+	Type      string   `json:"type"`                // btree, gin, NORMAL, BITMAP 등
+	Predicate string   `json:"predicate,omitempty"` // 부분 인덱스 조건
+}
+
+// ConstraintResponse는 기본 키, 유니크, 체크 제약조건 하나입니다.
+type ConstraintResponse struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"` // primary_key, unique, check
+	Columns    []string `json:"columns"`
+	Expression string   `json:"expression,omitempty"`
+	Deferrable bool     `json:"deferrable"`
+}
+
+// ForeignKeyResponse는 외래 키 하나입니다 (참조하는 쪽 → 참조되는 쪽).
+type ForeignKeyResponse struct {
+	Name       string   `json:"name"`
+	Schema     string   `json:"schema"`
+	Table      string   `json:"table"`
+	Columns    []string `json:"columns"`
+	RefSchema  string   `json:"ref_schema"`
+	RefTable   string   `json:"ref_table"`
+	RefColumns []string `json:"ref_columns"`
+	OnDelete   string   `json:"on_delete"`
+	OnUpdate   string   `json:"on_update,omitempty"` // Oracle은 없음
+}
+
+// FromDomainIndexes는 []domain.IndexInfo를 []IndexResponse로 변환합니다.
+func FromDomainIndexes(indexes []domain.IndexInfo) []IndexResponse {
+	responses := make([]IndexResponse, 0, len(indexes))
+	for _, idx := range indexes {
+		responses = append(responses, IndexResponse{
+			Name:      idx.Name,
+			Columns:   idx.Columns,
+			Unique:    idx.Unique,
+			Primary:   idx.Primary,
+			Type:      idx.Type,
+			Predicate: idx.Predicate,
+		})
+	}
+	return responses
+}
+
+// FromDomainConstraints는 []domain.ConstraintInfo를 []ConstraintResponse로 변환합니다.
+func FromDomainConstraints(constraints []domain.ConstraintInfo) []ConstraintResponse {
+	responses := make([]ConstraintResponse, 0, len(constraints))
+	for _, con := range constraints {
+		responses = append(responses, ConstraintResponse{
+			Name:       con.Name,
+			Type:       string(con.Type),
+			Columns:    con.Columns,
+			Expression: con.Expression,
+			Deferrable: con.Deferrable,
+		})
+	}
+	return responses
+}
+
+// FromDomainForeignKeys는 []domain.ForeignKeyInfo를 []ForeignKeyResponse로 변환합니다.
+func FromDomainForeignKeys(fks []domain.ForeignKeyInfo) []ForeignKeyResponse {
+	responses := make([]ForeignKeyResponse, 0, len(fks))
+	for _, fk := range fks {
+		responses = append(responses, ForeignKeyResponse{
+			Name:       fk.Name,
+			Schema:     fk.Schema,
+			Table:      fk.Table,
+			Columns:    fk.Columns,
+			RefSchema:  fk.RefSchema,
+			RefTable:   fk.RefTable,
+			RefColumns: fk.RefColumns,
+			OnDelete:   fk.OnDelete,
+			OnUpdate:   fk.OnUpdate,
+		})
+	}
+	return responses
+}
+
+// DatabaseObjectResponse는 뷰, 함수, 트리거 같은 객체 하나입니다.
+// 목록 조회에서는 definition/body/details가 빠집니다.
+type DatabaseObjectResponse struct {
+	Schema     string                 `json:"schema"`
+	Name       string                 `json:"name"`
+	Type       string                 `json:"type"`
+	Status     string                 `json:"status,omitempty"`    // Oracle: VALID, INVALID
+	Table      string                 `json:"table,omitempty"`     // 트리거가 걸린 테이블
+	Arguments  string                 `json:"arguments,omitempty"` // 함수/프로시저 인자 (Postgres)
+	Definition string                 `json:"definition,omitempty"`
+	Body       string                 `json:"body,omitempty"` // Oracle 패키지 본문
+	Details    map[string]interface{} `json:"details,omitempty"`
+}
+
+// FromDomainObject는 domain.DatabaseObject를 DatabaseObjectResponse로 변환합니다.
+func FromDomainObject(object *domain.DatabaseObject) DatabaseObjectResponse {
+	return DatabaseObjectResponse{
+		Schema:     object.Schema,
+		Name:       object.Name,
+		Type:       string(object.Type),
+		Status:     object.Status,
+		Table:      object.Table,
+		Arguments:  object.Arguments,
+		Definition: object.Definition,
+		Body:       object.Body,
+		Details:    object.Details,
+	}
+}
+
+// FromDomainObjects는 []domain.DatabaseObject를 []DatabaseObjectResponse로 변환합니다.
+func FromDomainObjects(objects []domain.DatabaseObject) []DatabaseObjectResponse {
+	responses := make([]DatabaseObjectResponse, 0, len(objects))
+	for i := range objects {
+		responses = append(responses, FromDomainObject(&objects[i]))
+	}
+	return responses
+}
+
+// ExplainResponse는 실행 계획 조회 응답입니다.
+type ExplainResponse struct {
+	Analyzed        bool              `json:"analyzed"`
+	TotalCost       float64           `json:"total_cost"`
+	EstimatedRows   float64           `json:"estimated_rows"`
+	PlanningTimeMs  *float64          `json:"planning_time_ms,omitempty"`
+	ExecutionTimeMs *float64          `json:"execution_time_ms,omitempty"`
+	Plan            *PlanNodeResponse `json:"plan"`
+	Raw             string            `json:"raw,omitempty"` // DB 원본 계획 (JSON 또는 텍스트)
+}
+
+// PlanNodeResponse는 DB 종류와 상관없는 공통 계획 노드입니다.
+type PlanNodeResponse struct {
+	Operation    string                 `json:"operation"`
+	Object       string                 `json:"object,omitempty"`
+	Cost         float64                `json:"cost"`
+	Rows         float64                `json:"rows"`
+	ActualRows   *float64               `json:"actual_rows,omitempty"`
+	ActualTimeMs *float64               `json:"actual_time_ms,omitempty"`
+	Details      map[string]interface{} `json:"details,omitempty"`
+	Children     []*PlanNodeResponse    `json:"children,omitempty"`
+}
+
+// FromDomainQueryPlan은 domain.QueryPlan을 ExplainResponse로 변환합니다.
+func FromDomainQueryPlan(plan *domain.QueryPlan) *ExplainResponse {
+	return &ExplainResponse{
+		Analyzed:        plan.Analyzed,
+		TotalCost:       plan.TotalCost(),
+		EstimatedRows:   plan.EstimatedRows(),
+		PlanningTimeMs:  plan.PlanningTimeMs,
+		ExecutionTimeMs: plan.ExecutionTimeMs,
+		Plan:            FromDomainPlanNode(plan.Root),
+		Raw:             plan.Raw,
+	}
+}
+
+// FromDomainPlanNode는 domain.PlanNode 트리를 재귀적으로 변환합니다.
+func FromDomainPlanNode(node *domain.PlanNode) *PlanNodeResponse {
+	if node == nil {
+		return nil
+	}
+
+	children := make([]*PlanNodeResponse, 0, len(node.Children))
+	for _, child := range node.Children {
+		children = append(children, FromDomainPlanNode(child))
+	}
+
+	return &PlanNodeResponse{
+		Operation:    node.Operation,
+		Object:       node.Object,
+		Cost:         node.Cost,
+		Rows:         node.Rows,
+		ActualRows:   node.ActualRows,
+		ActualTimeMs: node.ActualTimeMs,
+		Details:      node.Details,
+		Children:     children,
+	}
+}
+
+// FederatedQueryResponse는 페더레이션 쿼리 결과 응답입니다.
+// 최종 결과와 함께 소스별 컬럼 타입을 돌려줘서
+// 원본 DB에서 어떤 타입이었는지 확인할 수 있게 합니다.
+type FederatedQueryResponse struct {
+	Result  *QueryResultResponse      `json:"result"`
+	Sources []FederatedSourceResponse `json:"sources"`
+}
+
+// FederatedSourceResponse는 소스 하나의 결과 요약입니다 (row 데이터 제외).
+type FederatedSourceResponse struct {
+	Alias         string   `json:"alias"`
+	DatabaseID    string   `json:"database_id"`
+	Columns       []string `json:"columns"`
+	ColumnTypes   []string `json:"column_types,omitempty"`
+	RowCount      int      `json:"row_count"`
+	ExecutionTime string   `json:"execution_time"`
+}
+
+// FromDomainFederatedResult는 domain.FederatedResult를 FederatedQueryResponse로 변환합니다.
+func FromDomainFederatedResult(result *domain.FederatedResult) *FederatedQueryResponse {
+	sources := make([]FederatedSourceResponse, 0, len(result.Sources))
+	for _, t := range result.Sources {
+		sources = append(sources, FederatedSourceResponse{
+			Alias:         t.Source.Alias,
+			DatabaseID:    t.Source.DatabaseID,
+			Columns:       t.Result.Columns,
+			ColumnTypes:   t.Result.ColumnTypes,
+			RowCount:      t.Result.RowCount(),
+			ExecutionTime: t.Result.FormatExecutionTime(),
+		})
+	}
+
+	return &FederatedQueryResponse{
+		Result:  FromDomainQueryResult(result.Result),
+		Sources: sources,
+	}
+}
+
+// ResultDiffResponse는 결과 비교 응답입니다.
+type ResultDiffResponse struct {
+	Mode              string               `json:"mode"`
+	Identical         bool                 `json:"identical"`
+	KeyColumns        []string             `json:"key_columns"`
+	Columns           []string             `json:"columns"`
+	SourceOnlyColumns []string             `json:"source_only_columns,omitempty"`
+	TargetOnlyColumns []string             `json:"target_only_columns,omitempty"`
+	Summary           DiffSummaryResponse  `json:"summary"`
+	Added             []DiffRowResponse    `json:"added"`
+	Removed           []DiffRowResponse    `json:"removed"`
+	Changed           []ChangedRowResponse `json:"changed"`
+	Truncated         bool                 `json:"truncated"`
+}
+
+// DiffSummaryResponse는 비교 결과 개수 요약입니다.
+type DiffSummaryResponse struct {
+	SourceRows int `json:"source_rows"`
+	TargetRows int `json:"target_rows"`
+	Added      int `json:"added"`
+	Removed    int `json:"removed"`
+	Changed    int `json:"changed"`
+	Unchanged  int `json:"unchanged"`
+}
+
+// DiffRowResponse는 추가/삭제된 row입니다 (hash 모드는 key만).
+type DiffRowResponse struct {
+	Key    map[string]interface{} `json:"key"`
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// ChangedRowResponse는 값이 바뀐 row입니다 (hash 모드는 key만).
+type ChangedRowResponse struct {
+	Key         map[string]interface{} `json:"key"`
+	Differences []ColumnDiffResponse   `json:"differences,omitempty"`
+}
+
+// ColumnDiffResponse는 컬럼 하나의 차이입니다.
+type ColumnDiffResponse struct {
+	Column string      `json:"column"`
+	Source interface{} `json:"source"`
+	Target interface{} `json:"target"`
+}
+
+// FromDomainResultDiff는 domain.ResultDiff를 ResultDiffResponse로 변환합니다.
+func FromDomainResultDiff(diff *domain.ResultDiff) *ResultDiffResponse {
+	added := make([]DiffRowResponse, 0, len(diff.Added))
+	for _, row := range diff.Added {
+		added = append(added, DiffRowResponse{Key: row.Key, Values: row.Values})
+	}
+
+	removed := make([]DiffRowResponse, 0, len(diff.Removed))
+	for _, row := range diff.Removed {
+		removed = append(removed, DiffRowResponse{Key: row.Key, Values: row.Values})
+	}
+
+	changed := make([]ChangedRowResponse, 0, len(diff.Changed))
+	for _, row := range diff.Changed {
+		differences := make([]ColumnDiffResponse, 0, len(row.Differences))
+		for _, d := range row.Differences {
+			differences = append(differences, ColumnDiffResponse{
+				Column: d.Column,
+				Source: d.Source,
+				Target: d.Target,
+			})
+		}
+		changed = append(changed, ChangedRowResponse{Key: row.Key, Differences: differences})
+	}
+
+	return &ResultDiffResponse{
+		Mode:              string(diff.Mode),
+		Identical:         diff.IsIdentical(),
+		KeyColumns:        diff.KeyColumns,
+		Columns:           diff.Columns,
+		SourceOnlyColumns: diff.SourceOnlyColumns,
+		TargetOnlyColumns: diff.TargetOnlyColumns,
+		Summary: DiffSummaryResponse{
+			SourceRows: diff.Summary.SourceRows,
+			TargetRows: diff.Summary.TargetRows,
+			Added:      diff.Summary.Added,
+			Removed:    diff.Summary.Removed,
+			Changed:    diff.Summary.Changed,
+			Unchanged:  diff.Summary.Unchanged,
+		},
+		Added:     added,
+		Removed:   removed,
+		Changed:   changed,
+		Truncated: diff.Truncated,
+	}
 }
 
 // FromDomainList는 domain.Database 슬라이스를 DatabaseResponse 슬라이스로 변환합니다.
@@ -111,3 +660,834 @@ func FromDomainList(databases []*domain.Database) []*DatabaseResponse {
 //   "row_count": 2,
 //   "execution_time": "15ms"
 // }
+
+// DDLResponse는 생성한 DDL입니다.
+type DDLResponse struct {
+	Schema   string   `json:"schema"`
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Dialect  string   `json:"dialect"`
+	DDL      string   `json:"ddl"`
+	Warnings []string `json:"warnings,omitempty"` // 다른 방언으로 변환할 때만
+}
+
+// FromDomainDDL은 domain.DDLResult를 DDLResponse로 변환합니다.
+func FromDomainDDL(result *domain.DDLResult) DDLResponse {
+	return DDLResponse{
+		Schema:   result.Schema,
+		Name:     result.Name,
+		Type:     string(result.Type),
+		Dialect:  string(result.Dialect),
+		DDL:      result.DDL,
+		Warnings: result.Warnings,
+	}
+}
+
+// SchemaDiffResponse는 스키마 비교 결과입니다.
+type SchemaDiffResponse struct {
+	Source         SchemaRefResponse   `json:"source"`
+	Target         SchemaRefResponse   `json:"target"`
+	HasDifferences bool                `json:"has_differences"`
+	MissingTables  []string            `json:"missing_tables"` // source에만 있음
+	ExtraTables    []string            `json:"extra_tables"`   // target에만 있음
+	ChangedTables  []TableDiffResponse `json:"changed_tables"`
+	Migration      []string            `json:"migration"`               // target에 실행할 DDL (순서대로)
+	MigrationSQL   string              `json:"migration_sql,omitempty"` // 위 문장을 이어 붙인 스크립트
+	Warnings       []string            `json:"warnings,omitempty"`
+}
+
+// SchemaRefResponse는 비교한 한쪽 스키마입니다.
+type SchemaRefResponse struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseType string `json:"database_type"`
+	Schema       string `json:"schema"`
+}
+
+// TableDiffResponse는 양쪽에 있는 테이블 하나의 차이입니다.
+type TableDiffResponse struct {
+	Table              string                     `json:"table"`
+	MissingColumns     []string                   `json:"missing_columns,omitempty"`
+	ExtraColumns       []string                   `json:"extra_columns,omitempty"`
+	ColumnChanges      []ColumnChangeResponse     `json:"column_changes,omitempty"`
+	MissingIndexes     []string                   `json:"missing_indexes,omitempty"`
+	ExtraIndexes       []string                   `json:"extra_indexes,omitempty"`
+	ChangedIndexes     []DefinitionChangeResponse `json:"changed_indexes,omitempty"`
+	MissingConstraints []string                   `json:"missing_constraints,omitempty"`
+	ExtraConstraints   []string                   `json:"extra_constraints,omitempty"`
+	ChangedConstraints []DefinitionChangeResponse `json:"changed_constraints,omitempty"`
+}
+
+// ColumnChangeResponse는 컬럼 속성(type, nullable, default) 하나의 차이입니다.
+type ColumnChangeResponse struct {
+	Column   string `json:"column"`
+	Property string `json:"property"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+}
+
+// DefinitionChangeResponse는 이름은 같고 정의가 다른 인덱스/제약조건입니다.
+type DefinitionChangeResponse struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// FromDomainSchemaDiff는 domain.SchemaDiff를 SchemaDiffResponse로 변환합니다.
+func FromDomainSchemaDiff(diff *domain.SchemaDiff) SchemaDiffResponse {
+	response := SchemaDiffResponse{
+		Source:         fromDomainSchemaRef(diff.Source),
+		Target:         fromDomainSchemaRef(diff.Target),
+		HasDifferences: diff.HasDifferences(),
+		MissingTables:  nonNilStrings(diff.MissingTables),
+		ExtraTables:    nonNilStrings(diff.ExtraTables),
+		ChangedTables:  make([]TableDiffResponse, 0, len(diff.ChangedTables)),
+		Migration:      nonNilStrings(diff.Migration),
+		MigrationSQL:   strings.Join(diff.Migration, "\n\n"),
+		Warnings:       diff.Warnings,
+	}
+
+	for _, t := range diff.ChangedTables {
+		table := TableDiffResponse{
+			Table:              t.Table,
+			MissingColumns:     t.MissingColumns,
+			ExtraColumns:       t.ExtraColumns,
+			MissingIndexes:     t.MissingIndexes,
+			ExtraIndexes:       t.ExtraIndexes,
+			ChangedIndexes:     fromDomainDefinitionChanges(t.ChangedIndexes),
+			MissingConstraints: t.MissingConstraints,
+			ExtraConstraints:   t.ExtraConstraints,
+			ChangedConstraints: fromDomainDefinitionChanges(t.ChangedConstraints),
+		}
+		for _, c := range t.ColumnChanges {
+			table.ColumnChanges = append(table.ColumnChanges, ColumnChangeResponse{
+				Column:   c.Column,
+				Property: string(c.Property),
+				Source:   c.Source,
+				Target:   c.Target,
+			})
+		}
+		response.ChangedTables = append(response.ChangedTables, table)
+	}
+
+	return response
+}
+
+func fromDomainSchemaRef(ref domain.SchemaRef) SchemaRefResponse {
+	return SchemaRefResponse{
+		DatabaseID:   ref.DatabaseID,
+		DatabaseType: string(ref.DatabaseType),
+		Schema:       ref.Schema,
+	}
+}
+
+func fromDomainDefinitionChanges(changes []domain.DefinitionChange) []DefinitionChangeResponse {
+	var responses []DefinitionChangeResponse
+	for _, c := range changes {
+		responses = append(responses, DefinitionChangeResponse{Name: c.Name, Source: c.Source, Target: c.Target})
+	}
+	return responses
+}
+
+// nonNilStrings는 nil 슬라이스를 빈 슬라이스로 바꿉니다 (JSON에서 null 대신 []).
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// SnapshotVersionResponse는 저장된 스키마 스냅샷 하나의 요약입니다.
+type SnapshotVersionResponse struct {
+	DatabaseID  string `json:"database_id"`
+	Schema      string `json:"schema"`
+	Version     int    `json:"version"`
+	TakenAt     string `json:"taken_at"`
+	TableCount  int    `json:"table_count"`
+	ChangeCount int    `json:"change_count"` // 이전 버전 대비 변경 수
+}
+
+// SnapshotResultResponse는 스냅샷을 찍은 결과입니다.
+type SnapshotResultResponse struct {
+	Stored    bool                     `json:"stored"` // false면 이전 버전과 구조가 같아서 저장 안 함
+	Version   SnapshotVersionResponse  `json:"version"`
+	ChangeLog *SchemaChangeLogResponse `json:"change_log,omitempty"`
+}
+
+// SchemaChangeLogResponse는 한 버전에서 발견한 구조 변경 목록입니다.
+type SchemaChangeLogResponse struct {
+	DatabaseID      string                 `json:"database_id"`
+	Schema          string                 `json:"schema"`
+	Version         int                    `json:"version"`
+	PreviousVersion int                    `json:"previous_version"`
+	DetectedAt      string                 `json:"detected_at"`
+	Changes         []SchemaChangeResponse `json:"changes"`
+}
+
+// SchemaChangeResponse는 변경 하나입니다.
+type SchemaChangeResponse struct {
+	Type   string `json:"type"` // table_added, column_dropped, column_type_changed, index_added 등
+	Table  string `json:"table"`
+	Name   string `json:"name,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// SchemaSnapshotResponse는 스냅샷 전체(테이블 구조)입니다.
+type SchemaSnapshotResponse struct {
+	DatabaseID   string                  `json:"database_id"`
+	DatabaseType string                  `json:"database_type"`
+	Schema       string                  `json:"schema"`
+	TakenAt      string                  `json:"taken_at"`
+	Tables       []TableSnapshotResponse `json:"tables"`
+}
+
+// TableSnapshotResponse는 스냅샷 안의 테이블 하나입니다.
+type TableSnapshotResponse struct {
+	Name        string               `json:"name"`
+	Comment     string               `json:"comment,omitempty"`
+	Columns     []ColumnResponse     `json:"columns"`
+	Indexes     []IndexResponse      `json:"indexes"`
+	Constraints []ConstraintResponse `json:"constraints"`
+	ForeignKeys []ForeignKeyResponse `json:"foreign_keys"`
+}
+
+// FromDomainSnapshotVersion은 domain.SnapshotVersion을 SnapshotVersionResponse로 변환합니다.
+func FromDomainSnapshotVersion(v domain.SnapshotVersion) SnapshotVersionResponse {
+	return SnapshotVersionResponse{
+		DatabaseID:  v.DatabaseID,
+		Schema:      v.Schema,
+		Version:     v.Version,
+		TakenAt:     v.TakenAt.Format(time.RFC3339),
+		TableCount:  v.TableCount,
+		ChangeCount: v.ChangeCount,
+	}
+}
+
+// FromDomainSnapshotVersions는 버전 목록을 변환합니다.
+func FromDomainSnapshotVersions(versions []domain.SnapshotVersion) []SnapshotVersionResponse {
+	responses := make([]SnapshotVersionResponse, 0, len(versions))
+	for _, v := range versions {
+		responses = append(responses, FromDomainSnapshotVersion(v))
+	}
+	return responses
+}
+
+// FromDomainSnapshotResult는 domain.SnapshotResult를 SnapshotResultResponse로 변환합니다.
+func FromDomainSnapshotResult(result *domain.SnapshotResult) SnapshotResultResponse {
+	response := SnapshotResultResponse{
+		Stored:  result.Stored,
+		Version: FromDomainSnapshotVersion(result.Version),
+	}
+	if result.ChangeLog != nil {
+		changeLog := FromDomainSchemaChangeLog(*result.ChangeLog)
+		response.ChangeLog = &changeLog
+	}
+	return response
+}
+
+// FromDomainSchemaChangeLog는 domain.SchemaChangeLog를 SchemaChangeLogResponse로 변환합니다.
+func FromDomainSchemaChangeLog(log domain.SchemaChangeLog) SchemaChangeLogResponse {
+	response := SchemaChangeLogResponse{
+		DatabaseID:      log.DatabaseID,
+		Schema:          log.Schema,
+		Version:         log.Version,
+		PreviousVersion: log.PreviousVersion,
+		DetectedAt:      log.DetectedAt.Format(time.RFC3339),
+		Changes:         make([]SchemaChangeResponse, 0, len(log.Changes)),
+	}
+	for _, c := range log.Changes {
+		response.Changes = append(response.Changes, SchemaChangeResponse{
+			Type:   string(c.Type),
+			Table:  c.Table,
+			Name:   c.Name,
+			Before: c.Before,
+			After:  c.After,
+		})
+	}
+	return response
+}
+
+// FromDomainSchemaChangeLogs는 변경 로그 목록을 변환합니다.
+func FromDomainSchemaChangeLogs(logs []domain.SchemaChangeLog) []SchemaChangeLogResponse {
+	responses := make([]SchemaChangeLogResponse, 0, len(logs))
+	for _, l := range logs {
+		responses = append(responses, FromDomainSchemaChangeLog(l))
+	}
+	return responses
+}
+
+// FromDomainSchemaSnapshot은 domain.SchemaSnapshot을 SchemaSnapshotResponse로 변환합니다.
+func FromDomainSchemaSnapshot(snapshot *domain.SchemaSnapshot) SchemaSnapshotResponse {
+	response := SchemaSnapshotResponse{
+		DatabaseID:   snapshot.DatabaseID,
+		DatabaseType: string(snapshot.DatabaseType),
+		Schema:       snapshot.Schema,
+		TakenAt:      snapshot.TakenAt.Format(time.RFC3339),
+		Tables:       make([]TableSnapshotResponse, 0, len(snapshot.Tables)),
+	}
+
+	for _, t := range snapshot.Tables {
+		table := TableSnapshotResponse{
+			Name:        t.Name,
+			Columns:     FromDomainColumns(t.Columns),
+			Indexes:     []IndexResponse{},
+			Constraints: []ConstraintResponse{},
+			ForeignKeys: []ForeignKeyResponse{},
+		}
+		if t.Metadata != nil {
+			table.Comment = t.Metadata.Comment
+			table.Indexes = FromDomainIndexes(t.Metadata.Indexes)
+			table.Constraints = FromDomainConstraints(t.Metadata.Constraints)
+			table.ForeignKeys = FromDomainForeignKeys(t.Metadata.ForeignKeys)
+		}
+		response.Tables = append(response.Tables, table)
+	}
+
+	return response
+}
+
+// ERDiagramResponse는 ER 다이어그램입니다.
+type ERDiagramResponse struct {
+	DatabaseID    string   `json:"database_id"`
+	Schema        string   `json:"schema"`
+	Format        string   `json:"format"` // mermaid, dot
+	Tables        []string `json:"tables"`
+	Relationships int      `json:"relationships"`
+	Diagram       string   `json:"diagram"`
+}
+
+// FromDomainERDiagram은 domain.ERDiagram을 ERDiagramResponse로 변환합니다.
+func FromDomainERDiagram(diagram *domain.ERDiagram) ERDiagramResponse {
+	return ERDiagramResponse{
+		DatabaseID:    diagram.DatabaseID,
+		Schema:        diagram.Schema,
+		Format:        string(diagram.Format),
+		Tables:        nonNilStrings(diagram.Tables),
+		Relationships: diagram.Relationships,
+		Diagram:       diagram.Diagram,
+	}
+}
+
+// TableStatsResponse는 테이블 크기/활동 통계입니다.
+// DB가 제공하지 않는 값은 null입니다 (예: Oracle의 dead_tuples, 권한이 없을 때의 크기).
+type TableStatsResponse struct {
+	Schema        string  `json:"schema"`
+	Table         string  `json:"table"`
+	EstimatedRows *int64  `json:"estimated_rows"`
+	TableBytes    *int64  `json:"table_bytes"`
+	IndexBytes    *int64  `json:"index_bytes"`
+	ToastBytes    *int64  `json:"toast_bytes"` // Oracle은 LOB 세그먼트
+	TotalBytes    *int64  `json:"total_bytes"`
+	LastVacuum    *string `json:"last_vacuum"`
+	LastAnalyzed  *string `json:"last_analyzed"`
+	DeadTuples    *int64  `json:"dead_tuples"`
+	SeqScans      *int64  `json:"seq_scans"`
+	IndexScans    *int64  `json:"index_scans"`
+	Inserts       *int64  `json:"inserts"`
+	Updates       *int64  `json:"updates"`
+	Deletes       *int64  `json:"deletes"`
+}
+
+// FromDomainTableStats는 domain.TableStats를 TableStatsResponse로 변환합니다.
+func FromDomainTableStats(stats domain.TableStats) TableStatsResponse {
+	formatTime := func(t *time.Time) *string {
+		if t == nil {
+			return nil
+		}
+		s := t.Format(time.RFC3339)
+		return &s
+	}
+
+	return TableStatsResponse{
+		Schema:        stats.Schema,
+		Table:         stats.Table,
+		EstimatedRows: stats.EstimatedRows,
+		TableBytes:    stats.TableBytes,
+		IndexBytes:    stats.IndexBytes,
+		ToastBytes:    stats.ToastBytes,
+		TotalBytes:    stats.TotalBytes,
+		LastVacuum:    formatTime(stats.LastVacuum),
+		LastAnalyzed:  formatTime(stats.LastAnalyzed),
+		DeadTuples:    stats.DeadTuples,
+		SeqScans:      stats.SequentialScan,
+		IndexScans:    stats.IndexScan,
+		Inserts:       stats.Inserts,
+		Updates:       stats.Updates,
+		Deletes:       stats.Deletes,
+	}
+}
+
+// FromDomainTableStatsList는 통계 목록을 변환합니다.
+func FromDomainTableStatsList(stats []domain.TableStats) []TableStatsResponse {
+	responses := make([]TableStatsResponse, 0, len(stats))
+	for _, s := range stats {
+		responses = append(responses, FromDomainTableStats(s))
+	}
+	return responses
+}
+
+// SchemaSearchResponse는 스키마 검색 결과입니다.
+type SchemaSearchResponse struct {
+	Matches   []SchemaSearchMatchResponse `json:"matches"`
+	Count     int                         `json:"count"`
+	Truncated bool                        `json:"truncated"` // limit에서 잘림
+	Searched  int                         `json:"searched"`  // 검색한 DB 수
+	Errors    []SearchErrorResponse       `json:"errors,omitempty"`
+}
+
+// SchemaSearchMatchResponse는 검색 결과 하나입니다.
+type SchemaSearchMatchResponse struct {
+	DatabaseID   string `json:"database_id"`
+	DatabaseType string `json:"database_type"`
+	Schema       string `json:"schema"`
+	Table        string `json:"table"`
+	TableType    string `json:"table_type"`
+	Column       string `json:"column,omitempty"`
+	DataType     string `json:"data_type,omitempty"`
+	Comment      string `json:"comment,omitempty"`
+	MatchedField string `json:"matched_field"` // table, column, table_comment, column_comment
+}
+
+// SearchErrorResponse는 검색하지 못한 DB입니다.
+type SearchErrorResponse struct {
+	DatabaseID string `json:"database_id"`
+	Error      string `json:"error"`
+}
+
+// FromDomainSchemaSearch는 domain.SchemaSearchResult를 SchemaSearchResponse로 변환합니다.
+func FromDomainSchemaSearch(result *domain.SchemaSearchResult) SchemaSearchResponse {
+	response := SchemaSearchResponse{
+		Matches:   make([]SchemaSearchMatchResponse, 0, len(result.Matches)),
+		Count:     len(result.Matches),
+		Truncated: result.Truncated,
+		Searched:  result.Searched,
+	}
+
+	for _, m := range result.Matches {
+		response.Matches = append(response.Matches, SchemaSearchMatchResponse{
+			DatabaseID:   m.DatabaseID,
+			DatabaseType: string(m.DatabaseType),
+			Schema:       m.Schema,
+			Table:        m.Table,
+			TableType:    string(m.TableType),
+			Column:       m.Column,
+			DataType:     m.DataType,
+			Comment:      m.Comment,
+			MatchedField: string(m.MatchedField),
+		})
+	}
+
+	for _, e := range result.Errors {
+		response.Errors = append(response.Errors, SearchErrorResponse{DatabaseID: e.DatabaseID, Error: e.Message})
+	}
+
+	return response
+}
+
+// CompletionResponse는 SQL 자동완성 결과입니다.
+type CompletionResponse struct {
+	DatabaseID   string                   `json:"database_id"`
+	Context      string                   `json:"context"` // none, statement, table, column, qualified, keyword
+	Prefix       string                   `json:"prefix"`
+	ReplaceStart int                      `json:"replace_start"`
+	ReplaceEnd   int                      `json:"replace_end"`
+	Items        []CompletionItemResponse `json:"items"`
+	Truncated    bool                     `json:"truncated"`
+}
+
+// CompletionItemResponse는 자동완성 후보 하나입니다.
+type CompletionItemResponse struct {
+	Label      string `json:"label"`
+	Kind       string `json:"kind"` // schema, table, view, alias, column, function, keyword
+	Detail     string `json:"detail,omitempty"`
+	InsertText string `json:"insert_text"`
+}
+
+// FromDomainCompletion은 domain.CompletionResult를 CompletionResponse로 변환합니다.
+func FromDomainCompletion(result *domain.CompletionResult) CompletionResponse {
+	response := CompletionResponse{
+		DatabaseID:   result.DatabaseID,
+		Context:      string(result.Context),
+		Prefix:       result.Prefix,
+		ReplaceStart: result.ReplaceStart,
+		ReplaceEnd:   result.ReplaceEnd,
+		Items:        make([]CompletionItemResponse, 0, len(result.Items)),
+		Truncated:    result.Truncated,
+	}
+
+	for _, item := range result.Items {
+		response.Items = append(response.Items, CompletionItemResponse{
+			Label:      item.Label,
+			Kind:       string(item.Kind),
+			Detail:     item.Detail,
+			InsertText: item.InsertText,
+		})
+	}
+
+	return response
+}
+
+// ChangeLogEntryResponse는 DMS로 바꾼 내용 하나의 이력입니다.
+type ChangeLogEntryResponse struct {
+	ID         string `json:"id"`
+	DatabaseID string `json:"database_id"`
+	Schema     string `json:"schema"`
+	Table      string `json:"table"`
+	Column     string `json:"column,omitempty"`
+	Kind       string `json:"kind"` // table_comment, column_comment
+	Author     string `json:"author"`
+	ChangedAt  string `json:"changed_at"` // RFC3339
+	Before     string `json:"before"`
+	After      string `json:"after"`
+	Statement  string `json:"statement"`
+}
+
+// FromDomainChangeLogEntry는 domain.ChangeLogEntry를 ChangeLogEntryResponse로 변환합니다.
+func FromDomainChangeLogEntry(entry *domain.ChangeLogEntry) ChangeLogEntryResponse {
+	return ChangeLogEntryResponse{
+		ID:         entry.ID,
+		DatabaseID: entry.DatabaseID,
+		Schema:     entry.Schema,
+		Table:      entry.Table,
+		Column:     entry.Column,
+		Kind:       string(entry.Kind),
+		Author:     entry.Author,
+		ChangedAt:  entry.ChangedAt.Format(time.RFC3339),
+		Before:     entry.Before,
+		After:      entry.After,
+		Statement:  entry.Statement,
+	}
+}
+
+// FromDomainChangeLog는 변경 이력 목록을 변환합니다.
+func FromDomainChangeLog(entries []domain.ChangeLogEntry) []ChangeLogEntryResponse {
+	response := make([]ChangeLogEntryResponse, 0, len(entries))
+	for i := range entries {
+		response = append(response, FromDomainChangeLogEntry(&entries[i]))
+	}
+	return response
+}
+
+// TableProfileResponse는 컬럼 프로파일링 결과입니다.
+type TableProfileResponse struct {
+	DatabaseID    string                  `json:"database_id"`
+	Schema        string                  `json:"schema,omitempty"`
+	Table         string                  `json:"table,omitempty"`
+	Query         string                  `json:"query,omitempty"`
+	RowCount      int64                   `json:"row_count"` // 표본이면 표본의 row 수
+	Sampled       bool                    `json:"sampled"`
+	SamplePercent float64                 `json:"sample_percent,omitempty"`
+	Seed          int64                   `json:"seed,omitempty"` // 같은 표본을 다시 보려면 seed로 넘김
+	Columns       []ColumnProfileResponse `json:"columns"`
+	Queries       int                     `json:"queries"` // 실행한 쿼리 수
+	ExecutionTime string                  `json:"execution_time"`
+}
+
+// ColumnProfileResponse는 컬럼 하나의 프로파일입니다.
+// 컬럼 종류(category)에서 계산하지 않는 값은 생략됩니다.
+type ColumnProfileResponse struct {
+	Name            string                   `json:"name"`
+	DataType        string                   `json:"data_type"`
+	Category        string                   `json:"category"` // numeric, text, temporal, other, lob
+	NullCount       int64                    `json:"null_count"`
+	NullRatio       float64                  `json:"null_ratio"`
+	DistinctCount   *int64                   `json:"distinct_count,omitempty"`
+	DistinctApprox  bool                     `json:"distinct_approx,omitempty"`
+	Min             interface{}              `json:"min,omitempty"`
+	Max             interface{}              `json:"max,omitempty"`
+	Mean            *float64                 `json:"mean,omitempty"`
+	StdDev          *float64                 `json:"stddev,omitempty"`
+	MinLength       *int64                   `json:"min_length,omitempty"`
+	MaxLength       *int64                   `json:"max_length,omitempty"`
+	AvgLength       *float64                 `json:"avg_length,omitempty"`
+	LengthHistogram []LengthBucketResponse   `json:"length_histogram,omitempty"`
+	TopValues       []ValueFrequencyResponse `json:"top_values,omitempty"`
+}
+
+// LengthBucketResponse는 문자열 길이 분포의 구간 하나입니다 (from ≤ 길이 ≤ to).
+type LengthBucketResponse struct {
+	From  int64 `json:"from"`
+	To    int64 `json:"to"`
+	Count int64 `json:"count"`
+}
+
+// ValueFrequencyResponse는 최빈값 하나입니다.
+type ValueFrequencyResponse struct {
+	Value interface{} `json:"value"`
+	Count int64       `json:"count"`
+	Ratio float64     `json:"ratio"`
+}
+
+// FromDomainTableProfile은 domain.TableProfile을 TableProfileResponse로 변환합니다.
+func FromDomainTableProfile(profile *domain.TableProfile) TableProfileResponse {
+	columns := make([]ColumnProfileResponse, 0, len(profile.Columns))
+	for _, c := range profile.Columns {
+		col := ColumnProfileResponse{
+			Name:           c.Name,
+			DataType:       c.DataType,
+			Category:       string(c.Category),
+			NullCount:      c.NullCount,
+			NullRatio:      c.NullRatio,
+			DistinctCount:  c.DistinctCount,
+			DistinctApprox: c.DistinctApprox,
+			Min:            c.Min,
+			Max:            c.Max,
+			Mean:           c.Mean,
+			StdDev:         c.StdDev,
+			MinLength:      c.MinLength,
+			MaxLength:      c.MaxLength,
+			AvgLength:      c.AvgLength,
+		}
+		for _, b := range c.LengthHistogram {
+			col.LengthHistogram = append(col.LengthHistogram, LengthBucketResponse{From: b.From, To: b.To, Count: b.Count})
+		}
+		for _, v := range c.TopValues {
+			col.TopValues = append(col.TopValues, ValueFrequencyResponse{Value: v.Value, Count: v.Count, Ratio: v.Ratio})
+		}
+		columns = append(columns, col)
+	}
+
+	return TableProfileResponse{
+		DatabaseID:    profile.DatabaseID,
+		Schema:        profile.Schema,
+		Table:         profile.Table,
+		Query:         profile.Query,
+		RowCount:      profile.RowCount,
+		Sampled:       profile.Sampled,
+		SamplePercent: profile.SamplePercent,
+		Seed:          profile.Seed,
+		Columns:       columns,
+		Queries:       profile.Queries,
+		ExecutionTime: profile.ExecutionTime.String(),
+	}
+}
+
+// RowPageResponse는 테이블 데이터 한 페이지입니다.
+type RowPageResponse struct {
+	Schema        string                   `json:"schema,omitempty"`
+	Table         string                   `json:"table"`
+	Columns       []string                 `json:"columns"`
+	ColumnTypes   []string                 `json:"column_types"`
+	Rows          []map[string]interface{} `json:"rows"`
+	RowCount      int                      `json:"row_count"`
+	Limit         int                      `json:"limit"`
+	Offset        int                      `json:"offset"`
+	HasMore       bool                     `json:"has_more"`        // 다음 페이지 여부 (offset+limit으로 요청)
+	Total         *int64                   `json:"total,omitempty"` // count=true일 때만
+	Statement     string                   `json:"statement"`       // 실행한 SQL (값은 바인드 변수)
+	ExecutionTime string                   `json:"execution_time"`
+}
+
+// FromDomainRowPage는 domain.RowPage를 RowPageResponse로 변환합니다.
+func FromDomainRowPage(page *domain.RowPage) RowPageResponse {
+	return RowPageResponse{
+		Schema:        page.Schema,
+		Table:         page.Table,
+		Columns:       page.Columns,
+		ColumnTypes:   page.ColumnTypes,
+		Rows:          page.Rows,
+		RowCount:      len(page.Rows),
+		Limit:         page.Limit,
+		Offset:        page.Offset,
+		HasMore:       page.HasMore,
+		Total:         page.Total,
+		Statement:     page.Statement,
+		ExecutionTime: page.ExecutionTime.String(),
+	}
+}
+
+// RowChangeResponse는 row 추가/수정/삭제 결과입니다.
+type RowChangeResponse struct {
+	Schema      string                 `json:"schema,omitempty"`
+	Table       string                 `json:"table"`
+	Operation   string                 `json:"operation"`
+	Key         map[string]interface{} `json:"key,omitempty"`
+	Before      map[string]interface{} `json:"before,omitempty"` // insert는 없음
+	After       map[string]interface{} `json:"after,omitempty"`  // delete는 없음
+	Statement   string                 `json:"statement"`        // 실행한 SQL (값은 바인드 변수)
+	ChangeLogID string                 `json:"change_log_id"`
+	Warnings    []string               `json:"warnings,omitempty"`
+}
+
+// FromDomainRowChangeResult는 domain.RowChangeResult를 RowChangeResponse로 변환합니다.
+func FromDomainRowChangeResult(result *domain.RowChangeResult) RowChangeResponse {
+	return RowChangeResponse{
+		Schema:      result.Schema,
+		Table:       result.Table,
+		Operation:   string(result.Operation),
+		Key:         result.Key,
+		Before:      result.Before,
+		After:       result.After,
+		Statement:   result.Statement,
+		ChangeLogID: result.ChangeLogID,
+		Warnings:    result.Warnings,
+	}
+}
+
+// ImportResultResponse는 파일 가져오기 결과입니다.
+type ImportResultResponse struct {
+	Schema        string                `json:"schema,omitempty"`
+	Table         string                `json:"table"`
+	Format        string                `json:"format"`
+	Columns       []string              `json:"columns"`
+	Key           []string              `json:"key,omitempty"` // upsert 키
+	Truncated     bool                  `json:"truncated"`
+	DryRun        bool                  `json:"dry_run"`
+	Committed     bool                  `json:"committed"`
+	Aborted       string                `json:"aborted,omitempty"` // 오류가 max_errors를 넘어 롤백한 이유
+	Read          int64                 `json:"read"`
+	Loaded        int64                 `json:"loaded"`
+	Rejected      int64                 `json:"rejected"`
+	Errors        []ImportErrorResponse `json:"errors"` // 최대 1000개
+	Statement     string                `json:"statement"`
+	ChangeLogID   string                `json:"change_log_id,omitempty"`
+	ExecutionTime string                `json:"execution_time"`
+}
+
+// ImportErrorResponse는 넣지 못한 row 하나입니다.
+type ImportErrorResponse struct {
+	Line    int    `json:"line"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// FromDomainImportResult는 domain.ImportResult를 ImportResultResponse로 변환합니다.
+func FromDomainImportResult(result *domain.ImportResult) ImportResultResponse {
+	rowErrors := make([]ImportErrorResponse, len(result.Errors))
+	for i, e := range result.Errors {
+		rowErrors[i] = ImportErrorResponse{Line: e.Line, Column: e.Column, Message: e.Message}
+	}
+
+	return ImportResultResponse{
+		Schema:        result.Schema,
+		Table:         result.Table,
+		Format:        string(result.Format),
+		Columns:       result.Columns,
+		Key:           result.Key,
+		Truncated:     result.Truncated,
+		DryRun:        result.DryRun,
+		Committed:     result.Committed,
+		Aborted:       result.Aborted,
+		Read:          result.Read,
+		Loaded:        result.Loaded,
+		Rejected:      result.Rejected,
+		Errors:        rowErrors,
+		Statement:     result.Statement,
+		ChangeLogID:   result.ChangeLogID,
+		ExecutionTime: result.ExecutionTime.String(),
+	}
+}
+
+// CopyJobResponse는 테이블 복사 작업의 상태와 진행 상황입니다.
+type CopyJobResponse struct {
+	ID        string            `json:"id"`
+	Source    CopyTableResponse `json:"source"`
+	Target    CopyTableResponse `json:"target"`
+	Status    string            `json:"status"` // running, completed, failed
+	Phase     string            `json:"phase"`  // create, copy, finish, verify, done
+	Error     string            `json:"error,omitempty"`
+	Truncate  bool              `json:"truncate"`
+	BatchSize int               `json:"batch_size"`
+	Author    string            `json:"author"`
+
+	Columns []string `json:"columns"`
+	Key     []string `json:"key,omitempty"` // 원본을 읽는 순서 (기본 키 또는 지정한 유니크 키)
+
+	Created    bool     `json:"created"`              // 작업이 대상 테이블을 만듦
+	DDL        []string `json:"ddl,omitempty"`        // CREATE TABLE + 인덱스/주석/자동 증가 값
+	Warnings   []string `json:"warnings,omitempty"`   // 타입 변환 등에서 정확히 옮기지 못한 부분
+	Statement  string   `json:"statement,omitempty"`  // 대상에 넣는 SQL
+	Checkpoint []string `json:"checkpoint,omitempty"` // 마지막으로 커밋한 row의 키
+
+	SourceRows int64   `json:"source_rows"` // 시작할 때 센 원본 row 수
+	Copied     int64   `json:"copied"`
+	Batches    int64   `json:"batches"`
+	Progress   float64 `json:"progress"` // 0~100
+	Resumes    int     `json:"resumes"`
+
+	Verification *CopyVerificationResponse `json:"verification,omitempty"`
+	ChangeLogID  string                    `json:"change_log_id,omitempty"`
+
+	CreatedAt  string  `json:"created_at"` // RFC3339
+	UpdatedAt  string  `json:"updated_at"`
+	FinishedAt *string `json:"finished_at,omitempty"`
+}
+
+// CopyTableResponse는 복사의 한쪽(DB + 테이블)입니다.
+type CopyTableResponse struct {
+	DatabaseID string `json:"database_id"`
+	Schema     string `json:"schema,omitempty"`
+	Table      string `json:"table"`
+}
+
+// CopyVerificationResponse는 원본과 대상의 row 수 비교 결과입니다.
+type CopyVerificationResponse struct {
+	SourceRows int64  `json:"source_rows"`
+	TargetRows int64  `json:"target_rows"`
+	Matched    bool   `json:"matched"`
+	VerifiedAt string `json:"verified_at"`
+}
+
+// FromDomainCopyJob은 domain.CopyJob을 CopyJobResponse로 변환합니다.
+func FromDomainCopyJob(job *domain.CopyJob) CopyJobResponse {
+	resp := CopyJobResponse{
+		ID:          job.ID,
+		Source:      fromCopyEndpoint(job.Source),
+		Target:      fromCopyEndpoint(job.Target),
+		Status:      string(job.Status),
+		Phase:       string(job.Phase),
+		Error:       job.Error,
+		Truncate:    job.Truncate,
+		BatchSize:   job.BatchSize,
+		Author:      job.Author,
+		Columns:     make([]string, len(job.SourceColumns)),
+		Created:     job.Created,
+		Warnings:    job.Warnings,
+		Statement:   job.LoadStatement,
+		Checkpoint:  job.Checkpoint,
+		SourceRows:  job.SourceRows,
+		Copied:      job.Copied,
+		Batches:     job.Batches,
+		Progress:    job.Progress(),
+		Resumes:     job.Resumes,
+		ChangeLogID: job.ChangeLogID,
+		CreatedAt:   job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   job.UpdatedAt.Format(time.RFC3339),
+	}
+
+	for i, c := range job.SourceColumns {
+		resp.Columns[i] = domain.DisplayIdentifier(job.Source.Type, c.Name)
+	}
+	for _, c := range job.Key {
+		resp.Key = append(resp.Key, domain.DisplayIdentifier(job.Source.Type, c.Name))
+	}
+	if job.CreateStatement != "" {
+		resp.DDL = append([]string{job.CreateStatement}, job.FinishStatements...)
+	}
+	if job.Verification != nil {
+		resp.Verification = &CopyVerificationResponse{
+			SourceRows: job.Verification.SourceRows,
+			TargetRows: job.Verification.TargetRows,
+			Matched:    job.Verification.Matched,
+			VerifiedAt: job.Verification.VerifiedAt.Format(time.RFC3339),
+		}
+	}
+	if !job.FinishedAt.IsZero() {
+		finished := job.FinishedAt.Format(time.RFC3339)
+		resp.FinishedAt = &finished
+	}
+
+	return resp
+}
+
+// fromCopyEndpoint는 카탈로그 이름을 DisplayIdentifier로 정규화해서 보여줍니다.
+func fromCopyEndpoint(e domain.CopyEndpoint) CopyTableResponse {
+	resp := CopyTableResponse{
+		DatabaseID: e.DatabaseID,
+		Table:      domain.DisplayIdentifier(e.Type, e.Table),
+	}
+	if e.Schema != "" {
+		resp.Schema = domain.DisplayIdentifier(e.Type, e.Schema)
+	}
+	return resp
+}
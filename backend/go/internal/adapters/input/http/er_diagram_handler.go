@@ -0,0 +1,66 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// GetERDiagram은 외래 키 정보로 ER 다이어그램을 만듭니다.
+// HTTP: GET /databases/:dbID/er-diagram?schema=hr&tables=users,orders&hops=1&format=mermaid
+//
+// 쿼리 파라미터:
+//   - tables: 중심 테이블 (쉼표로 구분, 없으면 스키마 전체)
+//   - hops: 중심 테이블에서 외래 키를 따라갈 단계 (기본값 1, tables가 있을 때만)
+//   - format: mermaid(기본값) 또는 dot
+//   - raw=true: JSON 대신 다이어그램 텍스트만 text/plain으로 반환 (문서/렌더러에 바로 넣기 편하게)
+func (h *Handler) GetERDiagram(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	format, err := domain.ParseDiagramFormat(c.Query("format"))
+	if err != nil {
+		respondSchemaError(c, "invalid format", err)
+		return
+	}
+
+	options := domain.ERDiagramOptions{Hops: 1, Format: format}
+
+	if value := c.Query("tables"); value != "" {
+		for _, table := range strings.Split(value, ",") {
+			if table = strings.TrimSpace(table); table != "" {
+				options.Tables = append(options.Tables, table)
+			}
+		}
+	}
+
+	if value := c.Query("hops"); value != "" {
+		hops, err := strconv.Atoi(value)
+		if err != nil || hops < 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid hops",
+				Message: "hops must be a non-negative integer",
+			})
+			return
+		}
+		options.Hops = hops
+	}
+
+	diagram, err := h.service.GenerateERDiagram(c.Request.Context(), dbID, schema, options)
+	if err != nil {
+		respondSchemaError(c, "failed to generate ER diagram", err)
+		return
+	}
+
+	if c.Query("raw") == "true" {
+		c.String(http.StatusOK, diagram.Diagram)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainERDiagram(diagram))
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// ExplainQuery는 쿼리의 실행 계획을 공통 트리 형태로 반환합니다.
+// HTTP: POST /databases/:dbID/explain
+//
+// Postgres는 EXPLAIN (FORMAT JSON), Oracle은 EXPLAIN PLAN + DBMS_XPLAN을 사용하지만
+// 응답은 항상 같은 구조(operation, object, cost, rows, children)입니다.
+func (h *Handler) ExplainQuery(c *gin.Context) {
+	dbID := c.Param("dbID")
+
+	var req dto.ExplainRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	plan, err := h.service.ExplainQuery(ctx, dbID, req.Query, req.Analyze)
+	if err != nil {
+		errorResp := dto.ErrorResponse{
+			Error:   "explain failed",
+			Message: err.Error(),
+		}
+
+		statusCode := http.StatusInternalServerError
+
+		switch {
+		case errors.Is(err, domain.ErrDatabaseNotFound):
+			statusCode = http.StatusNotFound // 404
+			errorResp.Error = "database not found"
+
+		case errors.Is(err, domain.ErrDatabaseNotConnected):
+			statusCode = http.StatusServiceUnavailable // 503
+			errorResp.Error = "database not connected"
+
+		case errors.Is(err, domain.ErrExplainNotSupported):
+			statusCode = http.StatusBadRequest // 400
+			errorResp.Error = "explain not supported"
+		}
+
+		c.JSON(statusCode, errorResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainQueryPlan(plan))
+}
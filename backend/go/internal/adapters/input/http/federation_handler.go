@@ -0,0 +1,60 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// ExecuteFederatedQuery는 여러 DB에 걸친 페더레이션 쿼리를 실행합니다.
+// HTTP: POST /federated-query
+//
+// 각 소스의 서브 쿼리 결과를 in-memory SQLite 테이블로 적재한 뒤
+// 최종 SQL을 실행합니다. 최종 SQL은 SQLite 문법입니다.
+func (h *Handler) ExecuteFederatedQuery(c *gin.Context) {
+	var req dto.FederatedQueryRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	result, err := h.service.ExecuteFederatedQuery(ctx, req.ToDomain())
+	if err != nil {
+		errorResp := dto.ErrorResponse{
+			Error:   "federated query failed",
+			Message: err.Error(),
+		}
+
+		statusCode := http.StatusInternalServerError
+
+		// 서비스가 에러를 wrap해서 반환하므로 errors.Is로 확인합니다.
+		switch {
+		case errors.Is(err, domain.ErrInvalidFederation):
+			statusCode = http.StatusBadRequest // 400
+			errorResp.Error = "invalid federated query"
+
+		case errors.Is(err, domain.ErrFederationLimitExceeded):
+			statusCode = http.StatusUnprocessableEntity // 422
+			errorResp.Error = "federation limit exceeded"
+
+		case errors.Is(err, domain.ErrDatabaseNotConnected):
+			statusCode = http.StatusServiceUnavailable // 503
+			errorResp.Error = "database not connected"
+		}
+
+		c.JSON(statusCode, errorResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainFederatedResult(result))
+}
@@ -8,7 +8,10 @@
 package http
 
 import (
+	"errors"
 	"net/http" // HTTP 상태 코드 (200, 404 등)
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin" // Gin 웹 프레임워크
 
@@ -92,7 +95,22 @@ func (h *Handler) RegisterDatabase(c *gin.Context) {
 		Schema:   req.Schema,
 		Username: req.Username,
 		Password: req.Password,
-		Status:   domain.Disconnected, // 초기 상태
+		Status:   domain.Disconnected,  // 초기 상태
+		Guard:    req.Guard.ToDomain(), // nil이면 가드 없음
+		Write:    req.WritePolicy(),    // nil이면 읽기 전용
+	}
+
+	// cache_ttl은 "30s" 같은 문자열이므로 time.Duration으로 변환
+	if req.CacheTTL != "" {
+		ttl, err := time.ParseDuration(req.CacheTTL)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid request",
+				"details": "invalid cache_ttl: " + req.CacheTTL,
+			})
+			return
+		}
+		db.CacheTTL = ttl
 	}
 
 	// ==========================================
@@ -196,9 +214,33 @@ func (h *Handler) ExecuteQuery(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
+	// Cache-Control: no-cache 요청은 캐시를 무시하고 다시 실행합니다.
+	refresh := strings.Contains(c.GetHeader("Cache-Control"), "no-cache")
+
+	opts, err := req.ToOptions(refresh)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	// service.ExecuteQuery() 호출
-	result, err := h.service.ExecuteQuery(ctx, dbID, req.Query)
+	result, err := h.service.ExecuteQuery(ctx, dbID, req.Query, opts)
 	if err != nil {
+		// 비용 가드에 걸린 경우: 문제 노드를 응답에 담아서 반환
+		// errors.As()는 wrap된 에러 안에서 특정 타입을 꺼냅니다.
+		var guardErr *domain.QueryGuardError
+		if errors.As(err, &guardErr) {
+			statusCode := http.StatusUnprocessableEntity // 422 (reject)
+			if guardErr.Action == domain.GuardConfirm {
+				statusCode = http.StatusPreconditionRequired // 428 (confirm=true로 재요청)
+			}
+			c.JSON(statusCode, dto.FromDomainGuardError(guardErr))
+			return
+		}
+
 		// 에러 처리
 		errorResp := dto.ErrorResponse{
 			Error:   "query execution failed",
@@ -229,6 +271,11 @@ func (h *Handler) ExecuteQuery(c *gin.Context) {
 	// 4단계: 성공 응답
 	// ==========================================
 
+	// 캐시 헤더 설정 (ETag가 같으면 304 Not Modified)
+	if writeCacheHeaders(c, result.Cache) {
+		return
+	}
+
 	// Domain → DTO 변환
 	response := dto.FromDomainQueryResult(result)
 
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+)
+
+// RefreshMetadata는 DB 하나의 메타데이터 캐시를 비우고 메타데이터 인덱스를 다시 읽습니다.
+// HTTP: POST /databases/:dbID/metadata/refresh
+//
+// 이 서버를 거친 DDL은 자동으로 반영되므로, 다른 도구로 스키마를 바꿨을 때 사용합니다.
+func (h *Handler) RefreshMetadata(c *gin.Context) {
+	dbID := c.Param("dbID")
+
+	refresh, err := h.service.RefreshMetadata(c.Request.Context(), dbID)
+	if err != nil {
+		respondSchemaError(c, "metadata refresh failed", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainMetadataRefresh(refresh))
+}
+
+// InvalidateAllMetadata는 모든 DB의 메타데이터 캐시를 비웁니다.
+// HTTP: DELETE /metadata-cache
+func (h *Handler) InvalidateAllMetadata(c *gin.Context) {
+	removed, err := h.service.InvalidateMetadata(c.Request.Context(), "")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "metadata cache invalidation failed",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CacheInvalidateResponse{Removed: removed})
+}
+
+// GetMetadataCacheStats는 메타데이터 캐시의 항목 수, 크기, 적중률을 반환합니다.
+// HTTP: GET /metadata-cache/stats
+func (h *Handler) GetMetadataCacheStats(c *gin.Context) {
+	stats := h.service.MetadataCacheStats(c.Request.Context())
+	c.JSON(http.StatusOK, dto.FromDomainCacheStats(stats))
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// ProfileTable은 테이블의 컬럼별 데이터 프로파일을 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/profile?schema=hr&columns=email,salary&sample=5&top=10
+//
+// 쿼리 파라미터:
+//   - columns: 프로파일링할 컬럼 (쉼표 구분, 없으면 전체)
+//   - sample: 표본 비율(%) (0 < sample < 100, 없으면 전체 row)
+//   - seed: 표본 시드 (이전 응답의 seed를 넘기면 같은 표본)
+//   - top: 컬럼별 최빈값 개수 (기본값 10, 최대 100)
+//   - buckets: 문자열 길이 분포 구간 수 (기본값 10, 최대 100)
+//   - distinct: exact(기본값) 또는 approx (Oracle 19c의 APPROX_COUNT_DISTINCT)
+func (h *Handler) ProfileTable(c *gin.Context) {
+	req := domain.ProfileRequest{
+		Schema:  c.Query("schema"),
+		Table:   c.Param("table"),
+		Columns: splitList(c.Query("columns")),
+	}
+
+	if value := c.Query("sample"); value != "" {
+		percent, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid sample",
+				Message: "sample must be a percentage (e.g. 5 or 0.5)",
+			})
+			return
+		}
+		req.SamplePercent = percent
+	}
+
+	for name, target := range map[string]*int{"top": &req.TopN, "buckets": &req.LengthBuckets} {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid " + name,
+				Message: name + " must be a positive integer",
+			})
+			return
+		}
+		*target = n
+	}
+
+	if value := c.Query("seed"); value != "" {
+		seed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid seed",
+				Message: "seed must be an integer",
+			})
+			return
+		}
+		req.Seed = seed
+	}
+
+	switch c.Query("distinct") {
+	case "", "exact":
+	case "approx":
+		req.ApproxDistinct = true
+	default:
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid distinct",
+			Message: "distinct must be exact or approx",
+		})
+		return
+	}
+
+	h.respondProfile(c, req)
+}
+
+// ProfileQuery는 SELECT 쿼리 결과의 컬럼별 데이터 프로파일을 반환합니다.
+// HTTP: POST /databases/:dbID/profile
+//
+// Request Body 예시:
+//
+//	{"query": "SELECT * FROM orders WHERE created_at >= DATE '2024-01-01'", "top": 5}
+//
+// 쿼리 결과를 가져오지 않고 인라인 뷰로 감싸서 DB에서 집계합니다.
+func (h *Handler) ProfileQuery(c *gin.Context) {
+	var body dto.ProfileQueryRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	h.respondProfile(c, body.ToDomain())
+}
+
+func (h *Handler) respondProfile(c *gin.Context, req domain.ProfileRequest) {
+	profile, err := h.service.ProfileColumns(c.Request.Context(), c.Param("dbID"), req)
+	if err != nil {
+		respondSchemaError(c, "failed to profile columns", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainTableProfile(profile))
+}
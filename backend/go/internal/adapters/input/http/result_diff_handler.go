@@ -0,0 +1,70 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// DiffQueryResults는 두 DB(또는 두 쿼리)의 결과를 비교합니다.
+// HTTP: POST /result-diff
+//
+// 양쪽 쿼리는 SELECT만 허용하고, 실행 전에 각 DB의 비용 가드를 확인합니다.
+// confirm 가드에 걸리면 428을 반환하므로 "confirm": true로 다시 요청합니다.
+func (h *Handler) DiffQueryResults(c *gin.Context) {
+	var req dto.ResultDiffRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	diff, err := h.service.DiffQueryResults(ctx, req.ToDomain())
+	if err != nil {
+		// 비용 가드에 걸린 경우: ExecuteQuery와 같은 응답 (422 reject, 428 confirm)
+		var guardErr *domain.QueryGuardError
+		if errors.As(err, &guardErr) {
+			statusCode := http.StatusUnprocessableEntity
+			if guardErr.Action == domain.GuardConfirm {
+				statusCode = http.StatusPreconditionRequired
+			}
+			c.JSON(statusCode, dto.FromDomainGuardError(guardErr))
+			return
+		}
+
+		errorResp := dto.ErrorResponse{
+			Error:   "result diff failed",
+			Message: err.Error(),
+		}
+
+		statusCode := http.StatusInternalServerError
+
+		switch {
+		case errors.Is(err, domain.ErrInvalidDiff):
+			statusCode = http.StatusBadRequest // 400
+			errorResp.Error = "invalid result diff request"
+
+		case errors.Is(err, domain.ErrDiffLimitExceeded):
+			statusCode = http.StatusUnprocessableEntity // 422
+			errorResp.Error = "result diff limit exceeded"
+
+		case errors.Is(err, domain.ErrDatabaseNotConnected):
+			statusCode = http.StatusServiceUnavailable // 503
+			errorResp.Error = "database not connected"
+		}
+
+		c.JSON(statusCode, errorResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainResultDiff(diff))
+}
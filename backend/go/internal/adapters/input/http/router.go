@@ -17,7 +17,8 @@ func corsMiddleware(allowedOrigins []string) gin.HandlerFunc {
 		}
 
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Cache-Control, If-None-Match, X-DMS-User")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag, X-Cache, X-DDL-Warnings")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 
 		if c.Request.Method == "OPTIONS" {
@@ -69,6 +70,74 @@ func SetupRouter(handler *Handler, allowedOrigins []string) *gin.Engine {
 			databases.GET("/:dbID", handler.GetDatabaseInfo)
 			databases.DELETE("/:dbID", handler.DisconnectDatabase)
 			databases.POST("/:dbID/query", handler.ExecuteQuery)
+			databases.POST("/:dbID/explain", handler.ExplainQuery)
+			databases.POST("/:dbID/completions", handler.CompleteSQL)
+			databases.POST("/:dbID/profile", handler.ProfileQuery)
+			databases.DELETE("/:dbID/cache", handler.InvalidateDatabaseCache)
+			databases.POST("/:dbID/metadata/refresh", handler.RefreshMetadata)
+
+			// 스키마 브라우저
+			databases.GET("/:dbID/schemas", handler.GetSchemas)
+			databases.GET("/:dbID/tables", handler.GetTables)
+			databases.GET("/:dbID/tables/:table/columns", handler.GetColumns)
+			databases.PUT("/:dbID/tables/:table/comment", handler.SetTableComment)
+			databases.DELETE("/:dbID/tables/:table/comment", handler.ClearTableComment)
+			databases.PUT("/:dbID/tables/:table/columns/:column/comment", handler.SetColumnComment)
+			databases.DELETE("/:dbID/tables/:table/columns/:column/comment", handler.ClearColumnComment)
+			databases.GET("/:dbID/tables/:table/indexes", handler.GetIndexes)
+			databases.GET("/:dbID/tables/:table/constraints", handler.GetConstraints)
+			databases.GET("/:dbID/tables/:table/foreign-keys", handler.GetForeignKeys)
+			databases.GET("/:dbID/tables/:table/stats", handler.GetTableStats)
+			databases.GET("/:dbID/tables/:table/profile", handler.ProfileTable)
+			databases.GET("/:dbID/tables/:table/rows", handler.BrowseRows)
+			databases.POST("/:dbID/tables/:table/rows", handler.InsertRow)
+			databases.PATCH("/:dbID/tables/:table/rows", handler.UpdateRow)
+			databases.DELETE("/:dbID/tables/:table/rows", handler.DeleteRow)
+			databases.POST("/:dbID/tables/:table/import", handler.ImportRows)
+			databases.GET("/:dbID/table-stats", handler.ListTableStats)
+			databases.GET("/:dbID/objects", handler.ListObjects)
+			databases.GET("/:dbID/objects/:type/:name", handler.GetObject)
+			databases.GET("/:dbID/ddl/:type/:name", handler.GetDDL)
+			databases.GET("/:dbID/er-diagram", handler.GetERDiagram)
+			databases.GET("/:dbID/data-dictionary", handler.GetDataDictionary)
+
+			// 스키마 스냅샷 이력 (구조 변경 감지)
+			databases.GET("/:dbID/snapshots", handler.ListSnapshots)
+			databases.POST("/:dbID/snapshots", handler.CaptureSnapshot)
+			databases.GET("/:dbID/snapshots/:version", handler.GetSnapshot)
+			databases.GET("/:dbID/schema-changes", handler.ListSchemaChanges)
+
+			// DMS로 바꾼 내용의 변경 이력 (주석 편집 등)
+			databases.GET("/:dbID/change-log", handler.ListChangeLog)
+		}
+
+		// 쿼리 결과 캐시
+		cache := v1.Group("/cache")
+		{
+			cache.GET("/stats", handler.GetCacheStats)
+			cache.DELETE("", handler.InvalidateAllCache)
+		}
+
+		// 메타데이터 캐시 (스키마 브라우저, 검색, 자동완성)
+		metadataCache := v1.Group("/metadata-cache")
+		{
+			metadataCache.GET("/stats", handler.GetMetadataCacheStats)
+			metadataCache.DELETE("", handler.InvalidateAllMetadata)
+		}
+
+		// 여러 DB에 걸친 쿼리
+		v1.POST("/federated-query", handler.ExecuteFederatedQuery)
+		v1.POST("/result-diff", handler.DiffQueryResults)
+		v1.POST("/schema-diff", handler.DiffSchemas)
+		v1.GET("/schema-search", handler.SearchSchema)
+
+		// 테이블 복사 작업 (백그라운드 실행, 진행 상황 조회, 실패하면 이어서 실행)
+		copyJobs := v1.Group("/copy-jobs")
+		{
+			copyJobs.GET("", handler.ListCopyJobs)
+			copyJobs.POST("", handler.StartCopyJob)
+			copyJobs.GET("/:id", handler.GetCopyJob)
+			copyJobs.POST("/:id/resume", handler.ResumeCopyJob)
 		}
 	}
 	// 등으로 변경됨
@@ -95,3 +164,115 @@ func SetupRouter(handler *Handler, allowedOrigins []string) *gin.Engine {
 // POST /databases/postgres-prod/query
 // → handler.ExecuteQuery()
 //    dbID = "postgres-prod"
+//
+// POST /databases/postgres-prod/explain
+// → handler.ExplainQuery()
+//    dbID = "postgres-prod"
+//
+// POST /databases/postgres-prod/completions
+// → handler.CompleteSQL()
+//    dbID = "postgres-prod"
+//
+// GET /databases/postgres-prod/tables?type=view
+// → handler.GetTables()
+//    dbID = "postgres-prod"
+//
+// GET /databases/postgres-prod/tables/users/columns
+// → handler.GetColumns()
+//    dbID = "postgres-prod", table = "users"
+//
+// GET /databases/postgres-prod/tables/users/foreign-keys
+// → handler.GetForeignKeys()
+//    dbID = "postgres-prod", table = "users"
+//
+// GET /databases/oracle-prod/tables/students/rows?filter=status:eq:active&sort=-admitted_at&limit=50
+// → handler.BrowseRows()
+//    dbID = "oracle-prod", table = "students"
+//
+// PATCH /databases/oracle-prod/tables/students/rows
+// → handler.UpdateRow()
+//    dbID = "oracle-prod", table = "students"
+//
+// POST /databases/postgres-prod/tables/users/import?format=csv&upsert=true
+// → handler.ImportRows()
+//    dbID = "postgres-prod", table = "users"
+//
+// GET /databases/postgres-prod/tables/orders/profile?sample=5&columns=status,amount
+// → handler.ProfileTable()
+//    dbID = "postgres-prod", table = "orders"
+//
+// GET /databases/postgres-prod/table-stats?sort=dead_tuples&limit=20
+// → handler.ListTableStats()
+//    dbID = "postgres-prod"
+//
+// GET /databases/postgres-prod/objects/view/active_users
+// → handler.GetObject()
+//    dbID = "postgres-prod", type = "view", name = "active_users"
+//
+// GET /databases/oracle-prod/ddl/table/students?dialect=postgres
+// → handler.GetDDL()
+//    dbID = "oracle-prod", type = "table", name = "students"
+//
+// GET /databases/postgres-prod/er-diagram?tables=users&hops=2&format=dot
+// → handler.GetERDiagram()
+//    dbID = "postgres-prod"
+//
+// GET /databases/oracle-prod/data-dictionary?schema=hr&format=xlsx
+// → handler.GetDataDictionary()
+//    dbID = "oracle-prod"
+//
+// GET /databases/postgres-prod/schema-changes?since=2024-01-01T00:00:00Z
+// → handler.ListSchemaChanges()
+//    dbID = "postgres-prod"
+//
+// PUT /databases/oracle-prod/tables/employees/columns/emp_no/comment
+// → handler.SetColumnComment()
+//    dbID = "oracle-prod", table = "employees", column = "emp_no"
+//
+// GET /databases/oracle-prod/change-log?table=employees
+// → handler.ListChangeLog()
+//    dbID = "oracle-prod"
+//
+// POST /copy-jobs
+// → handler.StartCopyJob()
+//    body: {"source": {"database_id": "oracle-prod", ...}, "target": {"database_id": "postgres-prod", ...}}
+//
+// GET /copy-jobs/m2x8k1q0a7
+// → handler.GetCopyJob()
+//    id = "m2x8k1q0a7"
+//
+// POST /copy-jobs/m2x8k1q0a7/resume
+// → handler.ResumeCopyJob()
+//    id = "m2x8k1q0a7"
+//
+// DELETE /databases/postgres-prod/cache
+// → handler.InvalidateDatabaseCache()
+//    dbID = "postgres-prod"
+//
+// GET /cache/stats
+// → handler.GetCacheStats()
+//
+// DELETE /cache
+// → handler.InvalidateAllCache()
+//
+// POST /databases/postgres-prod/metadata/refresh
+// → handler.RefreshMetadata()
+//    dbID = "postgres-prod"
+//
+// GET /metadata-cache/stats
+// → handler.GetMetadataCacheStats()
+//
+// DELETE /metadata-cache
+// → handler.InvalidateAllMetadata()
+//
+// POST /federated-query
+// → handler.ExecuteFederatedQuery()
+//
+// POST /result-diff
+// → handler.DiffQueryResults()
+//
+// POST /schema-diff
+// → handler.DiffSchemas()
+//
+// GET /schema-search?q=student_no&fields=column
+// → handler.SearchSchema()
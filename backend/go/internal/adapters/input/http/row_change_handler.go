@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// InsertRow는 테이블에 row 하나를 추가합니다.
+// HTTP: POST /databases/:dbID/tables/:table/rows?schema=hr
+//
+// Request Body 예시:
+//
+//	{"values": {"student_no": 20240001, "name": "홍길동", "admitted_at": "2024-03-02"}}
+//
+// 헤더 X-DMS-User의 사용자가 DB 설정의 rows 쓰기 권한에 있어야 합니다.
+// 응답의 after는 DB가 채운 기본값과 자동 증가 값을 포함한 row입니다.
+func (h *Handler) InsertRow(c *gin.Context) {
+	h.changeRow(c, domain.RowInsert)
+}
+
+// UpdateRow는 기본 키로 row 하나를 수정합니다.
+// HTTP: PATCH /databases/:dbID/tables/:table/rows?schema=hr
+//
+// Request Body 예시:
+//
+//	{"key": {"student_no": 20240001}, "values": {"status": "graduated"}}
+//
+// 정확히 1개 row가 바뀌지 않으면 롤백합니다 (row가 없으면 404).
+func (h *Handler) UpdateRow(c *gin.Context) {
+	h.changeRow(c, domain.RowUpdate)
+}
+
+// DeleteRow는 기본 키로 row 하나를 삭제합니다.
+// HTTP: DELETE /databases/:dbID/tables/:table/rows?schema=hr
+//
+// Request Body 예시:
+//
+//	{"key": {"student_no": 20240001}}
+func (h *Handler) DeleteRow(c *gin.Context) {
+	h.changeRow(c, domain.RowDelete)
+}
+
+func (h *Handler) changeRow(c *gin.Context, operation domain.RowOperation) {
+	// 숫자는 json.Number로 읽습니다.
+	// float64로 읽으면 2^53보다 큰 키가 반올림되어 다른 row를 바꿀 수 있기 때문!
+	var req dto.RowChangeRequest
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	change := domain.RowChange{
+		Schema:    c.Query("schema"),
+		Table:     c.Param("table"),
+		Operation: operation,
+		Key:       req.Key,
+		Values:    req.Values,
+		Author:    c.GetHeader(AuthorHeader),
+	}
+
+	result, err := h.service.ChangeRow(c.Request.Context(), c.Param("dbID"), change)
+	if err != nil {
+		respondSchemaError(c, "failed to "+string(operation)+" row", err)
+		return
+	}
+
+	status := http.StatusOK
+	if operation == domain.RowInsert {
+		status = http.StatusCreated
+	}
+	c.JSON(status, dto.FromDomainRowChangeResult(result))
+}
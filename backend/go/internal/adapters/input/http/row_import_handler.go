@@ -0,0 +1,150 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// ImportRows는 CSV 또는 JSON Lines 파일을 기존 테이블에 넣습니다.
+// HTTP: POST /databases/:dbID/tables/:table/import?schema=hr&format=csv&upsert=true&max_errors=10
+//
+// 파일은 multipart 폼의 file 필드로 올리거나 요청 본문에 그대로 보냅니다.
+// 본문은 메모리에 모두 올리지 않고 묶음 단위로 읽으면서 넣습니다.
+//
+// 쿼리 파라미터:
+//   - format: csv, jsonl (없으면 파일 확장자나 Content-Type으로 판단, 기본값 csv)
+//   - delimiter: CSV 구분자 (기본값 쉼표, tab은 탭)
+//   - null: CSV에서 NULL로 볼 값 (기본값: 빈 값)
+//   - truncate: true면 넣기 전에 테이블을 비움
+//   - upsert: true면 키가 같은 row는 바꿈 (key: 키 컬럼, 쉼표 구분, 없으면 기본 키)
+//   - max_errors: 건너뛸 수 있는 오류 row 수 (기본값 0, -1이면 제한 없음)
+//   - dry_run: true면 끝까지 실행한 뒤 롤백
+//   - batch: 한 번에 넣을 row 수 (기본값 1000, 최대 10000)
+//
+// 헤더 X-DMS-User의 사용자가 DB 설정의 rows 쓰기 권한에 있어야 합니다.
+// 오류 row가 max_errors를 넘어서 롤백되면 422와 함께 오류 목록을 반환합니다.
+func (h *Handler) ImportRows(c *gin.Context) {
+	req := domain.ImportRequest{
+		Schema: c.Query("schema"),
+		Table:  c.Param("table"),
+		Null:   c.Query("null"),
+		Key:    splitList(c.Query("key")),
+		Author: c.GetHeader(AuthorHeader),
+	}
+
+	for name, target := range map[string]*bool{"truncate": &req.Truncate, "upsert": &req.Upsert, "dry_run": &req.DryRun} {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid " + name,
+				Message: name + " must be true or false",
+			})
+			return
+		}
+		*target = b
+	}
+
+	for name, target := range map[string]*int{"max_errors": &req.MaxErrors, "batch": &req.BatchSize} {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid " + name,
+				Message: name + " must be an integer",
+			})
+			return
+		}
+		*target = n
+	}
+
+	if value := c.Query("delimiter"); value != "" {
+		if strings.EqualFold(value, "tab") {
+			value = "\t"
+		}
+		r, size := utf8.DecodeRuneInString(value)
+		if size != len(value) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid delimiter",
+				Message: "delimiter must be a single character (or tab)",
+			})
+			return
+		}
+		req.Delimiter = r
+	}
+
+	var data io.Reader = c.Request.Body
+	filename := ""
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		header, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "invalid request",
+				"details": "multipart upload needs a file field: " + err.Error(),
+			})
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			respondSchemaError(c, "failed to read upload", err)
+			return
+		}
+		defer file.Close()
+		data, filename = file, header.Filename
+	}
+	req.Data = data
+
+	format, err := importFormat(c.Query("format"), filename, c.ContentType())
+	if err != nil {
+		respondSchemaError(c, "invalid format", err)
+		return
+	}
+	req.Format = format
+
+	result, err := h.service.ImportRows(c.Request.Context(), c.Param("dbID"), req)
+	if err != nil {
+		respondSchemaError(c, "failed to import rows", err)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Aborted != "" {
+		status = http.StatusUnprocessableEntity
+	}
+	c.JSON(status, dto.FromDomainImportResult(result))
+}
+
+// importFormat은 format 파라미터, 파일 확장자, Content-Type 순서로 형식을 정합니다.
+func importFormat(format, filename, contentType string) (domain.ImportFormat, error) {
+	if format != "" {
+		return domain.ParseImportFormat(format)
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".jsonl", ".ndjson":
+		return domain.ImportJSONLines, nil
+	case ".csv":
+		return domain.ImportCSV, nil
+	}
+
+	switch contentType {
+	case "application/x-ndjson", "application/jsonl", "application/x-jsonlines":
+		return domain.ImportJSONLines, nil
+	}
+	return domain.ImportCSV, nil
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+)
+
+// DiffSchemas는 두 DB(스키마)의 구조를 비교합니다.
+// HTTP: POST /schema-diff
+//
+// 요청 예:
+//
+//	{
+//	  "source": {"database_id": "pg-dev", "schema": "public"},
+//	  "target": {"database_id": "pg-prod", "schema": "public"},
+//	  "allow_drop": false
+//	}
+//
+// 응답의 migration은 target에서 실행하면 source와 같아지는 DDL입니다.
+// 같은 종류의 DB끼리만 만들어지며, 지우는 문장은 allow_drop일 때만 포함됩니다.
+func (h *Handler) DiffSchemas(c *gin.Context) {
+	var req dto.SchemaDiffRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "invalid request",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	source, target, options := req.ToDomain()
+
+	diff, err := h.service.DiffSchemas(c.Request.Context(), source, target, options)
+	if err != nil {
+		respondSchemaError(c, "schema diff failed", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSchemaDiff(diff))
+}
@@ -0,0 +1,339 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// GetSchemas는 스키마 목록을 반환합니다.
+// HTTP: GET /databases/:dbID/schemas
+func (h *Handler) GetSchemas(c *gin.Context) {
+	dbID := c.Param("dbID")
+
+	schemas, err := h.service.GetSchemas(c.Request.Context(), dbID)
+	if err != nil {
+		respondSchemaError(c, "failed to get schemas", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schemas": schemas,
+		"count":   len(schemas),
+	})
+}
+
+// GetTables는 테이블과 뷰 목록을 반환합니다.
+// HTTP: GET /databases/:dbID/tables?schema=hr&type=table,view
+//
+// schema 쿼리 파라미터가 없으면 DB 설정의 기본 스키마를 사용합니다.
+// type 쿼리 파라미터로 종류를 거를 수 있습니다 (쉼표로 여러 개).
+func (h *Handler) GetTables(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	tables, err := h.service.GetTables(c.Request.Context(), dbID, schema)
+	if err != nil {
+		respondSchemaError(c, "failed to get tables", err)
+		return
+	}
+
+	if filter := c.Query("type"); filter != "" {
+		wanted := make(map[domain.TableType]bool)
+		for _, t := range strings.Split(filter, ",") {
+			wanted[domain.TableType(strings.TrimSpace(t))] = true
+		}
+
+		filtered := tables[:0]
+		for _, t := range tables {
+			if wanted[t.Type] {
+				filtered = append(filtered, t)
+			}
+		}
+		tables = filtered
+	}
+
+	response := dto.FromDomainTables(tables)
+
+	c.JSON(http.StatusOK, gin.H{
+		"tables": response,
+		"count":  len(response),
+	})
+}
+
+// GetColumns는 테이블(또는 뷰)의 컬럼 목록을 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/columns?schema=hr
+func (h *Handler) GetColumns(c *gin.Context) {
+	dbID := c.Param("dbID")
+	table := c.Param("table")
+	schema := c.Query("schema")
+
+	columns, err := h.service.GetColumns(c.Request.Context(), dbID, schema, table)
+	if err != nil {
+		respondSchemaError(c, "failed to get columns", err)
+		return
+	}
+
+	response := dto.FromDomainColumns(columns)
+
+	c.JSON(http.StatusOK, gin.H{
+		"table":   table,
+		"columns": response,
+		"count":   len(response),
+	})
+}
+
+// GetIndexes는 테이블의 인덱스 목록을 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/indexes?schema=hr
+func (h *Handler) GetIndexes(c *gin.Context) {
+	metadata, ok := h.tableMetadata(c)
+	if !ok {
+		return
+	}
+
+	response := dto.FromDomainIndexes(metadata.Indexes)
+
+	c.JSON(http.StatusOK, gin.H{
+		"schema":  metadata.Schema,
+		"table":   metadata.Table,
+		"indexes": response,
+		"count":   len(response),
+	})
+}
+
+// GetConstraints는 테이블의 기본 키, 유니크, 체크 제약조건을 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/constraints?schema=hr
+func (h *Handler) GetConstraints(c *gin.Context) {
+	metadata, ok := h.tableMetadata(c)
+	if !ok {
+		return
+	}
+
+	response := dto.FromDomainConstraints(metadata.Constraints)
+
+	c.JSON(http.StatusOK, gin.H{
+		"schema":      metadata.Schema,
+		"table":       metadata.Table,
+		"constraints": response,
+		"count":       len(response),
+	})
+}
+
+// GetForeignKeys는 테이블의 외래 키를 방향별로 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/foreign-keys?schema=hr
+//
+//   - outgoing: 이 테이블이 참조하는 외래 키
+//   - incoming: 이 테이블을 참조하는 다른 테이블의 외래 키
+func (h *Handler) GetForeignKeys(c *gin.Context) {
+	metadata, ok := h.tableMetadata(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"schema":   metadata.Schema,
+		"table":    metadata.Table,
+		"outgoing": dto.FromDomainForeignKeys(metadata.ForeignKeys),
+		"incoming": dto.FromDomainForeignKeys(metadata.ReferencedBy),
+	})
+}
+
+// tableMetadata는 URL의 테이블 메타데이터를 조회합니다.
+// 실패하면 에러 응답을 쓰고 false를 반환합니다.
+func (h *Handler) tableMetadata(c *gin.Context) (*domain.TableMetadata, bool) {
+	dbID := c.Param("dbID")
+	table := c.Param("table")
+	schema := c.Query("schema")
+
+	metadata, err := h.service.GetTableMetadata(c.Request.Context(), dbID, schema, table)
+	if err != nil {
+		respondSchemaError(c, "failed to get table metadata", err)
+		return nil, false
+	}
+
+	return metadata, true
+}
+
+// ListObjects는 뷰, 함수, 트리거 같은 객체 목록을 반환합니다.
+// HTTP: GET /databases/:dbID/objects?schema=hr&type=view,function
+//
+// type 쿼리 파라미터 (쉼표로 여러 개, 없으면 전체):
+// view, materialized_view, function, procedure, package, trigger, sequence
+func (h *Handler) ListObjects(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	types, err := domain.ParseObjectTypes(c.Query("type"))
+	if err != nil {
+		respondSchemaError(c, "invalid object type", err)
+		return
+	}
+
+	objects, err := h.service.ListObjects(c.Request.Context(), dbID, schema, types)
+	if err != nil {
+		respondSchemaError(c, "failed to list objects", err)
+		return
+	}
+
+	response := dto.FromDomainObjects(objects)
+
+	c.JSON(http.StatusOK, gin.H{
+		"objects": response,
+		"count":   len(response),
+	})
+}
+
+// GetObject는 객체 하나의 정의(소스)를 반환합니다.
+// HTTP: GET /databases/:dbID/objects/:type/:name?schema=hr
+//
+// 예: /objects/view/active_users, /objects/function/add_user(name text)
+func (h *Handler) GetObject(c *gin.Context) {
+	dbID := c.Param("dbID")
+	objectType := domain.ObjectType(c.Param("type"))
+	name := c.Param("name")
+	schema := c.Query("schema")
+
+	object, err := h.service.GetObject(c.Request.Context(), dbID, schema, objectType, name)
+	if err != nil {
+		respondSchemaError(c, "failed to get object", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainObject(object))
+}
+
+// respondSchemaError는 스키마 조회 에러를 HTTP 상태 코드로 변환합니다.
+func respondSchemaError(c *gin.Context, message string, err error) {
+	errorResp := dto.ErrorResponse{
+		Error:   message,
+		Message: err.Error(),
+	}
+
+	statusCode := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, domain.ErrDatabaseNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "database not found"
+
+	case errors.Is(err, domain.ErrDatabaseNotConnected):
+		statusCode = http.StatusServiceUnavailable // 503
+		errorResp.Error = "database not connected"
+
+	case errors.Is(err, domain.ErrSchemaNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "schema not found"
+
+	case errors.Is(err, domain.ErrTableNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "table not found"
+
+	case errors.Is(err, domain.ErrColumnNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "column not found"
+
+	case errors.Is(err, domain.ErrAuthorRequired):
+		statusCode = http.StatusUnauthorized // 401
+		errorResp.Error = "author required"
+		errorResp.Message = "set the X-DMS-User header to the user making the change"
+
+	case errors.Is(err, domain.ErrWriteNotAllowed):
+		statusCode = http.StatusForbidden // 403
+		errorResp.Error = "write not allowed"
+
+	case errors.Is(err, domain.ErrCommentTooLong):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "comment too long"
+
+	case errors.Is(err, domain.ErrObjectNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "object not found"
+
+	case errors.Is(err, domain.ErrInvalidObjectType):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid object type"
+
+	case errors.Is(err, domain.ErrUnsupportedDialect):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "unsupported dialect"
+
+	case errors.Is(err, domain.ErrDDLNotSupported):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "DDL conversion not supported"
+
+	case errors.Is(err, domain.ErrInvalidDiagramFormat):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid diagram format"
+
+	case errors.Is(err, domain.ErrInvalidDictionaryFormat):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid dictionary format"
+
+	case errors.Is(err, domain.ErrInvalidStatsSort):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid sort field"
+
+	case errors.Is(err, domain.ErrInvalidProfile):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid profile request"
+
+	case errors.Is(err, domain.ErrInvalidRowQuery):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid row query"
+
+	case errors.Is(err, domain.ErrInvalidValue):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid value"
+
+	case errors.Is(err, domain.ErrRowNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "row not found"
+
+	case errors.Is(err, domain.ErrNoPrimaryKey):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "table has no primary key"
+
+	case errors.Is(err, domain.ErrUnexpectedRowCount):
+		statusCode = http.StatusConflict // 409
+		errorResp.Error = "unexpected row count"
+
+	case errors.Is(err, domain.ErrInvalidRowChange):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid row change"
+
+	case errors.Is(err, domain.ErrInvalidImport):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid import"
+
+	case errors.Is(err, domain.ErrInvalidCopy):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid copy job"
+
+	case errors.Is(err, domain.ErrCopyJobNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "copy job not found"
+
+	case errors.Is(err, domain.ErrCopyJobRunning):
+		statusCode = http.StatusConflict // 409
+		errorResp.Error = "copy job is running"
+
+	case errors.Is(err, domain.ErrInvalidCursor):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid cursor"
+
+	case errors.Is(err, domain.ErrInvalidSearchPattern):
+		statusCode = http.StatusBadRequest // 400
+		errorResp.Error = "invalid search pattern"
+
+	case errors.Is(err, domain.ErrSnapshotNotFound):
+		statusCode = http.StatusNotFound // 404
+		errorResp.Error = "snapshot not found"
+	}
+
+	c.JSON(statusCode, errorResp)
+}
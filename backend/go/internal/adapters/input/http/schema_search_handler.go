@@ -0,0 +1,66 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// SearchSchema는 연결된 여러 DB에서 테이블/컬럼 이름과 주석을 검색합니다.
+// HTTP: GET /schema-search?q=student_no
+//
+// 쿼리 파라미터:
+//   - q: 검색어 (필수)
+//   - regex=true: q를 정규식으로 해석 (기본값은 부분 문자열)
+//   - case_sensitive=true: 대소문자 구분 (기본값은 구분 안 함)
+//   - fields: table, column, table_comment, column_comment (쉼표로 구분, 없으면 전부)
+//   - databases: 검색할 DB ID (쉼표로 구분, 없으면 연결된 모든 DB)
+//   - limit: 최대 결과 수 (기본값 500)
+func (h *Handler) SearchSchema(c *gin.Context) {
+	query := domain.SchemaSearchQuery{
+		Pattern:       c.Query("q"),
+		Regex:         c.Query("regex") == "true",
+		CaseSensitive: c.Query("case_sensitive") == "true",
+		DatabaseIDs:   splitList(c.Query("databases")),
+	}
+
+	for _, field := range splitList(c.Query("fields")) {
+		query.Fields = append(query.Fields, domain.SearchField(field))
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid limit",
+				Message: "limit must be a non-negative integer",
+			})
+			return
+		}
+		query.Limit = limit
+	}
+
+	result, err := h.service.SearchSchema(c.Request.Context(), query)
+	if err != nil {
+		respondSchemaError(c, "schema search failed", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSchemaSearch(result))
+}
+
+// splitList는 쉼표로 구분한 쿼리 파라미터를 나눕니다 (빈 항목 제외).
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
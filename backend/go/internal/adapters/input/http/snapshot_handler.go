@@ -0,0 +1,104 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+)
+
+// CaptureSnapshot은 스키마 스냅샷을 지금 바로 찍습니다.
+// HTTP: POST /databases/:dbID/snapshots?schema=hr
+//
+// 이전 버전과 구조가 같으면 저장하지 않고 200 (stored=false),
+// 새 버전을 저장했으면 201과 이전 버전 대비 변경 로그를 반환합니다.
+func (h *Handler) CaptureSnapshot(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	result, err := h.service.CaptureSnapshot(c.Request.Context(), dbID, schema)
+	if err != nil {
+		respondSchemaError(c, "failed to capture snapshot", err)
+		return
+	}
+
+	status := http.StatusOK
+	if result.Stored {
+		status = http.StatusCreated
+	}
+
+	c.JSON(status, dto.FromDomainSnapshotResult(result))
+}
+
+// ListSnapshots는 저장된 스냅샷 버전 목록을 반환합니다 (최신순).
+// HTTP: GET /databases/:dbID/snapshots?schema=hr
+func (h *Handler) ListSnapshots(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	versions, err := h.service.ListSnapshots(c.Request.Context(), dbID, schema)
+	if err != nil {
+		respondSchemaError(c, "failed to list snapshots", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSnapshotVersions(versions))
+}
+
+// GetSnapshot은 특정 버전의 스냅샷 전체를 반환합니다.
+// HTTP: GET /databases/:dbID/snapshots/:version?schema=hr
+func (h *Handler) GetSnapshot(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version <= 0 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid version",
+			Message: "version must be a positive integer",
+		})
+		return
+	}
+
+	snapshot, err := h.service.GetSnapshot(c.Request.Context(), dbID, schema, version)
+	if err != nil {
+		respondSchemaError(c, "failed to get snapshot", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSchemaSnapshot(snapshot))
+}
+
+// ListSchemaChanges는 스냅샷 사이에서 발견한 구조 변경 이력을 반환합니다 (최신순).
+// HTTP: GET /databases/:dbID/schema-changes?schema=hr&since=2024-01-01T00:00:00Z
+//
+// 쿼리 파라미터:
+//   - since: RFC3339 시각 (이후에 발견한 변경만)
+func (h *Handler) ListSchemaChanges(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	var since time.Time
+	if value := c.Query("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid since",
+				Message: "since must be an RFC3339 timestamp (e.g. 2024-01-01T00:00:00Z)",
+			})
+			return
+		}
+		since = parsed
+	}
+
+	logs, err := h.service.ListSchemaChanges(c.Request.Context(), dbID, schema, since)
+	if err != nil {
+		respondSchemaError(c, "failed to list schema changes", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainSchemaChangeLogs(logs))
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// BrowseRows는 테이블 데이터 한 페이지를 필터/정렬해서 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/rows?schema=hr&columns=emp_no,name&filter=dept_no:in:10,20&sort=-hired_at&limit=50&offset=100
+//
+// 쿼리 파라미터:
+//   - columns: 가져올 컬럼 (쉼표 구분, 없으면 전체)
+//   - filter: 컬럼:연산자[:값] (여러 번 쓰면 AND)
+//     연산자: eq, ne, lt, le, gt, ge, in, not_in (값은 쉼표 구분), like, not_like, ilike, is_null, not_null
+//   - sort: 정렬 컬럼 (쉼표 구분, 앞에 -를 붙이면 내림차순, 없으면 기본 키 순서)
+//   - limit: 페이지 크기 (기본값 100, 최대 1000)
+//   - offset: 건너뛸 row 수
+//   - count: true면 필터에 맞는 전체 row 수(total)도 반환
+func (h *Handler) BrowseRows(c *gin.Context) {
+	query := domain.RowQuery{
+		Schema:  c.Query("schema"),
+		Table:   c.Param("table"),
+		Columns: splitList(c.Query("columns")),
+		Sort:    domain.ParseRowSort(c.Query("sort")),
+	}
+
+	for _, value := range c.QueryArray("filter") {
+		filter, err := domain.ParseRowFilter(value)
+		if err != nil {
+			respondSchemaError(c, "invalid filter", err)
+			return
+		}
+		query.Filters = append(query.Filters, filter)
+	}
+
+	for name, target := range map[string]*int{"limit": &query.Limit, "offset": &query.Offset} {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid " + name,
+				Message: name + " must be a non-negative integer",
+			})
+			return
+		}
+		*target = n
+	}
+
+	if value := c.Query("count"); value != "" {
+		count, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid count",
+				Message: "count must be true or false",
+			})
+			return
+		}
+		query.CountTotal = count
+	}
+
+	page, err := h.service.BrowseRows(c.Request.Context(), c.Param("dbID"), query)
+	if err != nil {
+		respondSchemaError(c, "failed to browse rows", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainRowPage(page))
+}
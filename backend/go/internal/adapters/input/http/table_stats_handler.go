@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"space/internal/adapters/input/http/dto"
+	"space/internal/domain"
+)
+
+// GetTableStats는 테이블 하나의 크기/활동 통계를 반환합니다.
+// HTTP: GET /databases/:dbID/tables/:table/stats?schema=hr
+func (h *Handler) GetTableStats(c *gin.Context) {
+	dbID := c.Param("dbID")
+	table := c.Param("table")
+	schema := c.Query("schema")
+
+	stats, err := h.service.GetTableStats(c.Request.Context(), dbID, schema, table)
+	if err != nil {
+		respondSchemaError(c, "failed to get table stats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainTableStats(*stats))
+}
+
+// ListTableStats는 스키마의 모든 테이블 통계를 정렬해서 반환합니다.
+// HTTP: GET /databases/:dbID/table-stats?schema=hr&sort=total_bytes&order=desc&limit=20
+//
+// 쿼리 파라미터:
+//   - sort: total_bytes(기본값), table_bytes, index_bytes, rows, dead_tuples,
+//     seq_scans, index_scans, last_analyzed, last_vacuum, name
+//   - order: desc(기본값) 또는 asc (sort=name이면 기본값 asc)
+//   - limit: 최대 개수 (없으면 전체)
+func (h *Handler) ListTableStats(c *gin.Context) {
+	dbID := c.Param("dbID")
+	schema := c.Query("schema")
+
+	sort, err := domain.ParseTableStatsSort(c.Query("sort"))
+	if err != nil {
+		respondSchemaError(c, "invalid sort", err)
+		return
+	}
+
+	options := domain.TableStatsOptions{
+		Sort:       sort,
+		Descending: sort != domain.SortStatsName,
+	}
+
+	switch c.Query("order") {
+	case "":
+	case "asc":
+		options.Descending = false
+	case "desc":
+		options.Descending = true
+	default:
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid order",
+			Message: "order must be asc or desc",
+		})
+		return
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid limit",
+				Message: "limit must be a non-negative integer",
+			})
+			return
+		}
+		options.Limit = limit
+	}
+
+	stats, err := h.service.ListTableStats(c.Request.Context(), dbID, schema, options)
+	if err != nil {
+		respondSchemaError(c, "failed to list table stats", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.FromDomainTableStatsList(stats))
+}
@@ -0,0 +1,116 @@
+// Package scheduler는 시간에 맞춰 서비스를 호출하는 Input Adapter입니다.
+// HTTP 핸들러와 같은 "호출하는 쪽"이지만, 사람 대신 타이머가 요청합니다.
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"space/internal/ports/input"
+)
+
+// 기본값 (설정이 없을 때)
+const (
+	DefaultSnapshotInterval = time.Hour
+	DefaultSnapshotTimeout  = 5 * time.Minute
+)
+
+// SnapshotScheduler는 연결된 DB의 스키마 스냅샷을 주기적으로 찍습니다.
+//
+// 운영 DB를 마이그레이션 절차 밖에서 누가 바꿨는지 알아내려면
+// 사람이 기억해서 호출하는 것이 아니라 정해진 주기로 계속 찍어야 합니다.
+type SnapshotScheduler struct {
+	service input.DatabaseService
+
+	interval  time.Duration
+	timeout   time.Duration   // DB 하나를 찍는 시간 한도
+	databases map[string]bool // 비어 있으면 연결된 모든 DB
+}
+
+// NewSnapshotScheduler는 SnapshotScheduler를 생성합니다.
+// 0 이하의 주기/시간 한도는 기본값으로 바꿉니다.
+func NewSnapshotScheduler(service input.DatabaseService, interval time.Duration, timeout time.Duration, databases []string) *SnapshotScheduler {
+	if interval <= 0 {
+		interval = DefaultSnapshotInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultSnapshotTimeout
+	}
+
+	selected := make(map[string]bool, len(databases))
+	for _, id := range databases {
+		selected[id] = true
+	}
+
+	return &SnapshotScheduler{
+		service:   service,
+		interval:  interval,
+		timeout:   timeout,
+		databases: selected,
+	}
+}
+
+// Start는 백그라운드 고루틴에서 스케줄러를 시작합니다.
+// 시작하자마자 한 번 찍고, 이후 interval마다 찍습니다. ctx가 취소되면 멈춥니다.
+func (s *SnapshotScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.captureAll(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.captureAll(ctx)
+			}
+		}
+	}()
+}
+
+// captureAll은 대상 DB를 차례로 찍습니다.
+// 한 DB가 실패해도 나머지는 계속 진행합니다 (로그만 남김).
+func (s *SnapshotScheduler) captureAll(ctx context.Context) {
+	databases, err := s.service.ListDatabases(ctx)
+	if err != nil {
+		log.Printf("Snapshot: failed to list databases: %v", err)
+		return
+	}
+
+	for _, db := range databases {
+		if ctx.Err() != nil {
+			return
+		}
+		if !db.IsConnected() {
+			continue
+		}
+		if len(s.databases) > 0 && !s.databases[db.ID] {
+			continue
+		}
+
+		s.capture(ctx, db.ID)
+	}
+}
+
+// capture는 DB 하나의 기본 스키마를 찍고 결과를 로그로 남깁니다.
+func (s *SnapshotScheduler) capture(ctx context.Context, dbID string) {
+	captureCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	result, err := s.service.CaptureSnapshot(captureCtx, dbID, "")
+	if err != nil {
+		log.Printf("Snapshot: failed to capture %s: %v", dbID, err)
+		return
+	}
+
+	switch {
+	case result.ChangeLog != nil:
+		log.Printf("Snapshot: %s schema changed (%d changes, version %d)",
+			dbID, len(result.ChangeLog.Changes), result.Version.Version)
+	case result.Stored:
+		log.Printf("Snapshot: %s first snapshot stored (version %d)", dbID, result.Version.Version)
+	}
+}
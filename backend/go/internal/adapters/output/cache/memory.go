@@ -0,0 +1,162 @@
+// Package cache는 쿼리 결과 캐시 구현을 제공합니다.
+// 이 패키지는:
+// 1. output.ResultCache 인터페이스를 구현합니다
+// 2. 프로세스 메모리에 결과를 보관합니다 (서버 재시작 시 사라짐)
+// 3. 항목 수와 전체 크기 한도를 LRU 방식으로 지킵니다
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// 기본 한도 (설정이 없을 때)
+const (
+	DefaultMaxEntries = 1000
+	DefaultMaxBytes   = 64 * 1024 * 1024 // 64MB
+)
+
+// MemoryCache는 메모리 기반 LRU 결과 캐시입니다.
+//
+// 구조:
+// - entries: 키 → list 원소 (O(1) 조회)
+// - lru: 최근에 쓴 항목이 앞쪽 (뒤쪽부터 제거)
+type MemoryCache struct {
+	mu sync.Mutex
+
+	entries map[domain.CacheKey]*list.Element
+	lru     *list.List
+
+	maxEntries int
+	maxBytes   int64
+	sizeBytes  int64
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// NewMemoryCache는 MemoryCache를 생성합니다.
+// 0 이하의 한도는 기본값으로 바꿉니다.
+func NewMemoryCache(maxEntries int, maxBytes int64) output.ResultCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBytes
+	}
+
+	return &MemoryCache{
+		entries:    make(map[domain.CacheKey]*list.Element),
+		lru:        list.New(),
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+	}
+}
+
+// Get은 키에 해당하는 항목을 반환합니다.
+func (c *MemoryCache) Get(key domain.CacheKey) (*domain.CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	entry := elem.Value.(*domain.CacheEntry)
+	if entry.IsExpired(time.Now()) {
+		// 만료된 항목은 찾은 김에 지웁니다.
+		c.removeElement(elem)
+		c.misses++
+		return nil, false
+	}
+
+	// 최근에 쓴 항목을 앞으로
+	c.lru.MoveToFront(elem)
+	c.hits++
+	return entry, true
+}
+
+// Set은 항목을 저장하고 한도를 넘으면 오래된 항목을 제거합니다.
+func (c *MemoryCache) Set(entry *domain.CacheEntry) {
+	// 혼자서 한도를 넘는 항목은 저장하지 않습니다.
+	// (저장하면 다른 항목을 모두 밀어내기 때문!)
+	if entry.Size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[entry.Key]; ok {
+		c.removeElement(elem)
+	}
+
+	c.entries[entry.Key] = c.lru.PushFront(entry)
+	c.sizeBytes += entry.Size
+
+	for c.lru.Len() > c.maxEntries || c.sizeBytes > c.maxBytes {
+		oldest := c.lru.Back()
+		if oldest == nil {
+			break
+		}
+		c.removeElement(oldest)
+		c.evictions++
+	}
+}
+
+// InvalidateDatabase는 특정 DB의 모든 항목을 지웁니다.
+func (c *MemoryCache) InvalidateDatabase(dbID string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, elem := range c.entries {
+		if key.DatabaseID == dbID {
+			c.removeElement(elem)
+			removed++
+		}
+	}
+	return removed
+}
+
+// InvalidateAll은 모든 항목을 지웁니다.
+func (c *MemoryCache) InvalidateAll() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := len(c.entries)
+	c.entries = make(map[domain.CacheKey]*list.Element)
+	c.lru.Init()
+	c.sizeBytes = 0
+	return removed
+}
+
+// Stats는 캐시 상태를 반환합니다.
+func (c *MemoryCache) Stats() domain.CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return domain.CacheStats{
+		Entries:    len(c.entries),
+		SizeBytes:  c.sizeBytes,
+		MaxEntries: c.maxEntries,
+		MaxBytes:   c.maxBytes,
+		Hits:       c.hits,
+		Misses:     c.misses,
+		Evictions:  c.evictions,
+	}
+}
+
+// removeElement는 항목 하나를 제거합니다. 호출 전에 잠금이 필요합니다.
+func (c *MemoryCache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*domain.CacheEntry)
+	delete(c.entries, entry.Key)
+	c.sizeBytes -= entry.Size
+}
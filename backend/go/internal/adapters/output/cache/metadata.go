@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// 메타데이터 캐시 기본값 (설정이 없을 때)
+//
+// 스키마는 자주 바뀌지 않지만, 다른 도구로 바꾼 것도 언젠가는 반영되어야 하므로 만료시킵니다.
+// 이 서버를 거친 DDL은 실행 즉시 해당 DB의 항목을 지웁니다.
+const (
+	DefaultMetadataTTL        = 10 * time.Minute
+	DefaultMetadataMaxEntries = 5000
+	DefaultMetadataMaxBytes   = 64 * 1024 * 1024 // 64MB
+)
+
+// metadataEntry는 메타데이터 캐시 항목입니다.
+type metadataEntry struct {
+	key       domain.MetadataKey
+	value     interface{}
+	size      int64
+	expiresAt time.Time
+}
+
+// MetadataMemoryCache는 메모리 기반 LRU 메타데이터 캐시입니다.
+// 구조는 결과 캐시(MemoryCache)와 같고, 항목 크기는 JSON 바이트 수로 추정합니다.
+type MetadataMemoryCache struct {
+	mu sync.Mutex
+
+	entries map[domain.MetadataKey]*list.Element
+	lru     *list.List
+
+	ttl        time.Duration
+	maxEntries int
+	maxBytes   int64
+	sizeBytes  int64
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+// NewMetadataCache는 MetadataMemoryCache를 생성합니다.
+// 0 이하의 값은 기본값으로 바꿉니다.
+func NewMetadataCache(ttl time.Duration, maxEntries int, maxBytes int64) output.MetadataCache {
+	if ttl <= 0 {
+		ttl = DefaultMetadataTTL
+	}
+	if maxEntries <= 0 {
+		maxEntries = DefaultMetadataMaxEntries
+	}
+	if maxBytes <= 0 {
+		maxBytes = DefaultMetadataMaxBytes
+	}
+
+	return &MetadataMemoryCache{
+		entries:    make(map[domain.MetadataKey]*list.Element),
+		lru:        list.New(),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+	}
+}
+
+// Get은 키에 해당하는 값을 반환합니다.
+func (c *MetadataMemoryCache) Get(key domain.MetadataKey) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	entry := elem.Value.(*metadataEntry)
+	if !time.Now().Before(entry.expiresAt) {
+		c.removeElement(elem)
+		c.misses++
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	c.hits++
+	return entry.value, true
+}
+
+// Set은 값을 저장하고 한도를 넘으면 오래된 항목을 제거합니다.
+// 크기를 알 수 없는 값(직렬화 불가)은 저장하지 않습니다.
+func (c *MetadataMemoryCache) Set(key domain.MetadataKey, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil || int64(len(data)) > c.maxBytes {
+		return
+	}
+
+	entry := &metadataEntry{
+		key:       key,
+		value:     value,
+		size:      int64(len(data)),
+		expiresAt: time.Now().Add(c.ttl),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	c.sizeBytes += entry.size
+
+	for c.lru.Len() > c.maxEntries || c.sizeBytes > c.maxBytes {
+		oldest := c.lru.Back()
+		if oldest == nil {
+			break
+		}
+		c.removeElement(oldest)
+		c.evictions++
+	}
+}
+
+// InvalidateDatabase는 특정 DB의 모든 항목을 지웁니다.
+func (c *MetadataMemoryCache) InvalidateDatabase(dbID string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, elem := range c.entries {
+		if key.DatabaseID == dbID {
+			c.removeElement(elem)
+			removed++
+		}
+	}
+	return removed
+}
+
+// InvalidateAll은 모든 항목을 지웁니다.
+func (c *MetadataMemoryCache) InvalidateAll() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := len(c.entries)
+	c.entries = make(map[domain.MetadataKey]*list.Element)
+	c.lru.Init()
+	c.sizeBytes = 0
+	return removed
+}
+
+// Stats는 캐시 상태를 반환합니다.
+func (c *MetadataMemoryCache) Stats() domain.CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return domain.CacheStats{
+		Entries:    len(c.entries),
+		SizeBytes:  c.sizeBytes,
+		MaxEntries: c.maxEntries,
+		MaxBytes:   c.maxBytes,
+		Hits:       c.hits,
+		Misses:     c.misses,
+		Evictions:  c.evictions,
+	}
+}
+
+// removeElement는 항목 하나를 제거합니다. 호출 전에 잠금이 필요합니다.
+func (c *MetadataMemoryCache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*metadataEntry)
+	delete(c.entries, entry.key)
+	c.sizeBytes -= entry.size
+}
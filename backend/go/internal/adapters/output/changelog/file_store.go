@@ -0,0 +1,164 @@
+// Package changelog는 DMS 변경 이력 저장소 구현을 제공합니다.
+// 이 패키지는:
+// 1. output.ChangeLogStore 인터페이스를 구현합니다
+// 2. 이력을 DB별 JSON Lines 파일에 한 줄씩 덧붙입니다 (서버 재시작 후에도 유지)
+package changelog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// DefaultDirectory는 설정이 없을 때 쓰는 디렉터리입니다.
+const DefaultDirectory = "data/changelog"
+
+// fileSuffix는 이력 파일 확장자입니다.
+const fileSuffix = ".jsonl"
+
+// FileStore는 파일 기반 변경 이력 저장소입니다.
+//
+// 디렉터리 구조:
+//
+//	<directory>/<dbID>.jsonl    한 줄에 이력 하나 (오래된 것부터)
+//
+// 이력은 덧붙이기만 하므로 쓰는 도중에 서버가 죽어도 앞의 이력은 깨지지 않습니다.
+// (마지막 줄이 반쯤 쓰였으면 읽을 때 건너뜁니다)
+type FileStore struct {
+	mu sync.Mutex
+
+	directory string
+	lastID    int64 // 같은 나노초에 두 건이 들어와도 ID가 겹치지 않게
+}
+
+// NewFileStore는 FileStore를 생성합니다. 비어 있는 디렉터리는 기본값으로 바꿉니다.
+func NewFileStore(directory string) output.ChangeLogStore {
+	if directory == "" {
+		directory = DefaultDirectory
+	}
+
+	return &FileStore{directory: directory}
+}
+
+// Append는 이력을 DB 파일 끝에 한 줄로 덧붙입니다.
+func (s *FileStore) Append(ctx context.Context, entry *domain.ChangeLogEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.directory, 0o755); err != nil {
+		return fmt.Errorf("failed to create change log directory: %w", err)
+	}
+
+	id := entry.ChangedAt.UnixNano()
+	if id <= s.lastID {
+		id = s.lastID + 1
+	}
+	s.lastID = id
+	entry.ID = strconv.FormatInt(id, 36)
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode change log entry: %w", err)
+	}
+
+	f, err := os.OpenFile(s.path(entry.DatabaseID), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open change log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write change log: %w", err)
+	}
+	return f.Sync()
+}
+
+// List는 조건에 맞는 이력을 최신순으로 반환합니다.
+// query.DatabaseID가 비어 있으면 모든 DB 파일을 읽습니다.
+func (s *FileStore) List(ctx context.Context, query domain.ChangeLogQuery) ([]domain.ChangeLogEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = domain.DefaultChangeLogLimit
+	}
+
+	paths := []string{s.path(query.DatabaseID)}
+	if query.DatabaseID == "" {
+		var err error
+		if paths, err = filepath.Glob(filepath.Join(s.directory, "*"+fileSuffix)); err != nil {
+			return nil, fmt.Errorf("failed to list change logs: %w", err)
+		}
+	}
+
+	var entries []domain.ChangeLogEntry
+	for _, path := range paths {
+		if err := readEntries(path, query, &entries); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ChangedAt.After(entries[j].ChangedAt)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries, nil
+}
+
+// readEntries는 파일에서 조건에 맞는 이력을 읽어서 entries에 더합니다.
+// 파일이 없으면 이력이 없는 것입니다.
+func readEntries(path string, query domain.ChangeLogQuery, entries *[]domain.ChangeLogEntry) error {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open change log: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024) // 긴 주석(Postgres는 길이 제한 없음)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var entry domain.ChangeLogEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			continue // 쓰다가 끊긴 줄
+		}
+		if query.Matches(entry) {
+			*entries = append(*entries, entry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read change log %s: %w", path, err)
+	}
+	return nil
+}
+
+// path는 DB 이력 파일 경로입니다.
+// ID에 /나 .. 같은 문자가 있어도 디렉터리를 벗어나지 않도록 이스케이프합니다.
+func (s *FileStore) path(dbID string) string {
+	return filepath.Join(s.directory, url.PathEscape(dbID)+fileSuffix)
+}
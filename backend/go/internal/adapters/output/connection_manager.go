@@ -73,14 +73,46 @@ type Adapter interface {
 	Connect(ctx context.Context, db *domain.Database) (*sql.DB, error)
 
 	// ExecuteQuery는 쿼리를 실행하고 결과를 반환합니다.
-	ExecuteQuery(ctx context.Context, conn *sql.DB, query string) (*domain.QueryResult, error)
+	// args는 쿼리의 바인드 변수 값입니다 (값을 SQL 문자열에 직접 넣지 않기 위해).
+	ExecuteQuery(ctx context.Context, conn *sql.DB, query string, args ...interface{}) (*domain.QueryResult, error)
 
-	// GetTables는 테이블 목록을 조회합니다.
-	// (DB마다 쿼리가 다름!)
-	GetTables(ctx context.Context, conn *sql.DB) ([]string, error)
+	// GetSchemas는 탐색할 수 있는 스키마 목록을 조회합니다.
+	GetSchemas(ctx context.Context, conn *sql.DB) ([]string, error)
+
+	// GetTables는 스키마의 테이블과 뷰 목록을 조회합니다.
+	// (DB마다 쿼리가 다름!) schema가 비어 있으면 세션의 현재 스키마를 사용합니다.
+	GetTables(ctx context.Context, conn *sql.DB, schema string) ([]domain.TableInfo, error)
 
 	// GetColumns는 특정 테이블의 컬럼 목록을 조회합니다.
-	GetColumns(ctx context.Context, conn *sql.DB, tableName string) ([]string, error)
+	GetColumns(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.ColumnInfo, error)
+
+	// GetTableMetadata는 테이블의 인덱스, 제약조건, 외래 키를 조회합니다.
+	GetTableMetadata(ctx context.Context, conn *sql.DB, schema string, tableName string) (*domain.TableMetadata, error)
+
+	// ListObjects는 테이블 외의 객체(뷰, 함수, 트리거 등) 목록을 조회합니다.
+	ListObjects(ctx context.Context, conn *sql.DB, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error)
+
+	// GetObject는 객체 하나의 정의(소스)를 조회합니다.
+	GetObject(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error)
+
+	// GetDDL은 객체의 DDL을 DB 자신의 방언으로 조회합니다.
+	GetDDL(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (string, error)
+
+	// GetCatalog는 접근할 수 있는 모든 스키마의 테이블/컬럼 이름, 타입, 주석을 조회합니다.
+	// (시스템 스키마 제외, 메타데이터 인덱스용)
+	// DatabaseID/DatabaseType/LoadedAt은 호출하는 쪽에서 채웁니다.
+	GetCatalog(ctx context.Context, conn *sql.DB) (*domain.DatabaseCatalog, error)
+
+	// GetTableStats는 테이블 크기/활동 통계를 조회합니다 (tableName이 비어 있으면 스키마 전체).
+	GetTableStats(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.TableStats, error)
+
+	// Explain은 쿼리의 실행 계획을 공통 트리(domain.PlanNode)로 반환합니다.
+	// analyze가 true면 실제로 실행하되, 트랜잭션을 롤백해서 부작용을 남기지 않습니다.
+	Explain(ctx context.Context, conn *sql.DB, query string, analyze bool) (*domain.QueryPlan, error)
+
+	// LoadRows는 트랜잭션 안에서 row 묶음을 한 번에 넣습니다 (plan의 SQL 사용).
+	// 각 row의 값은 plan.Columns 순서이며 이미 컬럼 타입에 맞게 바뀌어 있습니다.
+	LoadRows(ctx context.Context, tx *sql.Tx, plan *domain.ImportPlan, rows [][]interface{}) error
 }
 
 // NewConnectionManager는 ConnectionManager를 생성합니다.
@@ -268,7 +300,7 @@ func (cm *ConnectionManager) Disconnect(ctx context.Context, dbID string) error
 }
 
 // ExecuteQuery는 특정 DB에 쿼리를 실행합니다.
-func (cm *ConnectionManager) ExecuteQuery(ctx context.Context, dbID string, query string) (*domain.QueryResult, error) {
+func (cm *ConnectionManager) ExecuteQuery(ctx context.Context, dbID string, query string, args ...interface{}) (*domain.QueryResult, error) {
 	// ==========================================
 	// 1단계: 읽기 잠금 (RLock)
 	// ==========================================
@@ -299,7 +331,7 @@ func (cm *ConnectionManager) ExecuteQuery(ctx context.Context, dbID string, quer
 
 	// Adapter의 ExecuteQuery() 호출
 	// 실제로 DB에 쿼리를 보냅니다!
-	result, err := conn.Adapter.ExecuteQuery(ctx, conn.ConnPool, query)
+	result, err := conn.Adapter.ExecuteQuery(ctx, conn.ConnPool, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query execution failed: %w", err)
 	}
@@ -307,6 +339,225 @@ func (cm *ConnectionManager) ExecuteQuery(ctx context.Context, dbID string, quer
 	return result, nil
 }
 
+// ExecuteStatement는 결과 집합이 없는 문장을 실행합니다.
+// 방언 차이는 문장을 만드는 쪽(domain)에서 처리하므로 Adapter를 거치지 않고
+// database/sql의 ExecContext로 바로 실행합니다.
+func (cm *ConnectionManager) ExecuteStatement(ctx context.Context, dbID string, statement string) (int64, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return 0, domain.ErrDatabaseNotFound
+	}
+
+	result, err := conn.ConnPool.ExecContext(ctx, statement)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute statement: %w", err)
+	}
+
+	// DDL은 영향받은 row 수를 지원하지 않는 드라이버도 있으므로 에러는 0으로 봅니다.
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, nil
+	}
+	return affected, nil
+}
+
+// ExecuteTransaction은 문장들을 한 트랜잭션 안에서 순서대로 실행합니다.
+// 문장은 domain에서 바인드 변수까지 만들어 오므로 ExecuteStatement처럼 Adapter를 거치지 않습니다.
+//
+// 어느 문장이든 실패하거나 예상과 다른 수의 row에 영향을 주면 (*domain.RowCountError)
+// 커밋하지 않고 롤백합니다. (defer tx.Rollback()은 커밋한 뒤에는 아무 일도 하지 않음)
+func (cm *ConnectionManager) ExecuteTransaction(ctx context.Context, dbID string, statements []domain.TxStatement) ([]domain.TxResult, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	tx, err := conn.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	results := make([]domain.TxResult, len(statements))
+	for i, stmt := range statements {
+		if stmt.Query {
+			rows, err := queryTx(ctx, tx, stmt.SQL, stmt.Args)
+			if err != nil {
+				return nil, err
+			}
+			results[i] = domain.TxResult{Rows: rows, RowsAffected: int64(len(rows))}
+		} else {
+			result, err := tx.ExecContext(ctx, stmt.SQL, stmt.Args...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute statement: %w", err)
+			}
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get affected rows: %w", err)
+			}
+			results[i] = domain.TxResult{RowsAffected: affected}
+		}
+
+		if stmt.ExpectRows >= 0 && results[i].RowsAffected != stmt.ExpectRows {
+			return nil, &domain.RowCountError{Statement: i, Expected: stmt.ExpectRows, Actual: results[i].RowsAffected}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return results, nil
+}
+
+// ImportRows는 row 묶음을 한 트랜잭션 안에서 테이블에 넣습니다.
+//
+// 묶음마다 SAVEPOINT를 두고, 묶음이 실패하면 그 묶음만 되돌린 뒤
+// row 하나씩 다시 넣어서 어느 row가 왜 실패했는지 report에 남깁니다.
+// (COPY나 배열 바인드는 어느 row에서 실패했는지 알려주지 않음)
+// 정상적인 데이터는 묶음 단위로 빠르게 넣고, 실패한 묶음만 느리게 처리하는 방식입니다.
+func (cm *ConnectionManager) ImportRows(ctx context.Context, dbID string, plan *domain.ImportPlan, next domain.ImportBatchFunc, report *domain.ImportReport) error {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return domain.ErrDatabaseNotFound
+	}
+
+	tx, err := conn.ConnPool.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// DryRun이거나 실패하면 커밋하지 않고 여기서 롤백됩니다.
+	defer tx.Rollback()
+
+	if plan.Truncate != "" {
+		if _, err := tx.ExecContext(ctx, plan.Truncate); err != nil {
+			return fmt.Errorf("failed to empty table: %w", err)
+		}
+	}
+
+	for {
+		batch, err := next()
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		if err := loadBatch(ctx, tx, conn.Adapter, plan, batch, report); err != nil {
+			return err
+		}
+	}
+
+	if plan.DryRun {
+		return nil
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// loadBatch는 묶음 하나를 넣고, 실패하면 row 하나씩 다시 넣습니다.
+func loadBatch(ctx context.Context, tx *sql.Tx, adapter Adapter, plan *domain.ImportPlan, batch []domain.ImportRow, report *domain.ImportReport) error {
+	rows := make([][]interface{}, len(batch))
+	for i, row := range batch {
+		rows[i] = row.Values
+	}
+
+	loadErr := loadWithSavepoint(ctx, tx, adapter, plan, rows)
+	if loadErr == nil {
+		report.Loaded += int64(len(batch))
+		return nil
+	}
+	if ctx.Err() != nil {
+		return loadErr
+	}
+
+	for _, row := range batch {
+		err := loadWithSavepoint(ctx, tx, adapter, plan, [][]interface{}{row.Values})
+		if err == nil {
+			report.Loaded++
+			continue
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+		if err := report.Reject(domain.ImportRowError{Line: row.Line, Message: err.Error()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadWithSavepoint는 SAVEPOINT를 두고 row를 넣습니다. 실패하면 SAVEPOINT까지 되돌립니다.
+// (Postgres는 문장 하나가 실패하면 되돌리기 전까지 트랜잭션 전체를 쓸 수 없음)
+func loadWithSavepoint(ctx context.Context, tx *sql.Tx, adapter Adapter, plan *domain.ImportPlan, rows [][]interface{}) error {
+	if _, err := tx.ExecContext(ctx, plan.Savepoint); err != nil {
+		return fmt.Errorf("failed to set savepoint: %w", err)
+	}
+
+	if err := adapter.LoadRows(ctx, tx, plan, rows); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, plan.RollbackSavepoint); rollbackErr != nil {
+			return fmt.Errorf("failed to roll back to savepoint: %v (after %w)", rollbackErr, err)
+		}
+		return err
+	}
+
+	if plan.ReleaseSavepoint != "" {
+		if _, err := tx.ExecContext(ctx, plan.ReleaseSavepoint); err != nil {
+			return fmt.Errorf("failed to release savepoint: %w", err)
+		}
+	}
+	return nil
+}
+
+// queryTx는 트랜잭션 안에서 쿼리를 실행하고 row를 컬럼 이름 → 값 맵으로 읽습니다.
+func queryTx(ctx context.Context, tx *sql.Tx, query string, args []interface{}) ([]map[string]interface{}, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query execution failed: %w", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+
+	var results []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		valuePtrs := make([]interface{}, len(columns))
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		row := make(map[string]interface{}, len(columns))
+		for i, col := range columns {
+			row[col] = values[i]
+		}
+		results = append(results, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during row iteration: %w", err)
+	}
+	return results, nil
+}
+
 // IsConnected는 특정 DB가 연결되어 있는지 확인합니다.
 func (cm *ConnectionManager) IsConnected(ctx context.Context, dbID string) bool {
 	// 읽기 잠금
@@ -331,8 +582,26 @@ func (cm *ConnectionManager) IsConnected(ctx context.Context, dbID string) bool
 	return conn.DB.Status == domain.Connected
 }
 
+// GetSchemas는 특정 DB의 스키마 목록을 조회합니다.
+func (cm *ConnectionManager) GetSchemas(ctx context.Context, dbID string) ([]string, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	schemas, err := conn.Adapter.GetSchemas(ctx, conn.ConnPool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schemas: %w", err)
+	}
+
+	return schemas, nil
+}
+
 // GetTables는 특정 DB의 테이블 목록을 조회합니다.
-func (cm *ConnectionManager) GetTables(ctx context.Context, dbID string) ([]string, error) {
+func (cm *ConnectionManager) GetTables(ctx context.Context, dbID string, schema string) ([]domain.TableInfo, error) {
 	// 읽기 잠금
 	cm.mu.RLock()
 	conn, exists := cm.connections[dbID]
@@ -344,7 +613,7 @@ func (cm *ConnectionManager) GetTables(ctx context.Context, dbID string) ([]stri
 
 	// Adapter의 GetTables() 호출
 	// DB 타입별로 다른 쿼리가 실행됨!
-	tables, err := conn.Adapter.GetTables(ctx, conn.ConnPool)
+	tables, err := conn.Adapter.GetTables(ctx, conn.ConnPool, schema)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tables: %w", err)
 	}
@@ -353,7 +622,7 @@ func (cm *ConnectionManager) GetTables(ctx context.Context, dbID string) ([]stri
 }
 
 // GetColumns는 특정 테이블의 컬럼 목록을 조회합니다.
-func (cm *ConnectionManager) GetColumns(ctx context.Context, dbID string, tableName string) ([]string, error) {
+func (cm *ConnectionManager) GetColumns(ctx context.Context, dbID string, schema string, tableName string) ([]domain.ColumnInfo, error) {
 	cm.mu.RLock()
 	conn, exists := cm.connections[dbID]
 	cm.mu.RUnlock()
@@ -363,7 +632,7 @@ func (cm *ConnectionManager) GetColumns(ctx context.Context, dbID string, tableN
 	}
 
 	// Adapter의 GetColumns() 호출
-	columns, err := conn.Adapter.GetColumns(ctx, conn.ConnPool, tableName)
+	columns, err := conn.Adapter.GetColumns(ctx, conn.ConnPool, schema, tableName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get columns: %w", err)
 	}
@@ -371,6 +640,134 @@ func (cm *ConnectionManager) GetColumns(ctx context.Context, dbID string, tableN
 	return columns, nil
 }
 
+// GetTableMetadata는 특정 테이블의 인덱스, 제약조건, 외래 키를 조회합니다.
+func (cm *ConnectionManager) GetTableMetadata(ctx context.Context, dbID string, schema string, tableName string) (*domain.TableMetadata, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	metadata, err := conn.Adapter.GetTableMetadata(ctx, conn.ConnPool, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// ListObjects는 특정 DB의 객체 목록을 조회합니다.
+func (cm *ConnectionManager) ListObjects(ctx context.Context, dbID string, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	objects, err := conn.Adapter.ListObjects(ctx, conn.ConnPool, schema, types)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	return objects, nil
+}
+
+// GetObject는 특정 DB의 객체 하나를 조회합니다.
+func (cm *ConnectionManager) GetObject(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	object, err := conn.Adapter.GetObject(ctx, conn.ConnPool, schema, objectType, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return object, nil
+}
+
+// GetDDL은 특정 DB의 객체 DDL을 조회합니다.
+func (cm *ConnectionManager) GetDDL(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (string, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return "", domain.ErrDatabaseNotFound
+	}
+
+	ddl, err := conn.Adapter.GetDDL(ctx, conn.ConnPool, schema, objectType, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get DDL: %w", err)
+	}
+
+	return ddl, nil
+}
+
+// GetCatalog는 특정 DB의 테이블/컬럼 목록 전체를 조회합니다.
+func (cm *ConnectionManager) GetCatalog(ctx context.Context, dbID string) (*domain.DatabaseCatalog, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	catalog, err := conn.Adapter.GetCatalog(ctx, conn.ConnPool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog: %w", err)
+	}
+
+	return catalog, nil
+}
+
+// GetTableStats는 특정 DB의 테이블 크기/활동 통계를 조회합니다.
+func (cm *ConnectionManager) GetTableStats(ctx context.Context, dbID string, schema string, tableName string) ([]domain.TableStats, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	stats, err := conn.Adapter.GetTableStats(ctx, conn.ConnPool, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// Explain은 특정 DB에서 쿼리의 실행 계획을 조회합니다.
+func (cm *ConnectionManager) Explain(ctx context.Context, dbID string, query string, analyze bool) (*domain.QueryPlan, error) {
+	cm.mu.RLock()
+	conn, exists := cm.connections[dbID]
+	cm.mu.RUnlock()
+
+	if !exists {
+		return nil, domain.ErrDatabaseNotFound
+	}
+
+	// Adapter의 Explain() 호출
+	// Postgres는 EXPLAIN (FORMAT JSON), Oracle은 EXPLAIN PLAN + DBMS_XPLAN
+	plan, err := conn.Adapter.Explain(ctx, conn.ConnPool, query, analyze)
+	if err != nil {
+		return nil, fmt.Errorf("failed to explain query: %w", err)
+	}
+
+	return plan, nil
+}
+
 // ListConnections는 현재 관리 중인 모든 연결 목록을 반환합니다.
 func (cm *ConnectionManager) ListConnections(ctx context.Context) ([]*domain.Database, error) {
 	// 읽기 잠금
@@ -0,0 +1,153 @@
+// Package copyjob은 테이블 복사 작업 저장소 구현을 제공합니다.
+// 이 패키지는:
+// 1. output.CopyJobStore 인터페이스를 구현합니다
+// 2. 작업을 하나씩 JSON 파일로 디스크에 남깁니다 (서버 재시작 후에도 이어서 복사 가능)
+package copyjob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// DefaultDirectory는 설정이 없을 때 쓰는 디렉터리입니다.
+const DefaultDirectory = "data/copy_jobs"
+
+// fileSuffix는 작업 파일 확장자입니다.
+const fileSuffix = ".json"
+
+// FileStore는 파일 기반 복사 작업 저장소입니다.
+//
+// 디렉터리 구조:
+//
+//	<directory>/<jobID>.json    작업 하나 (설정, 단계, 진행 상황, 마지막 키)
+//
+// 임시 파일에 쓴 뒤 이름을 바꾸므로, 저장하는 도중에 서버가 죽어도 이전 상태가 남습니다.
+type FileStore struct {
+	mu sync.Mutex
+
+	directory string
+	lastID    int64 // 같은 나노초에 두 작업이 만들어져도 ID가 겹치지 않게
+}
+
+// NewFileStore는 FileStore를 생성합니다. 비어 있는 디렉터리는 기본값으로 바꿉니다.
+func NewFileStore(directory string) output.CopyJobStore {
+	if directory == "" {
+		directory = DefaultDirectory
+	}
+
+	return &FileStore{directory: directory}
+}
+
+// Create는 작업에 ID를 붙이고 파일로 씁니다.
+func (s *FileStore) Create(ctx context.Context, job *domain.CopyJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := time.Now().UnixNano()
+	if id <= s.lastID {
+		id = s.lastID + 1
+	}
+	s.lastID = id
+	job.ID = strconv.FormatInt(id, 36)
+
+	return s.write(job)
+}
+
+// Save는 작업 파일을 현재 상태로 덮어씁니다.
+func (s *FileStore) Save(ctx context.Context, job *domain.CopyJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.write(job)
+}
+
+// Get은 작업 파일을 읽습니다.
+func (s *FileStore) Get(ctx context.Context, id string) (*domain.CopyJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return readJob(s.path(id), id)
+}
+
+// List는 모든 작업 파일을 읽어서 최신순으로 반환합니다.
+func (s *FileStore) List(ctx context.Context) ([]domain.CopyJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(s.directory, "*"+fileSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list copy jobs: %w", err)
+	}
+
+	jobs := make([]domain.CopyJob, 0, len(paths))
+	for _, path := range paths {
+		job, err := readJob(path, strings.TrimSuffix(filepath.Base(path), fileSuffix))
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt.After(jobs[j].CreatedAt)
+	})
+	return jobs, nil
+}
+
+// write는 임시 파일에 쓴 뒤 이름을 바꿉니다.
+func (s *FileStore) write(job *domain.CopyJob) error {
+	if err := os.MkdirAll(s.directory, 0o755); err != nil {
+		return fmt.Errorf("failed to create copy job directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode copy job: %w", err)
+	}
+
+	path := s.path(job.ID)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write copy job: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write copy job: %w", err)
+	}
+	return nil
+}
+
+// readJob은 작업 파일 하나를 읽습니다.
+func readJob(path, id string) (*domain.CopyJob, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCopyJobNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read copy job: %w", err)
+	}
+
+	var job domain.CopyJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("failed to parse copy job %s: %w", id, err)
+	}
+	return &job, nil
+}
+
+// path는 작업 파일 경로입니다.
+// ID에 /나 .. 같은 문자가 있어도 디렉터리를 벗어나지 않도록 이스케이프합니다.
+func (s *FileStore) path(id string) string {
+	return filepath.Join(s.directory, url.PathEscape(id)+fileSuffix)
+}
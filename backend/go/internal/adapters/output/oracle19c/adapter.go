@@ -63,10 +63,10 @@ func (a *OracleAdapter) Connect(ctx context.Context, db *domain.Database) (*sql.
 	return conn, nil
 }
 
-func (a *OracleAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query string) (*domain.QueryResult, error) {
+func (a *OracleAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query string, args ...interface{}) (*domain.QueryResult, error) {
 	start := time.Now()
 
-	rows, err := conn.QueryContext(ctx, query)
+	rows, err := conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query execution failed: %w", err)
 	}
@@ -77,6 +77,16 @@ func (a *OracleAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query st
 		return nil, fmt.Errorf("failed to get columns: %w", err)
 	}
 
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	typeNames := make([]string, len(columnTypes))
+	for i, ct := range columnTypes {
+		typeNames[i] = ct.DatabaseTypeName()
+	}
+
 	results := []map[string]interface{}{}
 
 	for rows.Next() {
@@ -107,73 +117,9 @@ func (a *OracleAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query st
 
 	return &domain.QueryResult{
 		Columns:       columns,
+		ColumnTypes:   typeNames,
 		Rows:          results,
 		RowsAffected:  int64(len(results)),
 		ExecutionTime: executionTime,
 	}, nil
 }
-
-func (a *OracleAdapter) GetTables(ctx context.Context, conn *sql.DB) ([]string, error) {
-	query := `
-		SELECT table_name 
-		FROM user_tables 
-		ORDER BY table_name
-	`
-
-	rows, err := conn.QueryContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query tables: %w", err)
-	}
-	defer rows.Close()
-
-	var tables []string
-
-	for rows.Next() {
-		var tableName string
-
-		if err := rows.Scan(&tableName); err != nil {
-			return nil, fmt.Errorf("failed to scan table name: %w", err)
-		}
-
-		tables = append(tables, tableName)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error during iteration: %w", err)
-	}
-
-	return tables, nil
-}
-
-func (a *OracleAdapter) GetColumns(ctx context.Context, conn *sql.DB, tableName string) ([]string, error) {
-	query := `
-		SELECT column_name 
-		FROM user_tab_columns 
-		WHERE table_name = :1
-		ORDER BY column_id
-	`
-
-	rows, err := conn.QueryContext(ctx, query, tableName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query columns: %w", err)
-	}
-	defer rows.Close()
-
-	var columns []string
-
-	for rows.Next() {
-		var columnName string
-
-		if err := rows.Scan(&columnName); err != nil {
-			return nil, fmt.Errorf("failed to scan column name: %w", err)
-		}
-
-		columns = append(columns, columnName)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error during iteration: %w", err)
-	}
-
-	return columns, nil
-}
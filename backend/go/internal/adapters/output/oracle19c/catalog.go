@@ -0,0 +1,152 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// systemSchemas는 메타데이터 인덱스에서 뺄 Oracle 내부 스키마입니다.
+// 12c부터는 all_users.oracle_maintained로 알 수 있지만, 11g에는 없어서 목록으로 둡니다.
+const systemSchemas = `
+	'SYS', 'SYSTEM', 'SYSMAN', 'SYSBACKUP', 'SYSDG', 'SYSKM', 'SYSRAC', 'SYS$UMF',
+	'OUTLN', 'DBSNMP', 'APPQOSSYS', 'AUDSYS', 'DBSFWUSER', 'GSMADMIN_INTERNAL', 'GSMCATUSER',
+	'GSMUSER', 'GGSYS', 'DIP', 'ORACLE_OCM', 'REMOTE_SCHEDULER_AGENT', 'XS$NULL',
+	'XDB', 'ANONYMOUS', 'CTXSYS', 'MDSYS', 'MDDATA', 'ORDSYS', 'ORDDATA', 'ORDPLUGINS',
+	'SI_INFORMTN_SCHEMA', 'OLAPSYS', 'WMSYS', 'EXFSYS', 'LBACSYS', 'DVSYS', 'DVF',
+	'OJVMSYS', 'SPATIAL_CSW_ADMIN_USR', 'SPATIAL_WFS_ADMIN_USR', 'MGMT_VIEW', 'OWBSYS',
+	'OWBSYS_AUDIT', 'APEX_PUBLIC_USER', 'FLOWS_FILES'
+`
+
+// GetCatalog는 접근할 수 있는 모든 스키마의 테이블/컬럼 이름, 타입, 주석을 한 번에 조회합니다.
+//
+// all_tab_columns는 테이블과 뷰의 컬럼을 모두 담고 있고,
+// all_tab_comments.table_type으로 테이블/뷰를 구분합니다.
+// 머티리얼라이즈드 뷰는 같은 이름의 테이블로도 나오므로 all_mviews로 따로 표시합니다.
+func (a *OracleAdapter) GetCatalog(ctx context.Context, conn *sql.DB) (*domain.DatabaseCatalog, error) {
+	catalog := &domain.DatabaseCatalog{}
+
+	// ALTER SESSION SET CURRENT_SCHEMA가 없으면 접속 사용자
+	if err := conn.QueryRowContext(ctx, "SELECT SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA') FROM dual").Scan(&catalog.CurrentSchema); err != nil {
+		return nil, fmt.Errorf("failed to get current schema: %w", err)
+	}
+
+	mviews, err := a.materializedViews(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT
+			c.owner, c.table_name, tc.table_type, tc.comments,
+			c.column_name, c.data_type, c.data_length, c.char_length, c.char_used,
+			c.data_precision, c.data_scale,
+			cc.comments
+		FROM all_tab_columns c
+		JOIN all_tab_comments tc
+		  ON tc.owner = c.owner AND tc.table_name = c.table_name
+		LEFT JOIN all_col_comments cc
+		       ON cc.owner = c.owner AND cc.table_name = c.table_name AND cc.column_name = c.column_name
+		WHERE c.owner NOT IN (` + systemSchemas + `)
+		  AND c.owner NOT LIKE 'APEX\_%' ESCAPE '\'
+		  AND c.table_name NOT LIKE 'BIN$%'
+		ORDER BY c.owner, c.table_name, c.column_id
+	`
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query catalog: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			schema, table, tableType    string
+			tableComment, columnComment sql.NullString
+			column                      domain.CatalogColumn
+			baseType                    string
+			dataLength, charLength      sql.NullInt64
+			charUsed                    sql.NullString
+			precision, scale            sql.NullInt64
+		)
+
+		if err := rows.Scan(&schema, &table, &tableType, &tableComment,
+			&column.Name, &baseType, &dataLength, &charLength, &charUsed,
+			&precision, &scale, &columnComment); err != nil {
+			return nil, fmt.Errorf("failed to scan catalog: %w", err)
+		}
+		column.Comment = columnComment.String
+		column.DataType = catalogType(baseType, dataLength, charLength, charUsed, precision, scale)
+
+		tables := catalog.Tables
+		if n := len(tables); n == 0 || tables[n-1].Schema != schema || tables[n-1].Name != table {
+			kind := objectTypes[tableType]
+			if mviews[schema+"."+table] {
+				kind = domain.TableTypeMaterializedView
+			}
+			catalog.Tables = append(catalog.Tables, domain.CatalogTable{
+				Schema:  schema,
+				Name:    table,
+				Type:    kind,
+				Comment: tableComment.String,
+			})
+		}
+		last := &catalog.Tables[len(catalog.Tables)-1]
+		last.Columns = append(last.Columns, column)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return catalog, nil
+}
+
+// materializedViews는 "OWNER.NAME" 형태의 머티리얼라이즈드 뷰 집합을 반환합니다.
+func (a *OracleAdapter) materializedViews(ctx context.Context, conn *sql.DB) (map[string]bool, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT owner, mview_name FROM all_mviews")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query materialized views: %w", err)
+	}
+	defer rows.Close()
+
+	mviews := make(map[string]bool)
+	for rows.Next() {
+		var owner, name string
+		if err := rows.Scan(&owner, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan materialized view: %w", err)
+		}
+		mviews[owner+"."+name] = true
+	}
+
+	return mviews, rows.Err()
+}
+
+// catalogType은 GetColumns와 같은 규칙(formatOracleType)으로 타입 문자열을 만듭니다.
+func catalogType(baseType string, dataLength, charLength sql.NullInt64, charUsed sql.NullString, precision, scale sql.NullInt64) string {
+	var length *int64
+	switch baseType {
+	case "VARCHAR2", "NVARCHAR2", "CHAR", "NCHAR":
+		if charLength.Valid {
+			length = &charLength.Int64
+		}
+	case "RAW":
+		if dataLength.Valid {
+			length = &dataLength.Int64
+		}
+	}
+
+	var p, s *int
+	if precision.Valid {
+		v := int(precision.Int64)
+		p = &v
+	}
+	if scale.Valid {
+		v := int(scale.Int64)
+		s = &v
+	}
+
+	return formatOracleType(baseType, length, charUsed.String, p, s)
+}
@@ -0,0 +1,164 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"space/internal/domain"
+)
+
+// metadataTypes는 domain.ObjectType을 DBMS_METADATA 객체 종류로 바꾸는 표입니다.
+// (all_objects와 달리 공백 대신 밑줄을 씁니다: MATERIALIZED_VIEW)
+var metadataTypes = map[domain.ObjectType]string{
+	domain.ObjectTable:            "TABLE",
+	domain.ObjectIndex:            "INDEX",
+	domain.ObjectView:             "VIEW",
+	domain.ObjectMaterializedView: "MATERIALIZED_VIEW",
+	domain.ObjectSequence:         "SEQUENCE",
+	domain.ObjectFunction:         "FUNCTION",
+	domain.ObjectProcedure:        "PROCEDURE",
+	domain.ObjectPackage:          "PACKAGE", // 명세 + 본문
+	domain.ObjectTrigger:          "TRIGGER",
+}
+
+// GetDDL은 DBMS_METADATA.GET_DDL로 객체의 DDL을 조회합니다.
+//
+// DBMS_METADATA의 출력 옵션(SET_TRANSFORM_PARAM)은 세션 단위라서
+// 커넥션 풀에서 세션 하나를 잡고(conn.Conn) 옵션 설정 → 조회 → 원래대로 되돌리기를 합니다.
+//   - SQLTERMINATOR: 문장 끝에 ; 붙이기
+//   - SEGMENT_ATTRIBUTES: TABLESPACE, STORAGE 같은 물리 속성 빼기
+//     (다른 환경에 그대로 실행할 수 있도록)
+//
+// 테이블은 GET_DDL이 제약조건까지만 돌려주므로 인덱스와 주석을 따로 붙입니다.
+func (a *OracleAdapter) GetDDL(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (string, error) {
+	metadataType, ok := metadataTypes[objectType]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", domain.ErrInvalidObjectType, objectType)
+	}
+
+	session, err := conn.Conn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get session: %w", err)
+	}
+	defer session.Close()
+
+	if err := session.QueryRowContext(ctx, "SELECT "+currentSchema+" FROM dual", schema).Scan(&schema); err != nil {
+		return "", fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	if _, err := session.ExecContext(ctx, `
+		BEGIN
+			DBMS_METADATA.SET_TRANSFORM_PARAM(DBMS_METADATA.SESSION_TRANSFORM, 'SQLTERMINATOR', TRUE);
+			DBMS_METADATA.SET_TRANSFORM_PARAM(DBMS_METADATA.SESSION_TRANSFORM, 'PRETTY', TRUE);
+			DBMS_METADATA.SET_TRANSFORM_PARAM(DBMS_METADATA.SESSION_TRANSFORM, 'SEGMENT_ATTRIBUTES', FALSE);
+		END;
+	`); err != nil {
+		return "", fmt.Errorf("failed to set DBMS_METADATA options: %w", err)
+	}
+	defer session.ExecContext(context.Background(),
+		"BEGIN DBMS_METADATA.SET_TRANSFORM_PARAM(DBMS_METADATA.SESSION_TRANSFORM, 'DEFAULT'); END;")
+
+	ddl, err := getMetadataDDL(ctx, session, "GET_DDL", metadataType, name, schema)
+	if err != nil {
+		if isMetadataNotFound(err) {
+			return "", fmt.Errorf("%w: %s %s", domain.ErrObjectNotFound, objectType, name)
+		}
+		return "", fmt.Errorf("failed to get DDL: %w", err)
+	}
+
+	if objectType != domain.ObjectTable {
+		return ddl, nil
+	}
+
+	parts := []string{ddl}
+
+	indexes, err := a.tableIndexDDL(ctx, session, schema, name)
+	if err != nil {
+		return "", err
+	}
+	parts = append(parts, indexes...)
+
+	// 주석이 하나도 없으면 ORA-31608 (object not found)
+	comments, err := getMetadataDDL(ctx, session, "GET_DEPENDENT_DDL", "COMMENT", name, schema)
+	if err != nil && !isMetadataNotFound(err) {
+		return "", fmt.Errorf("failed to get comment DDL: %w", err)
+	}
+	if comments != "" {
+		parts = append(parts, comments)
+	}
+
+	return strings.Join(parts, "\n"), nil
+}
+
+// tableIndexDDL은 테이블 인덱스의 DDL을 조회합니다.
+// 기본 키/유니크 제약조건의 인덱스는 CREATE TABLE에서 같이 만들어지므로 제외합니다.
+func (a *OracleAdapter) tableIndexDDL(ctx context.Context, session *sql.Conn, schema string, tableName string) ([]string, error) {
+	rows, err := session.QueryContext(ctx, `
+		SELECT i.owner, i.index_name
+		FROM all_indexes i
+		WHERE i.table_owner = :1
+		  AND i.table_name = :2
+		  AND i.index_type <> 'LOB'
+		  AND NOT EXISTS (
+			SELECT 1 FROM all_constraints c
+			WHERE c.owner = i.table_owner AND c.table_name = i.table_name
+			  AND c.index_name = i.index_name AND c.constraint_type IN ('P', 'U')
+		  )
+		ORDER BY i.index_name
+	`, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexes: %w", err)
+	}
+
+	type index struct{ owner, name string }
+	var indexes []index
+
+	for rows.Next() {
+		var idx index
+		if err := rows.Scan(&idx.owner, &idx.name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+		indexes = append(indexes, idx)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	// 세션 하나에서 rows를 다 읽은 뒤에 GET_DDL을 부릅니다.
+	var ddls []string
+	for _, idx := range indexes {
+		ddl, err := getMetadataDDL(ctx, session, "GET_DDL", "INDEX", idx.name, idx.owner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get index DDL: %w", err)
+		}
+		ddls = append(ddls, ddl)
+	}
+
+	return ddls, nil
+}
+
+// getMetadataDDL은 DBMS_METADATA 함수(GET_DDL, GET_DEPENDENT_DDL)를 호출합니다.
+// 결과는 CLOB이며 앞뒤 공백/줄바꿈을 정리해서 돌려줍니다.
+func getMetadataDDL(ctx context.Context, session *sql.Conn, function, objectType, name, schema string) (string, error) {
+	var ddl sql.NullString
+
+	query := fmt.Sprintf("SELECT DBMS_METADATA.%s(:1, :2, :3) FROM dual", function)
+	if err := session.QueryRowContext(ctx, query, objectType, name, schema).Scan(&ddl); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(ddl.String) + "\n", nil
+}
+
+// isMetadataNotFound는 DBMS_METADATA의 "객체 없음" 에러인지 확인합니다.
+//   - ORA-31603: object not found in schema (GET_DDL)
+//   - ORA-31608: specified object not found (GET_DEPENDENT_DDL)
+func isMetadataNotFound(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "ORA-31603") || strings.Contains(msg, "ORA-31608")
+}
@@ -0,0 +1,329 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"space/internal/domain"
+)
+
+// Explain은 Oracle 실행 계획을 조회합니다.
+//
+//   - 일반 모드: EXPLAIN PLAN FOR → PLAN_TABLE 조회 → DBMS_XPLAN.DISPLAY
+//   - ANALYZE 모드: GATHER_PLAN_STATISTICS 힌트로 실제 실행 →
+//     V$SQL_PLAN_STATISTICS_ALL 조회 → DBMS_XPLAN.DISPLAY_CURSOR
+//
+// PLAN_TABLE과 V$SESSION은 세션 단위이므로 하나의 커넥션(*sql.Conn)에서 처리합니다.
+// ANALYZE 모드는 트랜잭션 안에서 실행하고 마지막에 롤백합니다.
+func (a *OracleAdapter) Explain(ctx context.Context, conn *sql.DB, query string, analyze bool) (*domain.QueryPlan, error) {
+	// Oracle은 문장 끝의 세미콜론을 허용하지 않습니다.
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+
+	if analyze {
+		return a.explainAnalyze(ctx, conn, query)
+	}
+
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer c.Close()
+
+	// STATEMENT_ID로 다른 세션/요청의 계획과 구분합니다.
+	statementID := fmt.Sprintf("DMS_%d", time.Now().UnixNano())
+
+	explainSQL := fmt.Sprintf("EXPLAIN PLAN SET STATEMENT_ID = '%s' FOR %s", statementID, query)
+	if _, err := c.ExecContext(ctx, explainSQL); err != nil {
+		return nil, fmt.Errorf("explain failed: %w", err)
+	}
+	// 조회가 끝나면 PLAN_TABLE에서 지웁니다.
+	defer c.ExecContext(context.Background(), "DELETE FROM plan_table WHERE statement_id = :1", statementID)
+
+	root, err := queryPlanRows(ctx, c, `
+		SELECT id, parent_id, operation, options, object_owner, object_name,
+		       cost, cardinality, bytes, access_predicates, filter_predicates,
+		       NULL, NULL
+		FROM plan_table
+		WHERE statement_id = :1
+		ORDER BY id
+	`, statementID)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := queryXplan(ctx, c,
+		"SELECT plan_table_output FROM TABLE(DBMS_XPLAN.DISPLAY('PLAN_TABLE', :1, 'TYPICAL'))",
+		statementID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.QueryPlan{
+		Root:     root,
+		Analyzed: false,
+		Raw:      raw,
+	}, nil
+}
+
+// selectPattern은 힌트를 넣을 첫 SELECT 키워드를 찾습니다.
+var selectPattern = regexp.MustCompile(`(?is)^\s*(WITH\b.*?\bSELECT|SELECT)\b`)
+
+// explainAnalyze는 쿼리를 실제로 실행한 뒤 실행 통계가 포함된 계획을 조회합니다.
+//
+// SELECT와 DML(INSERT/UPDATE/DELETE/MERGE)만 실행합니다.
+// DDL은 Oracle이 자동으로 커밋하므로 롤백으로 되돌릴 수 없기 때문!
+func (a *OracleAdapter) explainAnalyze(ctx context.Context, conn *sql.DB, query string) (*domain.QueryPlan, error) {
+	if kind := domain.ClassifyStatement(query); !kind.IsExplainable() {
+		return nil, fmt.Errorf("%w: analyze is only allowed for SELECT and DML (got %s)", domain.ErrExplainNotSupported, kind)
+	}
+
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer c.Close()
+
+	// 세션 통계 수준을 바꿨다면 롤백 후에 원래대로 되돌립니다 (defer는 역순으로 실행).
+	statsChanged := false
+	defer func() {
+		if statsChanged {
+			resetStatisticsLevel(c)
+		}
+	}()
+
+	tx, err := c.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Commit은 절대 하지 않습니다. DML의 부작용을 되돌리기 위해!
+	defer tx.Rollback()
+
+	// ==========================================
+	// 1단계: 통계 수집 힌트를 붙여서 실제 실행
+	// ==========================================
+
+	start := time.Now()
+	if loc := selectPattern.FindStringIndex(query); loc != nil {
+		hinted := query[:loc[1]] + " /*+ GATHER_PLAN_STATISTICS */" + query[loc[1]:]
+
+		rows, err := tx.QueryContext(ctx, hinted)
+		if err != nil {
+			return nil, fmt.Errorf("query execution failed: %w", err)
+		}
+		// 통계가 끝까지 집계되도록 모든 row를 읽습니다.
+		for rows.Next() {
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("error during row iteration: %w", err)
+		}
+	} else {
+		// DML은 힌트 위치가 다양하므로 세션 파라미터로 통계를 켭니다.
+		if _, err := tx.ExecContext(ctx, "ALTER SESSION SET statistics_level = ALL"); err != nil {
+			return nil, fmt.Errorf("failed to enable statistics: %w", err)
+		}
+		statsChanged = true
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return nil, fmt.Errorf("query execution failed: %w", err)
+		}
+	}
+	elapsed := float64(time.Since(start).Microseconds()) / 1000
+
+	// ==========================================
+	// 2단계: 방금 실행한 커서 찾기
+	// ==========================================
+
+	var sqlID string
+	var childNumber int64
+	err = tx.QueryRowContext(ctx, `
+		SELECT prev_sql_id, prev_child_number
+		FROM v$session
+		WHERE sid = SYS_CONTEXT('USERENV', 'SID')
+	`).Scan(&sqlID, &childNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executed cursor (requires SELECT on V$SESSION): %w", err)
+	}
+
+	// ==========================================
+	// 3단계: 실행 통계가 포함된 계획 조회
+	// ==========================================
+
+	// last_elapsed_time은 마이크로초 단위입니다.
+	root, err := queryPlanRows(ctx, tx, `
+		SELECT id, parent_id, operation, options, object_owner, object_name,
+		       cost, cardinality, bytes, access_predicates, filter_predicates,
+		       last_output_rows, last_elapsed_time / 1000
+		FROM v$sql_plan_statistics_all
+		WHERE sql_id = :1 AND child_number = :2
+		ORDER BY id
+	`, sqlID, childNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := queryXplan(ctx, tx,
+		"SELECT plan_table_output FROM TABLE(DBMS_XPLAN.DISPLAY_CURSOR(:1, :2, 'ALLSTATS LAST'))",
+		sqlID, childNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.QueryPlan{
+		Root:            root,
+		Analyzed:        true,
+		ExecutionTimeMs: &elapsed,
+		Raw:             raw,
+	}, nil
+}
+
+// resetStatisticsLevel은 세션 통계 수준을 기본값(TYPICAL)으로 되돌립니다.
+// 커넥션은 풀로 돌아가므로, 되돌리지 못하면 ALL이 남은 세션을 다른 요청이 쓰지 않도록
+// 커넥션을 버립니다 (Raw에서 driver.ErrBadConn을 반환하면 풀이 닫아버림).
+func resetStatisticsLevel(c *sql.Conn) {
+	_, err := c.ExecContext(context.Background(), "ALTER SESSION SET statistics_level = TYPICAL")
+	if err == nil {
+		return
+	}
+
+	log.Printf("failed to reset statistics_level, discarding connection: %v", err)
+	c.Raw(func(interface{}) error {
+		return driver.ErrBadConn
+	})
+}
+
+// queryer는 *sql.Conn과 *sql.Tx의 공통 메서드입니다.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// queryPlanRows는 PLAN_TABLE 형태의 row들을 읽어 트리로 조립합니다.
+// 쿼리는 아래 순서의 13개 컬럼을 반환해야 합니다:
+// id, parent_id, operation, options, object_owner, object_name,
+// cost, cardinality, bytes, access_predicates, filter_predicates,
+// actual_rows, actual_time_ms
+func queryPlanRows(ctx context.Context, q queryer, query string, args ...interface{}) (*domain.PlanNode, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query plan: %w", err)
+	}
+	defer rows.Close()
+
+	nodes := make(map[int64]*domain.PlanNode)
+	var root *domain.PlanNode
+	var order []int64
+	parents := make(map[int64]int64)
+
+	for rows.Next() {
+		var (
+			id                       int64
+			parentID                 sql.NullInt64
+			operation, options       sql.NullString
+			owner, objectName        sql.NullString
+			cost, cardinality, bytes sql.NullFloat64
+			accessPred, filterPred   sql.NullString
+			actualRows, actualTimeMs sql.NullFloat64
+		)
+
+		if err := rows.Scan(&id, &parentID, &operation, &options, &owner, &objectName,
+			&cost, &cardinality, &bytes, &accessPred, &filterPred,
+			&actualRows, &actualTimeMs); err != nil {
+			return nil, fmt.Errorf("failed to scan plan row: %w", err)
+		}
+
+		// "TABLE ACCESS" + "FULL" → "TABLE ACCESS FULL"
+		op := operation.String
+		if options.String != "" {
+			op += " " + options.String
+		}
+
+		node := &domain.PlanNode{
+			Operation: op,
+			Cost:      cost.Float64,
+			Rows:      cardinality.Float64,
+			Details:   make(map[string]interface{}),
+		}
+
+		if objectName.String != "" {
+			node.Object = objectName.String
+			if owner.String != "" {
+				node.Object = owner.String + "." + objectName.String
+			}
+		}
+		if bytes.Valid {
+			node.Details["bytes"] = bytes.Float64
+		}
+		if accessPred.Valid {
+			node.Details["access_predicates"] = accessPred.String
+		}
+		if filterPred.Valid {
+			node.Details["filter_predicates"] = filterPred.String
+		}
+		if actualRows.Valid {
+			v := actualRows.Float64
+			node.ActualRows = &v
+		}
+		if actualTimeMs.Valid {
+			v := actualTimeMs.Float64
+			node.ActualTimeMs = &v
+		}
+
+		nodes[id] = node
+		order = append(order, id)
+		if parentID.Valid {
+			parents[id] = parentID.Int64
+		} else if root == nil {
+			root = node
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	// id 순서대로 부모에 붙여야 자식 순서가 계획 순서와 같아집니다.
+	for _, id := range order {
+		parentID, ok := parents[id]
+		if !ok {
+			continue
+		}
+		if parent, exists := nodes[parentID]; exists {
+			parent.Children = append(parent.Children, nodes[id])
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("%w: plan not found", domain.ErrExplainNotSupported)
+	}
+
+	return root, nil
+}
+
+// queryXplan은 DBMS_XPLAN 출력 텍스트를 한 문자열로 합칩니다.
+func queryXplan(ctx context.Context, q queryer, query string, args ...interface{}) (string, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to query DBMS_XPLAN: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var line sql.NullString
+		if err := rows.Scan(&line); err != nil {
+			return "", fmt.Errorf("failed to scan DBMS_XPLAN output: %w", err)
+		}
+		lines = append(lines, line.String)
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
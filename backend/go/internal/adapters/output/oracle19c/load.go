@@ -0,0 +1,63 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	"space/internal/domain"
+)
+
+// LoadRows는 배열 바인드로 row 묶음을 한 번에 넣습니다.
+//
+// go-ora는 바인드 값이 모두 슬라이스면 INSERT/MERGE를 배열 바인드로 실행합니다.
+// 그래서 row 목록을 컬럼별 값 배열로 바꿔서 넘기면, 묶음 전체가 한 번의 왕복으로 실행됩니다.
+func (a *OracleAdapter) LoadRows(ctx context.Context, tx *sql.Tx, plan *domain.ImportPlan, rows [][]interface{}) error {
+	args := make([]interface{}, len(plan.Columns))
+	for j := range plan.Columns {
+		values := make([]interface{}, len(rows))
+		for i, row := range rows {
+			values[i] = row[j]
+		}
+		args[j] = uniformValues(values)
+	}
+
+	if _, err := tx.ExecContext(ctx, plan.Load, args...); err != nil {
+		return fmt.Errorf("array insert failed: %w", err)
+	}
+	return nil
+}
+
+// uniformValues는 한 컬럼의 값 타입을 맞춥니다.
+//
+// 배열 바인드는 배열 하나를 한 가지 Oracle 타입으로 보내므로, 예를 들어 NUMBER 컬럼에
+// int64와 소수 문자열("12.5")이 섞여 있으면 값이 잘못 전달됩니다.
+// 타입이 섞여 있으면 모두 문자열로 바꿔서 Oracle이 컬럼 타입으로 변환하게 합니다.
+func uniformValues(values []interface{}) []interface{} {
+	var first reflect.Type
+	mixed := false
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		t := reflect.TypeOf(v)
+		if first == nil {
+			first = t
+		} else if t != first {
+			mixed = true
+			break
+		}
+	}
+	if !mixed {
+		return values
+	}
+
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		if v != nil {
+			result[i] = fmt.Sprint(v)
+		}
+	}
+	return result
+}
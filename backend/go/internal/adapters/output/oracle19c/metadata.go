@@ -0,0 +1,324 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+
+	"space/internal/domain"
+)
+
+// constraintTypes는 all_constraints.constraint_type을 domain.ConstraintType으로 바꾸는 표입니다.
+var constraintTypes = map[string]domain.ConstraintType{
+	"P": domain.ConstraintPrimaryKey,
+	"U": domain.ConstraintUnique,
+	"C": domain.ConstraintCheck,
+}
+
+// notNullPattern은 NOT NULL 컬럼마다 Oracle이 자동으로 만드는 체크 조건입니다.
+// (예: "EMAIL" IS NOT NULL) 컬럼 정보의 nullable과 중복이므로 제약조건 목록에서 뺍니다.
+var notNullPattern = regexp.MustCompile(`^"[^"]+" IS NOT NULL$`)
+
+// GetTableMetadata는 테이블의 인덱스, 제약조건, 외래 키를 조회합니다.
+// 스키마를 먼저 확정한 뒤 (빈 문자열이면 CURRENT_SCHEMA) 세 가지를 차례로 조회합니다.
+func (a *OracleAdapter) GetTableMetadata(ctx context.Context, conn *sql.DB, schema string, tableName string) (*domain.TableMetadata, error) {
+	if err := conn.QueryRowContext(ctx, "SELECT "+currentSchema+" FROM dual", schema).Scan(&schema); err != nil {
+		return nil, fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	metadata := &domain.TableMetadata{
+		Schema: schema,
+		Table:  tableName,
+		Owner:  schema,
+	}
+
+	var comment sql.NullString
+	err := conn.QueryRowContext(ctx,
+		"SELECT comments FROM all_tab_comments WHERE owner = :1 AND table_name = :2",
+		schema, tableName).Scan(&comment)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query table comment: %w", err)
+	}
+	metadata.Comment = comment.String
+
+	if metadata.Indexes, err = a.getIndexes(ctx, conn, schema, tableName); err != nil {
+		return nil, err
+	}
+	if metadata.Constraints, err = a.getConstraints(ctx, conn, schema, tableName); err != nil {
+		return nil, err
+	}
+	if metadata.ForeignKeys, metadata.ReferencedBy, err = a.getForeignKeys(ctx, conn, schema, tableName); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// getIndexes는 테이블의 인덱스 목록을 조회합니다.
+//
+// 인덱스 컬럼은 all_ind_columns에 있고, 함수 기반 인덱스의 식은
+// all_ind_expressions에 따로 있습니다 (column_name은 SYS_NC00005$ 같은 숨은 이름).
+// LOB 인덱스는 Oracle이 내부적으로 만드는 것이므로 제외합니다.
+func (a *OracleAdapter) getIndexes(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.IndexInfo, error) {
+	query := `
+		SELECT i.owner, i.index_name, i.uniqueness, i.index_type,
+		       CASE WHEN c.constraint_name IS NOT NULL THEN 1 ELSE 0 END AS is_primary
+		FROM all_indexes i
+		LEFT JOIN all_constraints c
+		       ON c.owner = i.table_owner AND c.table_name = i.table_name
+		      AND c.constraint_type = 'P' AND c.index_name = i.index_name
+		WHERE i.table_owner = :1
+		  AND i.table_name = :2
+		  AND i.index_type <> 'LOB'
+		ORDER BY is_primary DESC, i.index_name
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexes: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []domain.IndexInfo
+	positions := make(map[string]int) // "소유자.이름" → indexes 위치
+
+	for rows.Next() {
+		var owner, uniqueness string
+		var primary int
+		var index domain.IndexInfo
+
+		if err := rows.Scan(&owner, &index.Name, &uniqueness, &index.Type, &primary); err != nil {
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+
+		index.Unique = uniqueness == "UNIQUE"
+		index.Primary = primary == 1
+
+		positions[owner+"."+index.Name] = len(indexes)
+		indexes = append(indexes, index)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	// 인덱스 컬럼 (함수 기반 인덱스는 식으로 대체)
+	columnQuery := `
+		SELECT ic.index_owner, ic.index_name, ic.column_name, ic.descend, ie.column_expression
+		FROM all_ind_columns ic
+		LEFT JOIN all_ind_expressions ie
+		       ON ie.index_owner = ic.index_owner AND ie.index_name = ic.index_name
+		      AND ie.column_position = ic.column_position
+		WHERE ic.table_owner = :1
+		  AND ic.table_name = :2
+		ORDER BY ic.index_owner, ic.index_name, ic.column_position
+	`
+
+	columnRows, err := conn.QueryContext(ctx, columnQuery, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query index columns: %w", err)
+	}
+	defer columnRows.Close()
+
+	for columnRows.Next() {
+		var owner, indexName, columnName string
+		var descend, expression sql.NullString
+
+		if err := columnRows.Scan(&owner, &indexName, &columnName, &descend, &expression); err != nil {
+			return nil, fmt.Errorf("failed to scan index column: %w", err)
+		}
+
+		i, ok := positions[owner+"."+indexName]
+		if !ok {
+			continue
+		}
+
+		column := columnName
+		if expression.Valid {
+			column = expression.String
+		}
+		if descend.String == "DESC" {
+			column += " DESC"
+		}
+		indexes[i].Columns = append(indexes[i].Columns, column)
+	}
+
+	if err := columnRows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return indexes, nil
+}
+
+// getConstraints는 기본 키, 유니크, 체크 제약조건을 조회합니다.
+// search_condition은 LONG 타입이라 SQL에서 LIKE로 거를 수 없어서,
+// 자동 생성된 NOT NULL 체크는 Go에서 제외합니다.
+func (a *OracleAdapter) getConstraints(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.ConstraintInfo, error) {
+	query := `
+		SELECT constraint_name, constraint_type, search_condition, generated, deferrable
+		FROM all_constraints
+		WHERE owner = :1
+		  AND table_name = :2
+		  AND constraint_type IN ('P', 'U', 'C')
+		ORDER BY DECODE(constraint_type, 'P', 1, 'U', 2, 3), constraint_name
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query constraints: %w", err)
+	}
+	defer rows.Close()
+
+	var constraints []domain.ConstraintInfo
+	positions := make(map[string]int) // 제약조건 이름 → constraints 위치
+
+	for rows.Next() {
+		var constraint domain.ConstraintInfo
+		var constraintType, generated, deferrable string
+		var condition sql.NullString
+
+		if err := rows.Scan(&constraint.Name, &constraintType, &condition, &generated, &deferrable); err != nil {
+			return nil, fmt.Errorf("failed to scan constraint: %w", err)
+		}
+
+		if constraintType == "C" && generated == "GENERATED NAME" && notNullPattern.MatchString(condition.String) {
+			continue
+		}
+
+		constraint.Type = constraintTypes[constraintType]
+		constraint.Expression = condition.String
+		constraint.Deferrable = deferrable == "DEFERRABLE"
+
+		positions[constraint.Name] = len(constraints)
+		constraints = append(constraints, constraint)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	columnQuery := `
+		SELECT constraint_name, column_name
+		FROM all_cons_columns
+		WHERE owner = :1
+		  AND table_name = :2
+		ORDER BY constraint_name, position
+	`
+
+	columnRows, err := conn.QueryContext(ctx, columnQuery, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query constraint columns: %w", err)
+	}
+	defer columnRows.Close()
+
+	for columnRows.Next() {
+		var constraintName, columnName string
+
+		if err := columnRows.Scan(&constraintName, &columnName); err != nil {
+			return nil, fmt.Errorf("failed to scan constraint column: %w", err)
+		}
+
+		if i, ok := positions[constraintName]; ok {
+			constraints[i].Columns = append(constraints[i].Columns, columnName)
+		}
+	}
+
+	if err := columnRows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return constraints, nil
+}
+
+// getForeignKeys는 테이블에서 나가는 외래 키와 들어오는 외래 키를 한 번에 조회합니다.
+//
+// 외래 키(R)는 참조하는 기본 키/유니크 제약조건(r_constraint_name)을 가리키므로
+// 양쪽 all_cons_columns를 position으로 맞춰서 컬럼 쌍을 만듭니다.
+// 컬럼마다 row가 하나씩 나오므로 Go에서 제약조건별로 묶습니다.
+// Oracle은 ON UPDATE를 지원하지 않으므로 delete_rule만 있습니다.
+func (a *OracleAdapter) getForeignKeys(ctx context.Context, conn *sql.DB, schema string, tableName string) (outgoing, incoming []domain.ForeignKeyInfo, err error) {
+	query := `
+		WITH target AS (SELECT :1 AS owner, :2 AS table_name FROM dual)
+		SELECT c.owner, c.constraint_name, c.table_name, cc.column_name,
+		       r.owner, r.table_name, rc.column_name, c.delete_rule,
+		       CASE WHEN c.owner = t.owner AND c.table_name = t.table_name THEN 1 ELSE 0 END,
+		       CASE WHEN r.owner = t.owner AND r.table_name = t.table_name THEN 1 ELSE 0 END
+		FROM target t
+		JOIN all_constraints c ON c.constraint_type = 'R'
+		JOIN all_constraints r
+		  ON r.owner = c.r_owner AND r.constraint_name = c.r_constraint_name
+		JOIN all_cons_columns cc
+		  ON cc.owner = c.owner AND cc.constraint_name = c.constraint_name
+		JOIN all_cons_columns rc
+		  ON rc.owner = r.owner AND rc.constraint_name = r.constraint_name
+		 AND rc.position = cc.position
+		WHERE (c.owner = t.owner AND c.table_name = t.table_name)
+		   OR (r.owner = t.owner AND r.table_name = t.table_name)
+		ORDER BY c.owner, c.constraint_name, cc.position
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	type foreignKey struct {
+		info               domain.ForeignKeyInfo
+		outgoing, incoming bool
+	}
+	var keys []*foreignKey
+	byName := make(map[string]*foreignKey) // "소유자.이름" → 외래 키
+
+	for rows.Next() {
+		var (
+			owner, name, table, column    string
+			refOwner, refTable, refColumn string
+			deleteRule                    string
+			isOutgoing, isIncoming        int
+		)
+
+		if err := rows.Scan(&owner, &name, &table, &column,
+			&refOwner, &refTable, &refColumn, &deleteRule,
+			&isOutgoing, &isIncoming); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan foreign key: %w", err)
+		}
+
+		fk, ok := byName[owner+"."+name]
+		if !ok {
+			fk = &foreignKey{
+				info: domain.ForeignKeyInfo{
+					Name:      name,
+					Schema:    owner,
+					Table:     table,
+					RefSchema: refOwner,
+					RefTable:  refTable,
+					OnDelete:  deleteRule,
+				},
+				outgoing: isOutgoing == 1,
+				incoming: isIncoming == 1,
+			}
+			byName[owner+"."+name] = fk
+			keys = append(keys, fk)
+		}
+
+		fk.info.Columns = append(fk.info.Columns, column)
+		fk.info.RefColumns = append(fk.info.RefColumns, refColumn)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	for _, fk := range keys {
+		if fk.outgoing {
+			outgoing = append(outgoing, fk.info)
+		}
+		if fk.incoming {
+			incoming = append(incoming, fk.info)
+		}
+	}
+
+	return outgoing, incoming, nil
+}
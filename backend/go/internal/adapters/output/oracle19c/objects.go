@@ -0,0 +1,260 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"space/internal/domain"
+)
+
+// objectKinds는 all_objects.object_type을 domain.ObjectType으로 바꾸는 표입니다.
+// PACKAGE BODY는 PACKAGE의 일부로 보므로 목록에 따로 나오지 않습니다.
+var objectKinds = map[string]domain.ObjectType{
+	"VIEW":              domain.ObjectView,
+	"MATERIALIZED VIEW": domain.ObjectMaterializedView,
+	"FUNCTION":          domain.ObjectFunction,
+	"PROCEDURE":         domain.ObjectProcedure,
+	"PACKAGE":           domain.ObjectPackage,
+	"TRIGGER":           domain.ObjectTrigger,
+	"SEQUENCE":          domain.ObjectSequence,
+}
+
+// ListObjects는 스키마의 뷰, 함수, 프로시저, 패키지, 트리거, 시퀀스 목록을 조회합니다.
+//
+// all_objects.status로 컴파일 상태(VALID/INVALID)를 함께 돌려줍니다.
+// 종류 필터는 개수가 적어서 Go에서 처리합니다 (IN 절의 바인드 개수가 달라지지 않도록).
+func (a *OracleAdapter) ListObjects(ctx context.Context, conn *sql.DB, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error) {
+	query := `
+		SELECT o.owner, o.object_type, o.object_name, o.status, t.table_name
+		FROM all_objects o
+		LEFT JOIN all_triggers t
+		       ON o.object_type = 'TRIGGER' AND t.owner = o.owner AND t.trigger_name = o.object_name
+		WHERE o.owner = ` + currentSchema + `
+		  AND o.object_type IN ('VIEW', 'MATERIALIZED VIEW', 'FUNCTION', 'PROCEDURE',
+		                        'PACKAGE', 'TRIGGER', 'SEQUENCE')
+		ORDER BY o.object_type, o.object_name
+	`
+
+	wanted := make(map[domain.ObjectType]bool)
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	rows, err := conn.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query objects: %w", err)
+	}
+	defer rows.Close()
+
+	var objects []domain.DatabaseObject
+
+	for rows.Next() {
+		var object domain.DatabaseObject
+		var objectType string
+		var table sql.NullString
+
+		if err := rows.Scan(&object.Schema, &objectType, &object.Name, &object.Status, &table); err != nil {
+			return nil, fmt.Errorf("failed to scan object: %w", err)
+		}
+
+		object.Type = objectKinds[objectType]
+		if len(wanted) > 0 && !wanted[object.Type] {
+			continue
+		}
+
+		object.Table = table.String
+		objects = append(objects, object)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return objects, nil
+}
+
+// GetObject는 객체 하나의 정의(소스)를 조회합니다.
+//
+// 함수/프로시저/패키지/트리거의 소스는 all_source에 한 줄씩 저장되어 있으므로
+// line 순서대로 이어 붙이고 "CREATE OR REPLACE "를 앞에 붙입니다.
+func (a *OracleAdapter) GetObject(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	if err := conn.QueryRowContext(ctx, "SELECT "+currentSchema+" FROM dual", schema).Scan(&schema); err != nil {
+		return nil, fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	oracleType := ""
+	for kind, t := range objectKinds {
+		if t == objectType {
+			oracleType = kind
+		}
+	}
+
+	object := &domain.DatabaseObject{
+		Schema:  schema,
+		Name:    name,
+		Type:    objectType,
+		Details: make(map[string]interface{}),
+	}
+
+	// 존재 여부와 컴파일 상태
+	err := conn.QueryRowContext(ctx,
+		"SELECT status FROM all_objects WHERE owner = :1 AND object_name = :2 AND object_type = :3",
+		schema, name, oracleType).Scan(&object.Status)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: %s %s", domain.ErrObjectNotFound, objectType, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query object: %w", err)
+	}
+
+	switch objectType {
+	case domain.ObjectView:
+		err = conn.QueryRowContext(ctx,
+			"SELECT text FROM all_views WHERE owner = :1 AND view_name = :2",
+			schema, name).Scan(&object.Definition)
+	case domain.ObjectMaterializedView:
+		err = a.getMaterializedView(ctx, conn, object)
+	case domain.ObjectSequence:
+		err = a.getSequence(ctx, conn, object)
+	case domain.ObjectPackage:
+		if object.Definition, err = a.getSource(ctx, conn, schema, name, "PACKAGE"); err == nil {
+			object.Body, err = a.getSource(ctx, conn, schema, name, "PACKAGE BODY")
+		}
+	case domain.ObjectTrigger:
+		if err = a.getTrigger(ctx, conn, object); err == nil {
+			object.Definition, err = a.getSource(ctx, conn, schema, name, oracleType)
+		}
+	default:
+		object.Definition, err = a.getSource(ctx, conn, schema, name, oracleType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s definition: %w", objectType, err)
+	}
+
+	return object, nil
+}
+
+// getSource는 all_source에서 PL/SQL 소스를 한 줄씩 읽어 이어 붙입니다.
+// 각 줄의 text에는 줄바꿈이 포함되어 있습니다.
+// 소스가 없으면 (예: 본문 없는 패키지) 빈 문자열을 반환합니다.
+func (a *OracleAdapter) getSource(ctx context.Context, conn *sql.DB, schema, name, sourceType string) (string, error) {
+	rows, err := conn.QueryContext(ctx, `
+		SELECT text FROM all_source
+		WHERE owner = :1 AND name = :2 AND type = :3
+		ORDER BY line
+	`, schema, name, sourceType)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var source strings.Builder
+
+	for rows.Next() {
+		var line sql.NullString
+		if err := rows.Scan(&line); err != nil {
+			return "", err
+		}
+		source.WriteString(line.String)
+	}
+
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	if source.Len() == 0 {
+		return "", nil
+	}
+	return "CREATE OR REPLACE " + strings.TrimRight(source.String(), "\n"), nil
+}
+
+// getMaterializedView는 머티리얼라이즈드 뷰의 SELECT 문과 갱신 설정을 조회합니다.
+func (a *OracleAdapter) getMaterializedView(ctx context.Context, conn *sql.DB, object *domain.DatabaseObject) error {
+	var refreshMode, refreshMethod, staleness sql.NullString
+	var lastRefresh sql.NullTime
+
+	if err := conn.QueryRowContext(ctx, `
+		SELECT query, refresh_mode, refresh_method, staleness, last_refresh_date
+		FROM all_mviews
+		WHERE owner = :1 AND mview_name = :2
+	`, object.Schema, object.Name).Scan(&object.Definition, &refreshMode, &refreshMethod,
+		&staleness, &lastRefresh); err != nil {
+		return err
+	}
+
+	object.Details["refresh_mode"] = refreshMode.String
+	object.Details["refresh_method"] = refreshMethod.String
+	object.Details["staleness"] = staleness.String
+	if lastRefresh.Valid {
+		object.Details["last_refresh"] = lastRefresh.Time
+	}
+	return nil
+}
+
+// getTrigger는 트리거가 걸린 테이블과 발생 시점/이벤트를 조회합니다.
+func (a *OracleAdapter) getTrigger(ctx context.Context, conn *sql.DB, object *domain.DatabaseObject) error {
+	var table, timing, event, status sql.NullString
+
+	if err := conn.QueryRowContext(ctx, `
+		SELECT table_name, trigger_type, triggering_event, status
+		FROM all_triggers
+		WHERE owner = :1 AND trigger_name = :2
+	`, object.Schema, object.Name).Scan(&table, &timing, &event, &status); err != nil {
+		return err
+	}
+
+	object.Table = table.String
+	object.Details["timing"] = timing.String
+	object.Details["event"] = event.String
+	object.Details["enabled"] = status.String == "ENABLED"
+	return nil
+}
+
+// getSequence는 시퀀스 설정을 조회하고 CREATE SEQUENCE 문을 만듭니다.
+//
+// max_value 기본값이 9999999999999999999999999999(28자리)라 int64를 넘으므로
+// 숫자 컬럼을 문자열로 읽습니다.
+// last_number는 캐시 때문에 실제 마지막 값보다 클 수 있습니다.
+func (a *OracleAdapter) getSequence(ctx context.Context, conn *sql.DB, object *domain.DatabaseObject) error {
+	var minValue, maxValue, step, cacheSize, lastNumber string
+	var cycleFlag, orderFlag string
+
+	if err := conn.QueryRowContext(ctx, `
+		SELECT TO_CHAR(min_value), TO_CHAR(max_value), TO_CHAR(increment_by),
+		       TO_CHAR(cache_size), TO_CHAR(last_number), cycle_flag, order_flag
+		FROM all_sequences
+		WHERE sequence_owner = :1 AND sequence_name = :2
+	`, object.Schema, object.Name).Scan(&minValue, &maxValue, &step, &cacheSize,
+		&lastNumber, &cycleFlag, &orderFlag); err != nil {
+		return err
+	}
+
+	cacheClause := "NOCACHE"
+	if cacheSize != "0" {
+		cacheClause = "CACHE " + cacheSize
+	}
+	cycleClause := "NOCYCLE"
+	if cycleFlag == "Y" {
+		cycleClause = "CYCLE"
+	}
+	orderClause := "NOORDER"
+	if orderFlag == "Y" {
+		orderClause = "ORDER"
+	}
+
+	object.Definition = fmt.Sprintf(`CREATE SEQUENCE "%s"."%s" START WITH %s INCREMENT BY %s MINVALUE %s MAXVALUE %s %s %s %s;`,
+		object.Schema, object.Name, lastNumber, step, minValue, maxValue,
+		cacheClause, cycleClause, orderClause)
+
+	object.Details["min_value"] = minValue
+	object.Details["max_value"] = maxValue
+	object.Details["increment_by"] = step
+	object.Details["cache_size"] = cacheSize
+	object.Details["last_number"] = lastNumber
+	object.Details["cycle"] = cycleFlag == "Y"
+	object.Details["order"] = orderFlag == "Y"
+	return nil
+}
@@ -0,0 +1,308 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"space/internal/domain"
+)
+
+// objectTypes는 Oracle 객체 종류를 domain.TableType으로 바꾸는 표입니다.
+var objectTypes = map[string]domain.TableType{
+	"TABLE":             domain.TableTypeTable,
+	"VIEW":              domain.TableTypeView,
+	"MATERIALIZED VIEW": domain.TableTypeMaterializedView,
+}
+
+// currentSchema는 첫 번째 바인드 변수(스키마)가 비어 있으면
+// 세션의 현재 스키마를 쓰는 SQL 식입니다.
+// Oracle은 빈 문자열을 NULL로 취급하므로 NVL로 처리할 수 있습니다.
+const currentSchema = "NVL(:1, SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA'))"
+
+// GetSchemas는 스키마(= 사용자) 목록을 조회합니다.
+// Oracle은 사용자마다 스키마가 하나씩 있으므로 all_users를 조회합니다.
+func (a *OracleAdapter) GetSchemas(ctx context.Context, conn *sql.DB) ([]string, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT username FROM all_users ORDER BY username")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schemas: %w", err)
+	}
+	defer rows.Close()
+
+	var schemas []string
+
+	for rows.Next() {
+		var name string
+
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan schema name: %w", err)
+		}
+
+		schemas = append(schemas, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return schemas, nil
+}
+
+// GetTables는 스키마의 테이블, 뷰, 머티리얼라이즈드 뷰 목록을 조회합니다.
+//
+// all_* 딕셔너리 뷰는 접속 사용자가 권한을 가진 객체만 보여줍니다.
+// (다른 사용자 스키마는 SELECT 권한을 받은 테이블만 나옴)
+//
+// 머티리얼라이즈드 뷰는 같은 이름의 테이블도 함께 만들어지므로
+// all_tables에서 제외해야 두 번 나오지 않습니다.
+// dropped = 'NO'는 휴지통(BIN$...) 테이블을 제외합니다.
+func (a *OracleAdapter) GetTables(ctx context.Context, conn *sql.DB, schema string) ([]domain.TableInfo, error) {
+	query := `
+		WITH target AS (SELECT ` + currentSchema + ` AS owner FROM dual)
+		SELECT owner, name, object_type FROM (
+			SELECT t.owner, t.table_name AS name, 'TABLE' AS object_type
+			FROM all_tables t
+			JOIN target ON t.owner = target.owner
+			WHERE t.dropped = 'NO'
+			  AND NOT EXISTS (
+				SELECT 1 FROM all_mviews m
+				WHERE m.owner = t.owner AND m.mview_name = t.table_name
+			  )
+			UNION ALL
+			SELECT v.owner, v.view_name, 'VIEW'
+			FROM all_views v
+			JOIN target ON v.owner = target.owner
+			UNION ALL
+			SELECT m.owner, m.mview_name, 'MATERIALIZED VIEW'
+			FROM all_mviews m
+			JOIN target ON m.owner = target.owner
+		)
+		ORDER BY name
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []domain.TableInfo
+
+	for rows.Next() {
+		var owner, name, objectType string
+
+		if err := rows.Scan(&owner, &name, &objectType); err != nil {
+			return nil, fmt.Errorf("failed to scan table: %w", err)
+		}
+
+		tables = append(tables, domain.TableInfo{
+			Schema: owner,
+			Name:   name,
+			Type:   objectTypes[objectType],
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return tables, nil
+}
+
+// GetColumns는 특정 테이블(또는 뷰)의 컬럼 목록을 조회합니다.
+//
+//   - all_tab_columns: 타입, 길이, 정밀도, NULL 허용, 기본값 (테이블과 뷰 모두)
+//   - all_col_comments: 컬럼 주석
+//   - all_constraints + all_cons_columns (constraint_type = 'P'): 기본 키 순서
+//   - all_tab_identity_cols: identity 컬럼 (12c부터, getIdentityColumns 참고)
+func (a *OracleAdapter) GetColumns(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.ColumnInfo, error) {
+	query := `
+		SELECT
+			c.column_name, c.column_id, c.data_type,
+			c.data_length, c.char_length, c.char_used,
+			c.data_precision, c.data_scale,
+			c.nullable, c.data_default,
+			cm.comments,
+			pk.position
+		FROM all_tab_columns c
+		LEFT JOIN all_col_comments cm
+		       ON cm.owner = c.owner AND cm.table_name = c.table_name AND cm.column_name = c.column_name
+		LEFT JOIN (
+			SELECT cc.owner, cc.table_name, cc.column_name, cc.position
+			FROM all_constraints k
+			JOIN all_cons_columns cc
+			  ON cc.owner = k.owner AND cc.constraint_name = k.constraint_name
+			WHERE k.constraint_type = 'P'
+		) pk ON pk.owner = c.owner AND pk.table_name = c.table_name AND pk.column_name = c.column_name
+		WHERE c.owner = ` + currentSchema + `
+		  AND c.table_name = :2
+		ORDER BY c.column_id
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []domain.ColumnInfo
+
+	for rows.Next() {
+		var (
+			column                 domain.ColumnInfo
+			dataLength, charLength sql.NullInt64
+			charUsed               sql.NullString
+			precision, scale       sql.NullInt64
+			nullable               string
+			defaultExpr, comment   sql.NullString
+			pkPosition             sql.NullInt64
+		)
+
+		if err := rows.Scan(&column.Name, &column.Position, &column.BaseType,
+			&dataLength, &charLength, &charUsed, &precision, &scale,
+			&nullable, &defaultExpr, &comment, &pkPosition); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+
+		column.Nullable = nullable == "Y"
+		column.Comment = comment.String
+
+		// data_default는 LONG 타입이라 끝에 공백/줄바꿈이 붙어 있는 경우가 많습니다.
+		if def := strings.TrimSpace(defaultExpr.String); def != "" {
+			column.Default = &def
+		}
+
+		if pkPosition.Valid {
+			column.PrimaryKey = true
+			column.PrimaryKeyPosition = int(pkPosition.Int64)
+		}
+
+		switch column.BaseType {
+		case "VARCHAR2", "NVARCHAR2", "CHAR", "NCHAR":
+			if charLength.Valid {
+				column.Length = &charLength.Int64
+			}
+		case "RAW":
+			if dataLength.Valid {
+				column.Length = &dataLength.Int64
+			}
+		}
+		if precision.Valid {
+			p := int(precision.Int64)
+			column.Precision = &p
+		}
+		if scale.Valid {
+			s := int(scale.Int64)
+			column.Scale = &s
+		}
+
+		column.DataType = formatOracleType(column.BaseType, column.Length, charUsed.String, column.Precision, column.Scale)
+		columns = append(columns, column)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	identities, err := a.getIdentityColumns(ctx, conn, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range columns {
+		columns[i].Identity = identities[columns[i].Name]
+	}
+
+	return columns, nil
+}
+
+// getIdentityColumns는 테이블의 identity 컬럼을 조회합니다 (컬럼 이름 → 정보).
+//
+// all_tab_identity_cols는 12c부터 있으므로 11g에서는 ORA-00942
+// (table or view does not exist)가 납니다. 11g에는 identity 컬럼이 없으므로
+// 이 경우는 빈 결과로 처리합니다.
+func (a *OracleAdapter) getIdentityColumns(ctx context.Context, conn *sql.DB, schema string, tableName string) (map[string]*domain.ColumnIdentity, error) {
+	query := `
+		SELECT column_name, generation_type, sequence_name
+		FROM all_tab_identity_cols
+		WHERE owner = ` + currentSchema + `
+		  AND table_name = :2
+	`
+
+	identities := make(map[string]*domain.ColumnIdentity)
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		if strings.Contains(err.Error(), "ORA-00942") {
+			return identities, nil
+		}
+		return nil, fmt.Errorf("failed to query identity columns: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		var generation, sequence sql.NullString
+
+		if err := rows.Scan(&name, &generation, &sequence); err != nil {
+			return nil, fmt.Errorf("failed to scan identity column: %w", err)
+		}
+
+		identities[name] = &domain.ColumnIdentity{
+			Generation: domain.IdentityGeneration(generation.String),
+			Sequence:   sequence.String,
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return identities, nil
+}
+
+// formatOracleType은 all_tab_columns 값으로 DDL에 쓰는 모양의 타입을 만듭니다.
+//   - VARCHAR2(100), VARCHAR2(100 CHAR) (char_used = 'C'면 문자 단위)
+//   - NUMBER, NUMBER(10), NUMBER(10,2), NUMBER(*,0)
+//   - FLOAT(126), RAW(16)
+//
+// DATE, TIMESTAMP(6)처럼 data_type에 이미 정밀도가 있는 타입은 그대로 둡니다.
+func formatOracleType(baseType string, length *int64, charUsed string, precision, scale *int) string {
+	switch baseType {
+	case "VARCHAR2", "CHAR":
+		if length == nil {
+			return baseType
+		}
+		if charUsed == "C" {
+			return fmt.Sprintf("%s(%d CHAR)", baseType, *length)
+		}
+		return fmt.Sprintf("%s(%d)", baseType, *length)
+
+	case "NVARCHAR2", "NCHAR", "RAW":
+		if length == nil {
+			return baseType
+		}
+		return fmt.Sprintf("%s(%d)", baseType, *length)
+
+	case "NUMBER":
+		switch {
+		case precision == nil && scale == nil:
+			return "NUMBER"
+		case precision == nil:
+			return fmt.Sprintf("NUMBER(*,%d)", *scale)
+		case scale == nil || *scale == 0:
+			return fmt.Sprintf("NUMBER(%d)", *precision)
+		default:
+			return fmt.Sprintf("NUMBER(%d,%d)", *precision, *scale)
+		}
+
+	case "FLOAT":
+		if precision == nil {
+			return baseType
+		}
+		return fmt.Sprintf("FLOAT(%d)", *precision)
+
+	default:
+		return baseType
+	}
+}
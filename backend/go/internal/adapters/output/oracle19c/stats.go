@@ -0,0 +1,224 @@
+package oracle19c
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"space/internal/domain"
+)
+
+// GetTableStats는 테이블의 row 수 통계와 세그먼트 크기를 조회합니다.
+// tableName이 비어 있으면 스키마의 모든 테이블을 조회합니다.
+//
+//   - num_rows, last_analyzed: DBMS_STATS가 마지막으로 수집한 값
+//   - inserts/updates/deletes: all_tab_modifications (마지막 통계 수집 이후 변경량)
+//   - 크기: 세그먼트(테이블, 인덱스, LOB)의 bytes 합계
+//
+// 세그먼트 크기는 dba_segments가 가장 정확하지만 권한이 필요합니다.
+// 권한이 없으면 자기 스키마일 때만 user_segments로 대신하고, 아니면 크기를 비워 둡니다.
+func (a *OracleAdapter) GetTableStats(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.TableStats, error) {
+	var user string
+	if err := conn.QueryRowContext(ctx, "SELECT "+currentSchema+", USER FROM dual", schema).Scan(&schema, &user); err != nil {
+		return nil, fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	query := `
+		SELECT t.table_name, t.num_rows, t.last_analyzed, m.inserts, m.updates, m.deletes
+		FROM all_tables t
+		LEFT JOIN all_tab_modifications m
+		       ON m.table_owner = t.owner AND m.table_name = t.table_name AND m.partition_name IS NULL
+		WHERE t.owner = :1
+		  AND t.dropped = 'NO'
+	`
+	args := []interface{}{schema}
+	if tableName != "" {
+		query += " AND t.table_name = :2"
+		args = append(args, tableName)
+	}
+	query += " ORDER BY t.table_name"
+
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query table stats: %w", err)
+	}
+
+	var stats []domain.TableStats
+
+	for rows.Next() {
+		var (
+			stat                      domain.TableStats
+			numRows                   sql.NullInt64
+			lastAnalyzed              sql.NullTime
+			inserts, updates, deletes sql.NullInt64
+		)
+
+		if err := rows.Scan(&stat.Table, &numRows, &lastAnalyzed, &inserts, &updates, &deletes); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan table stats: %w", err)
+		}
+
+		stat.Schema = schema
+		stat.EstimatedRows = int64Ptr(numRows)
+		stat.LastAnalyzed = timePtr(lastAnalyzed)
+		stat.Inserts = int64Ptr(inserts)
+		stat.Updates = int64Ptr(updates)
+		stat.Deletes = int64Ptr(deletes)
+
+		stats = append(stats, stat)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	sizes, err := a.segmentSizes(ctx, conn, schema, user)
+	if err != nil {
+		return nil, err
+	}
+	if sizes == nil {
+		return stats, nil
+	}
+
+	for i := range stats {
+		size := sizes[stats[i].Table]
+		stats[i].TableBytes = &size.table
+		stats[i].IndexBytes = &size.index
+		stats[i].ToastBytes = &size.lob
+		stats[i].TotalBytes = domain.SumBytes(&size.table, &size.index, &size.lob)
+	}
+
+	return stats, nil
+}
+
+// segmentSize는 테이블 하나에 딸린 세그먼트 크기 합계입니다.
+type segmentSize struct {
+	table, index, lob int64
+}
+
+// segmentSizes는 스키마의 세그먼트 크기를 테이블별로 모읍니다.
+// 크기를 볼 수 없으면 (nil, nil)을 반환합니다.
+//
+// 세그먼트 이름은 종류마다 네임스페이스가 달라서 (테이블과 인덱스가 같은 이름일 수 있음)
+// segment_type으로 먼저 나눈 뒤 인덱스/LOB 이름을 테이블 이름으로 바꿉니다.
+// 파티션 테이블은 파티션마다 세그먼트가 있으므로 SUM으로 합칩니다.
+func (a *OracleAdapter) segmentSizes(ctx context.Context, conn *sql.DB, schema string, user string) (map[string]segmentSize, error) {
+	rows, err := conn.QueryContext(ctx, `
+		SELECT segment_name, segment_type, SUM(bytes)
+		FROM dba_segments
+		WHERE owner = :1
+		GROUP BY segment_name, segment_type
+	`, schema)
+	if err != nil && strings.Contains(err.Error(), "ORA-00942") {
+		// dba_segments 권한 없음 → 자기 스키마면 user_segments
+		if schema != user {
+			return nil, nil
+		}
+		rows, err = conn.QueryContext(ctx, `
+			SELECT segment_name, segment_type, SUM(bytes)
+			FROM user_segments
+			GROUP BY segment_name, segment_type
+		`)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query segments: %w", err)
+	}
+
+	type segment struct {
+		name, kind string
+		bytes      int64
+	}
+	var segments []segment
+
+	for rows.Next() {
+		var s segment
+		if err := rows.Scan(&s.name, &s.kind, &s.bytes); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan segment: %w", err)
+		}
+		segments = append(segments, s)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	indexTables, err := nameToTable(ctx, conn,
+		"SELECT index_name, table_name FROM all_indexes WHERE owner = :1 AND table_owner = :2", schema, schema)
+	if err != nil {
+		return nil, err
+	}
+	// LOB 세그먼트와 LOB 인덱스 모두 all_lobs에 있습니다.
+	lobTables, err := nameToTable(ctx, conn, `
+		SELECT segment_name, table_name FROM all_lobs WHERE owner = :1
+		UNION ALL
+		SELECT index_name, table_name FROM all_lobs WHERE owner = :2
+	`, schema, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make(map[string]segmentSize)
+	for _, s := range segments {
+		switch {
+		case strings.HasPrefix(s.kind, "TABLE"):
+			size := sizes[s.name]
+			size.table += s.bytes
+			sizes[s.name] = size
+		case strings.HasPrefix(s.kind, "INDEX"):
+			if table, ok := indexTables[s.name]; ok {
+				size := sizes[table]
+				size.index += s.bytes
+				sizes[table] = size
+			}
+		case strings.HasPrefix(s.kind, "LOB"):
+			if table, ok := lobTables[s.name]; ok {
+				size := sizes[table]
+				size.lob += s.bytes
+				sizes[table] = size
+			}
+		}
+	}
+
+	return sizes, nil
+}
+
+// nameToTable은 (객체 이름, 테이블 이름) 두 컬럼을 반환하는 쿼리를 map으로 읽습니다.
+func nameToTable(ctx context.Context, conn *sql.DB, query string, args ...interface{}) (map[string]string, error) {
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query segment owners: %w", err)
+	}
+	defer rows.Close()
+
+	names := make(map[string]string)
+	for rows.Next() {
+		var name, table string
+		if err := rows.Scan(&name, &table); err != nil {
+			return nil, fmt.Errorf("failed to scan segment owner: %w", err)
+		}
+		names[name] = table
+	}
+
+	return names, rows.Err()
+}
+
+// int64Ptr은 NULL이면 nil, 아니면 값의 포인터를 반환합니다.
+func int64Ptr(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// timePtr은 NULL이면 nil, 아니면 값의 포인터를 반환합니다.
+func timePtr(v sql.NullTime) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Time
+}
@@ -140,7 +140,7 @@ func (a *PostgresAdapter) Connect(ctx context.Context, db *domain.Database) (*sq
 }
 
 // ExecuteQuery는 PostgreSQL에 쿼리를 실행하고 결과를 반환합니다.
-func (a *PostgresAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query string) (*domain.QueryResult, error) {
+func (a *PostgresAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query string, args ...interface{}) (*domain.QueryResult, error) {
 	// ==========================================
 	// 1단계: 실행 시간 측정 시작
 	// ==========================================
@@ -162,13 +162,14 @@ func (a *PostgresAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query
 	// 파라미터:
 	// - ctx: context (타임아웃 설정 등)
 	// - query: SQL 쿼리 문자열
+	// - args: 바인드 변수 값 ($1, $2 ... 자리에 순서대로 들어감)
 	//
 	// 반환값:
 	// - *sql.Rows: 쿼리 결과 (여러 row)
 	// - error: 쿼리 실패 시
 	//
 	// 주의: Rows는 반드시 Close()해야 합니다!
-	rows, err := conn.QueryContext(ctx, query)
+	rows, err := conn.QueryContext(ctx, query, args...)
 	if err != nil {
 		// 쿼리 실패 (문법 에러, 테이블 없음 등)
 		return nil, fmt.Errorf("query execution failed: %w", err)
@@ -195,6 +196,18 @@ func (a *PostgresAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query
 		return nil, fmt.Errorf("failed to get columns: %w", err)
 	}
 
+	// rows.ColumnTypes()는 각 컬럼의 DB 타입 정보를 반환합니다.
+	// DatabaseTypeName()은 "INT4", "NUMERIC", "VARCHAR" 같은 원본 이름
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	typeNames := make([]string, len(columnTypes))
+	for i, ct := range columnTypes {
+		typeNames[i] = ct.DatabaseTypeName()
+	}
+
 	// ==========================================
 	// 4단계: Row 데이터 파싱
 	// ==========================================
@@ -311,115 +324,9 @@ func (a *PostgresAdapter) ExecuteQuery(ctx context.Context, conn *sql.DB, query
 	// domain.QueryResult 생성
 	return &domain.QueryResult{
 		Columns:       columns,             // 컬럼 이름들
+		ColumnTypes:   typeNames,           // 컬럼 타입들
 		Rows:          results,             // 실제 데이터
 		RowsAffected:  int64(len(results)), // SELECT는 row 개수
 		ExecutionTime: executionTime,       // 실행 시간
 	}, nil
 }
-
-// GetTables는 PostgreSQL의 모든 테이블 목록을 조회합니다.
-// PostgreSQL 전용 쿼리를 사용합니다!
-func (a *PostgresAdapter) GetTables(ctx context.Context, conn *sql.DB) ([]string, error) {
-	// ==========================================
-	// PostgreSQL 전용 쿼리! 🔥
-	// ==========================================
-
-	// pg_tables는 PostgreSQL 시스템 카탈로그
-	// schemaname='public'은 public 스키마의 테이블만
-	//
-	// 다른 DB는 쿼리가 다름:
-	// - Oracle: SELECT table_name FROM user_tables
-	// - MySQL: SHOW TABLES
-	// - SQL Server: SELECT name FROM sys.tables
-	query := `
-		SELECT tablename 
-		FROM pg_tables 
-		WHERE schemaname = 'public'
-		ORDER BY tablename
-	`
-
-	// QueryContext로 쿼리 실행
-	rows, err := conn.QueryContext(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query tables: %w", err)
-	}
-	defer rows.Close()
-
-	// 테이블 이름을 담을 슬라이스
-	// []string 타입
-	var tables []string
-
-	// 각 row 순회
-	for rows.Next() {
-		// 테이블 이름을 담을 변수
-		var tableName string
-
-		// Scan으로 값 읽기
-		// 컬럼이 하나뿐이므로 변수 하나만 전달
-		if err := rows.Scan(&tableName); err != nil {
-			return nil, fmt.Errorf("failed to scan table name: %w", err)
-		}
-
-		// 슬라이스에 추가
-		tables = append(tables, tableName)
-	}
-
-	// 에러 체크
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error during iteration: %w", err)
-	}
-
-	return tables, nil
-}
-
-// GetColumns는 특정 테이블의 컬럼 목록을 조회합니다.
-func (a *PostgresAdapter) GetColumns(ctx context.Context, conn *sql.DB, tableName string) ([]string, error) {
-	// ==========================================
-	// PostgreSQL 전용 쿼리! 🔥
-	// ==========================================
-
-	// information_schema.columns는 표준 SQL 뷰
-	// (대부분의 DB가 지원하지만 세부사항은 다름)
-	//
-	// $1은 파라미터 placeholder
-	// → SQL Injection 방지!
-	// → tableName이 직접 문자열로 들어가지 않음
-	query := `
-		SELECT column_name 
-		FROM information_schema.columns 
-		WHERE table_schema = 'public' 
-		  AND table_name = $1
-		ORDER BY ordinal_position
-	`
-
-	// QueryContext의 세 번째 파라미터부터는 쿼리 파라미터
-	// $1 = tableName
-	// $2가 있다면 네 번째 파라미터, ...
-	//
-	// 이렇게 하면:
-	// - SQL Injection 안전
-	// - 자동으로 escape 처리
-	rows, err := conn.QueryContext(ctx, query, tableName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query columns: %w", err)
-	}
-	defer rows.Close()
-
-	var columns []string
-
-	for rows.Next() {
-		var columnName string
-
-		if err := rows.Scan(&columnName); err != nil {
-			return nil, fmt.Errorf("failed to scan column name: %w", err)
-		}
-
-		columns = append(columns, columnName)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error during iteration: %w", err)
-	}
-
-	return columns, nil
-}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// GetCatalog는 USAGE 권한이 있는 모든 스키마의 테이블/컬럼 이름, 타입, 주석을 한 번에 조회합니다.
+//
+// 테이블마다 GetColumns를 부르면 테이블 수만큼 왕복하므로,
+// 메타데이터 인덱스(검색, 자동완성)용으로 쿼리 하나로 전부 읽습니다.
+// 결과는 (스키마, 테이블, 컬럼 순서)로 정렬되어 있어서 한 번 훑으며 묶을 수 있습니다.
+func (a *PostgresAdapter) GetCatalog(ctx context.Context, conn *sql.DB) (*domain.DatabaseCatalog, error) {
+	catalog := &domain.DatabaseCatalog{}
+
+	// search_path의 첫 번째 (존재하는) 스키마
+	var current sql.NullString
+	if err := conn.QueryRowContext(ctx, "SELECT current_schema()").Scan(&current); err != nil {
+		return nil, fmt.Errorf("failed to get current schema: %w", err)
+	}
+	catalog.CurrentSchema = current.String
+
+	query := `
+		SELECT
+			n.nspname,
+			c.relname,
+			c.relkind,
+			obj_description(c.oid, 'pg_class'),
+			a.attname,
+			format_type(a.atttypid, a.atttypmod),
+			col_description(c.oid, a.attnum)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_attribute a ON a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped
+		WHERE c.relkind IN ('r', 'p', 'v', 'm', 'f')
+		  AND NOT c.relispartition
+		  AND n.nspname NOT IN ('pg_catalog', 'information_schema')
+		  AND n.nspname NOT LIKE 'pg\_toast%'
+		  AND n.nspname NOT LIKE 'pg\_temp\_%'
+		  AND has_schema_privilege(n.oid, 'USAGE')
+		ORDER BY n.nspname, c.relname, a.attnum
+	`
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query catalog: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			schema, table, relkind      string
+			tableComment, columnComment sql.NullString
+			column                      domain.CatalogColumn
+		)
+
+		if err := rows.Scan(&schema, &table, &relkind, &tableComment,
+			&column.Name, &column.DataType, &columnComment); err != nil {
+			return nil, fmt.Errorf("failed to scan catalog: %w", err)
+		}
+		column.Comment = columnComment.String
+
+		tables := catalog.Tables
+		if n := len(tables); n == 0 || tables[n-1].Schema != schema || tables[n-1].Name != table {
+			catalog.Tables = append(catalog.Tables, domain.CatalogTable{
+				Schema:  schema,
+				Name:    table,
+				Type:    relkindTypes[relkind],
+				Comment: tableComment.String,
+			})
+		}
+		last := &catalog.Tables[len(catalog.Tables)-1]
+		last.Columns = append(last.Columns, column)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return catalog, nil
+}
@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"space/internal/domain"
+)
+
+// GetDDL은 객체를 다시 만드는 DDL을 조회합니다.
+//
+// PostgreSQL에는 Oracle의 DBMS_METADATA 같은 "테이블 DDL 함수"가 없어서
+// (pg_dump만 할 수 있음) 테이블은 카탈로그 정보로 직접 재구성합니다.
+// 뷰/인덱스/함수/트리거는 pg_get_*def 함수가 CREATE 문을 돌려줍니다.
+func (a *PostgresAdapter) GetDDL(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (string, error) {
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(NULLIF($1, ''), current_schema())", schema).Scan(&schema); err != nil {
+		return "", fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	switch objectType {
+	case domain.ObjectTable:
+		return a.tableDDL(ctx, conn, schema, name)
+	case domain.ObjectIndex:
+		return a.indexDDL(ctx, conn, schema, name)
+	case domain.ObjectView, domain.ObjectMaterializedView:
+		return a.viewDDL(ctx, conn, schema, objectType, name)
+	}
+
+	// 함수, 프로시저, 트리거, 시퀀스는 GetObject의 정의가 이미 CREATE 문입니다.
+	object, err := a.GetObject(ctx, conn, schema, objectType, name)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(object.Definition, "; \n") + ";\n", nil
+}
+
+// tableDDL은 컬럼, 제약조건, 인덱스, 주석, 소유자 정보로 CREATE TABLE 문을 만듭니다.
+func (a *PostgresAdapter) tableDDL(ctx context.Context, conn *sql.DB, schema string, name string) (string, error) {
+	columns, err := a.GetColumns(ctx, conn, schema, name)
+	if err != nil {
+		return "", err
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("%w: table %s", domain.ErrObjectNotFound, name)
+	}
+
+	metadata, err := a.GetTableMetadata(ctx, conn, schema, name)
+	if err != nil {
+		return "", err
+	}
+
+	ddl, _ := domain.GenerateTableDDL(domain.TableDefinition{
+		Source:   domain.DialectPostgres,
+		Metadata: metadata,
+		Columns:  columns,
+	}, domain.DialectPostgres)
+
+	return ddl, nil
+}
+
+// indexDDL은 pg_get_indexdef로 CREATE INDEX 문을 조회합니다.
+func (a *PostgresAdapter) indexDDL(ctx context.Context, conn *sql.DB, schema string, name string) (string, error) {
+	var ddl string
+
+	err := conn.QueryRowContext(ctx, `
+		SELECT pg_get_indexdef(c.oid)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('i', 'I')
+	`, schema, name).Scan(&ddl)
+	if err == sql.ErrNoRows {
+		return "", fmt.Errorf("%w: index %s", domain.ErrObjectNotFound, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to query index definition: %w", err)
+	}
+
+	return ddl + ";\n", nil
+}
+
+// viewDDL은 뷰 정의(pg_get_viewdef)에 CREATE 문, 주석, 소유자를 붙입니다.
+func (a *PostgresAdapter) viewDDL(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (string, error) {
+	object, err := a.GetObject(ctx, conn, schema, objectType, name)
+	if err != nil {
+		return "", err
+	}
+
+	var owner string
+	var comment sql.NullString
+	if err := conn.QueryRowContext(ctx, `
+		SELECT pg_get_userbyid(c.relowner), obj_description(c.oid, 'pg_class')
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2
+	`, schema, object.Name).Scan(&owner, &comment); err != nil {
+		return "", fmt.Errorf("failed to query view owner: %w", err)
+	}
+
+	qualified := pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(object.Name)
+	body := strings.TrimRight(object.Definition, "; \n")
+
+	var b strings.Builder
+	if objectType == domain.ObjectMaterializedView {
+		fmt.Fprintf(&b, "CREATE MATERIALIZED VIEW %s AS\n%s\nWITH DATA;\n", qualified, body)
+	} else {
+		fmt.Fprintf(&b, "CREATE OR REPLACE VIEW %s AS\n%s;\n", qualified, body)
+	}
+
+	keyword := "VIEW"
+	if objectType == domain.ObjectMaterializedView {
+		keyword = "MATERIALIZED VIEW"
+	}
+	if comment.Valid {
+		fmt.Fprintf(&b, "\nCOMMENT ON %s %s IS %s;\n", keyword, qualified, domain.QuoteLiteral(comment.String))
+	}
+	fmt.Fprintf(&b, "\nALTER %s %s OWNER TO %s;\n", keyword, qualified, pq.QuoteIdentifier(owner))
+
+	return b.String(), nil
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// Explain은 EXPLAIN (FORMAT JSON [, ANALYZE])로 실행 계획을 조회합니다.
+//
+// ANALYZE는 쿼리를 실제로 실행하므로 (INSERT/UPDATE/DELETE 포함!)
+// 항상 트랜잭션 안에서 실행하고 마지막에 롤백합니다.
+// Oracle 어댑터와 같이 SELECT와 DML만 ANALYZE 할 수 있습니다.
+func (a *PostgresAdapter) Explain(ctx context.Context, conn *sql.DB, query string, analyze bool) (*domain.QueryPlan, error) {
+	options := "FORMAT JSON"
+	if analyze {
+		if kind := domain.ClassifyStatement(query); !kind.IsExplainable() {
+			return nil, fmt.Errorf("%w: analyze is only allowed for SELECT and DML (got %s)", domain.ErrExplainNotSupported, kind)
+		}
+		options += ", ANALYZE"
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Commit은 절대 하지 않습니다. ANALYZE의 부작용을 되돌리기 위해!
+	defer tx.Rollback()
+
+	// EXPLAIN은 파라미터 바인딩을 지원하지 않으므로 쿼리를 그대로 붙입니다.
+	// 쿼리 실행 권한은 ExecuteQuery와 동일합니다.
+	var raw string
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN (%s) %s", options, query)).Scan(&raw); err != nil {
+		return nil, fmt.Errorf("explain failed: %w", err)
+	}
+
+	// EXPLAIN JSON은 문장 하나당 원소 하나인 배열입니다.
+	var plans []struct {
+		Plan          map[string]interface{} `json:"Plan"`
+		PlanningTime  *float64               `json:"Planning Time"`
+		ExecutionTime *float64               `json:"Execution Time"`
+	}
+	if err := json.Unmarshal([]byte(raw), &plans); err != nil {
+		return nil, fmt.Errorf("failed to parse explain output: %w", err)
+	}
+	if len(plans) == 0 {
+		return nil, fmt.Errorf("%w: empty explain output", domain.ErrExplainNotSupported)
+	}
+
+	return &domain.QueryPlan{
+		Root:            convertPlanNode(plans[0].Plan),
+		Analyzed:        analyze,
+		PlanningTimeMs:  plans[0].PlanningTime,
+		ExecutionTimeMs: plans[0].ExecutionTime,
+		Raw:             raw,
+	}, nil
+}
+
+// planKeys는 PlanNode의 공통 필드로 옮기는 Postgres 계획 키입니다.
+// 나머지 키는 Details에 그대로 담습니다.
+var planKeys = map[string]bool{
+	"Node Type":         true,
+	"Relation Name":     true,
+	"Index Name":        true,
+	"Total Cost":        true,
+	"Plan Rows":         true,
+	"Actual Rows":       true,
+	"Actual Total Time": true,
+	"Plans":             true,
+}
+
+// convertPlanNode는 Postgres JSON 계획 노드를 domain.PlanNode로 변환합니다.
+func convertPlanNode(raw map[string]interface{}) *domain.PlanNode {
+	node := &domain.PlanNode{
+		Operation: stringValue(raw["Node Type"]),
+		Cost:      floatValue(raw["Total Cost"]),
+		Rows:      floatValue(raw["Plan Rows"]),
+		Details:   make(map[string]interface{}),
+	}
+
+	// Join Type, Filter 같은 나머지 키는 아래에서 Details로 들어갑니다.
+	if name := stringValue(raw["Relation Name"]); name != "" {
+		node.Object = name
+		if schema := stringValue(raw["Schema"]); schema != "" {
+			node.Object = schema + "." + name
+		}
+	}
+	if index := stringValue(raw["Index Name"]); index != "" {
+		// 인덱스 스캔은 인덱스 이름을 대상 객체로 봅니다.
+		// 테이블 이름은 Details에 남깁니다.
+		if node.Object != "" {
+			node.Details["Relation Name"] = node.Object
+		}
+		node.Object = index
+	}
+
+	if v, ok := raw["Actual Rows"].(float64); ok {
+		// Actual Rows는 루프 1회당 값이므로 Loops를 곱해야 전체 row 수가 됩니다.
+		if loops, ok := raw["Actual Loops"].(float64); ok && loops > 1 {
+			v *= loops
+		}
+		node.ActualRows = &v
+	}
+	if v, ok := raw["Actual Total Time"].(float64); ok {
+		node.ActualTimeMs = &v
+	}
+
+	for key, value := range raw {
+		if !planKeys[key] {
+			node.Details[key] = value
+		}
+	}
+
+	if children, ok := raw["Plans"].([]interface{}); ok {
+		for _, child := range children {
+			if childMap, ok := child.(map[string]interface{}); ok {
+				node.Children = append(node.Children, convertPlanNode(childMap))
+			}
+		}
+	}
+
+	return node
+}
+
+// stringValue는 JSON 값을 문자열로 꺼냅니다 (없으면 빈 문자열).
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+// floatValue는 JSON 숫자를 float64로 꺼냅니다 (없으면 0).
+func floatValue(v interface{}) float64 {
+	f, _ := v.(float64)
+	return f
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"space/internal/domain"
+)
+
+// LoadRows는 COPY FROM STDIN으로 row 묶음을 넣습니다.
+//
+// lib/pq는 COPY 문을 Prepare한 뒤 row마다 Exec하면 값을 모아서 보내고,
+// 인자 없는 Exec에서 COPY를 끝냅니다. INSERT를 row마다 보내는 것보다 훨씬 빠릅니다.
+//
+// upsert(plan.Stage)는 COPY에 ON CONFLICT가 없으므로 임시 테이블에 COPY한 뒤
+// plan.Load(INSERT ... SELECT ... ON CONFLICT)로 옮기고 임시 테이블을 비웁니다.
+func (a *PostgresAdapter) LoadRows(ctx context.Context, tx *sql.Tx, plan *domain.ImportPlan, rows [][]interface{}) error {
+	names := make([]string, len(plan.Columns))
+	for i, c := range plan.Columns {
+		names[i] = c.Name
+	}
+
+	// pq.CopyIn이 이름을 따옴표로 감싸므로 카탈로그 이름을 그대로 넘깁니다.
+	copyStatement := pq.CopyInSchema(plan.Schema, plan.Table, names...)
+	if plan.Schema == "" {
+		copyStatement = pq.CopyIn(plan.Table, names...)
+	}
+	if plan.Stage != "" {
+		if _, err := tx.ExecContext(ctx, plan.Stage); err != nil {
+			return fmt.Errorf("failed to create stage table: %w", err)
+		}
+		copyStatement = pq.CopyIn(domain.ImportStageTable, names...)
+	}
+
+	if err := copyRows(ctx, tx, copyStatement, rows); err != nil {
+		return err
+	}
+
+	if plan.Stage != "" {
+		if _, err := tx.ExecContext(ctx, plan.Load); err != nil {
+			return fmt.Errorf("upsert failed: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, "TRUNCATE "+domain.ImportStageTable); err != nil {
+			return fmt.Errorf("failed to empty stage table: %w", err)
+		}
+	}
+	return nil
+}
+
+// copyRows는 COPY 문 하나로 row들을 보냅니다.
+func copyRows(ctx context.Context, tx *sql.Tx, copyStatement string, rows [][]interface{}) error {
+	stmt, err := tx.PrepareContext(ctx, copyStatement)
+	if err != nil {
+		return fmt.Errorf("failed to start copy: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return fmt.Errorf("copy failed: %w", err)
+		}
+	}
+
+	// 인자 없는 Exec가 남은 데이터를 보내고 COPY를 끝냅니다 (제약조건 위반은 여기서 보고됨).
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return fmt.Errorf("copy failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"space/internal/domain"
+)
+
+// fkActions는 pg_constraint.confdeltype/confupdtype 코드를 SQL 키워드로 바꾸는 표입니다.
+var fkActions = map[string]string{
+	"a": "NO ACTION",
+	"r": "RESTRICT",
+	"c": "CASCADE",
+	"n": "SET NULL",
+	"d": "SET DEFAULT",
+}
+
+// constraintTypes는 pg_constraint.contype을 domain.ConstraintType으로 바꾸는 표입니다.
+var constraintTypes = map[string]domain.ConstraintType{
+	"p": domain.ConstraintPrimaryKey,
+	"u": domain.ConstraintUnique,
+	"c": domain.ConstraintCheck,
+}
+
+// GetTableMetadata는 테이블의 인덱스, 제약조건, 외래 키를 조회합니다.
+// 스키마를 먼저 확정한 뒤 (빈 문자열이면 current_schema()) 세 가지를 차례로 조회합니다.
+func (a *PostgresAdapter) GetTableMetadata(ctx context.Context, conn *sql.DB, schema string, tableName string) (*domain.TableMetadata, error) {
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(NULLIF($1, ''), current_schema())", schema).Scan(&schema); err != nil {
+		return nil, fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	metadata := &domain.TableMetadata{
+		Schema: schema,
+		Table:  tableName,
+	}
+
+	// 소유자와 테이블 주석 (테이블이 없으면 빈 값으로 둡니다)
+	var comment sql.NullString
+	err := conn.QueryRowContext(ctx, `
+		SELECT pg_get_userbyid(c.relowner), obj_description(c.oid, 'pg_class')
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2
+	`, schema, tableName).Scan(&metadata.Owner, &comment)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query table owner: %w", err)
+	}
+	metadata.Comment = comment.String
+
+	if metadata.Indexes, err = a.getIndexes(ctx, conn, schema, tableName); err != nil {
+		return nil, err
+	}
+	if metadata.Constraints, err = a.getConstraints(ctx, conn, schema, tableName); err != nil {
+		return nil, err
+	}
+	if metadata.ForeignKeys, metadata.ReferencedBy, err = a.getForeignKeys(ctx, conn, schema, tableName); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// getIndexes는 테이블의 인덱스 목록을 조회합니다.
+//
+// pg_get_indexdef(인덱스, 번호, true)는 키 하나를 컬럼 이름 또는 식으로 돌려줍니다.
+// indnkeyatts는 INCLUDE 컬럼을 뺀 키 개수입니다.
+// pg_get_expr(indpred)는 부분 인덱스의 WHERE 조건입니다.
+// pg_get_indexdef(인덱스)는 CREATE INDEX 문 전체입니다 (DDL 생성용).
+func (a *PostgresAdapter) getIndexes(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.IndexInfo, error) {
+	query := `
+		SELECT
+			i.relname,
+			ARRAY(
+				SELECT pg_get_indexdef(ix.indexrelid, k, true)
+				FROM generate_series(1, ix.indnkeyatts) AS k
+				ORDER BY k
+			),
+			ix.indisunique,
+			ix.indisprimary,
+			am.amname,
+			pg_get_expr(ix.indpred, ix.indrelid),
+			pg_get_indexdef(ix.indexrelid)
+		FROM pg_index ix
+		JOIN pg_class t ON t.oid = ix.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		JOIN pg_class i ON i.oid = ix.indexrelid
+		JOIN pg_am am ON am.oid = i.relam
+		WHERE n.nspname = $1
+		  AND t.relname = $2
+		ORDER BY ix.indisprimary DESC, i.relname
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexes: %w", err)
+	}
+	defer rows.Close()
+
+	var indexes []domain.IndexInfo
+
+	for rows.Next() {
+		var index domain.IndexInfo
+		var predicate sql.NullString
+
+		if err := rows.Scan(&index.Name, pq.Array(&index.Columns), &index.Unique, &index.Primary,
+			&index.Type, &predicate, &index.Definition); err != nil {
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+
+		index.Predicate = predicate.String
+		indexes = append(indexes, index)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return indexes, nil
+}
+
+// getConstraints는 기본 키, 유니크, 체크 제약조건을 조회합니다.
+// conkey(컬럼 번호 배열)를 WITH ORDINALITY로 풀어서 순서대로 컬럼 이름을 만듭니다.
+func (a *PostgresAdapter) getConstraints(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.ConstraintInfo, error) {
+	query := `
+		SELECT
+			con.conname,
+			con.contype,
+			ARRAY(
+				SELECT att.attname
+				FROM unnest(con.conkey) WITH ORDINALITY AS k(attnum, ord)
+				JOIN pg_attribute att ON att.attrelid = con.conrelid AND att.attnum = k.attnum
+				ORDER BY k.ord
+			),
+			CASE WHEN con.contype = 'c' THEN pg_get_constraintdef(con.oid, true) END,
+			con.condeferrable
+		FROM pg_constraint con
+		JOIN pg_class t ON t.oid = con.conrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE n.nspname = $1
+		  AND t.relname = $2
+		  AND con.contype IN ('p', 'u', 'c')
+		ORDER BY con.contype, con.conname
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query constraints: %w", err)
+	}
+	defer rows.Close()
+
+	var constraints []domain.ConstraintInfo
+
+	for rows.Next() {
+		var constraint domain.ConstraintInfo
+		var contype string
+		var expression sql.NullString
+
+		if err := rows.Scan(&constraint.Name, &contype, pq.Array(&constraint.Columns),
+			&expression, &constraint.Deferrable); err != nil {
+			return nil, fmt.Errorf("failed to scan constraint: %w", err)
+		}
+
+		constraint.Type = constraintTypes[contype]
+		constraint.Expression = expression.String
+		constraints = append(constraints, constraint)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return constraints, nil
+}
+
+// getForeignKeys는 테이블에서 나가는 외래 키와 들어오는 외래 키를 한 번에 조회합니다.
+// 자기 자신을 참조하는 외래 키는 양쪽 목록에 모두 들어갑니다.
+func (a *PostgresAdapter) getForeignKeys(ctx context.Context, conn *sql.DB, schema string, tableName string) (outgoing, incoming []domain.ForeignKeyInfo, err error) {
+	query := `
+		SELECT
+			con.conname,
+			sn.nspname, st.relname,
+			ARRAY(
+				SELECT att.attname
+				FROM unnest(con.conkey) WITH ORDINALITY AS k(attnum, ord)
+				JOIN pg_attribute att ON att.attrelid = con.conrelid AND att.attnum = k.attnum
+				ORDER BY k.ord
+			),
+			tn.nspname, tt.relname,
+			ARRAY(
+				SELECT att.attname
+				FROM unnest(con.confkey) WITH ORDINALITY AS k(attnum, ord)
+				JOIN pg_attribute att ON att.attrelid = con.confrelid AND att.attnum = k.attnum
+				ORDER BY k.ord
+			),
+			con.confdeltype,
+			con.confupdtype,
+			(sn.nspname = $1 AND st.relname = $2),
+			(tn.nspname = $1 AND tt.relname = $2)
+		FROM pg_constraint con
+		JOIN pg_class st ON st.oid = con.conrelid
+		JOIN pg_namespace sn ON sn.oid = st.relnamespace
+		JOIN pg_class tt ON tt.oid = con.confrelid
+		JOIN pg_namespace tn ON tn.oid = tt.relnamespace
+		WHERE con.contype = 'f'
+		  AND ((sn.nspname = $1 AND st.relname = $2) OR (tn.nspname = $1 AND tt.relname = $2))
+		ORDER BY con.conname
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fk domain.ForeignKeyInfo
+		var onDelete, onUpdate string
+		var isOutgoing, isIncoming bool
+
+		if err := rows.Scan(&fk.Name,
+			&fk.Schema, &fk.Table, pq.Array(&fk.Columns),
+			&fk.RefSchema, &fk.RefTable, pq.Array(&fk.RefColumns),
+			&onDelete, &onUpdate, &isOutgoing, &isIncoming); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan foreign key: %w", err)
+		}
+
+		fk.OnDelete = fkActions[onDelete]
+		fk.OnUpdate = fkActions[onUpdate]
+
+		if isOutgoing {
+			outgoing = append(outgoing, fk)
+		}
+		if isIncoming {
+			incoming = append(incoming, fk)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return outgoing, incoming, nil
+}
@@ -0,0 +1,316 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"space/internal/domain"
+)
+
+// ListObjects는 스키마의 뷰, 함수, 프로시저, 트리거, 시퀀스 목록을 조회합니다.
+// PostgreSQL에는 패키지가 없으므로 package 종류는 항상 비어 있습니다.
+//
+// 확장(extension)이 만든 함수는 pg_depend (deptype = 'e')로 걸러냅니다.
+// (pgcrypto 같은 확장을 설치하면 함수가 수십 개씩 생기므로!)
+func (a *PostgresAdapter) ListObjects(ctx context.Context, conn *sql.DB, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error) {
+	query := `
+		WITH target AS (
+			SELECT oid, nspname FROM pg_namespace
+			WHERE nspname = COALESCE(NULLIF($1, ''), current_schema())
+		)
+		SELECT schema_name, object_type, name, table_name, arguments FROM (
+			SELECT t.nspname AS schema_name,
+			       CASE c.relkind WHEN 'v' THEN 'view'
+			                      WHEN 'm' THEN 'materialized_view'
+			                      ELSE 'sequence' END AS object_type,
+			       c.relname AS name, NULL::text AS table_name, NULL::text AS arguments
+			FROM pg_class c
+			JOIN target t ON c.relnamespace = t.oid
+			WHERE c.relkind IN ('v', 'm', 'S')
+			UNION ALL
+			SELECT t.nspname,
+			       CASE p.prokind WHEN 'p' THEN 'procedure' ELSE 'function' END,
+			       p.proname, NULL, pg_get_function_identity_arguments(p.oid)
+			FROM pg_proc p
+			JOIN target t ON p.pronamespace = t.oid
+			WHERE p.prokind IN ('f', 'p')
+			  AND NOT EXISTS (
+				SELECT 1 FROM pg_depend d
+				WHERE d.classid = 'pg_proc'::regclass AND d.objid = p.oid AND d.deptype = 'e'
+			  )
+			UNION ALL
+			SELECT t.nspname, 'trigger', tg.tgname, tc.relname, NULL
+			FROM pg_trigger tg
+			JOIN pg_class tc ON tc.oid = tg.tgrelid
+			JOIN target t ON tc.relnamespace = t.oid
+			WHERE NOT tg.tgisinternal
+		) o
+		WHERE cardinality($2::text[]) = 0 OR o.object_type = ANY($2::text[])
+		ORDER BY o.object_type, o.name, o.arguments
+	`
+
+	typeNames := make([]string, 0, len(types))
+	for _, t := range types {
+		typeNames = append(typeNames, string(t))
+	}
+
+	rows, err := conn.QueryContext(ctx, query, schema, pq.Array(typeNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query objects: %w", err)
+	}
+	defer rows.Close()
+
+	var objects []domain.DatabaseObject
+
+	for rows.Next() {
+		var object domain.DatabaseObject
+		var objectType string
+		var table, arguments sql.NullString
+
+		if err := rows.Scan(&object.Schema, &objectType, &object.Name, &table, &arguments); err != nil {
+			return nil, fmt.Errorf("failed to scan object: %w", err)
+		}
+
+		object.Type = domain.ObjectType(objectType)
+		object.Table = table.String
+		object.Arguments = arguments.String
+		objects = append(objects, object)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return objects, nil
+}
+
+// GetObject는 객체 하나의 정의(소스)를 조회합니다.
+//
+// 함수/프로시저는 오버로딩이 있을 수 있으므로 이름을 "add_user(name text)"처럼 쓰면
+// 인자까지 맞는 것을 고르고, 이름만 쓰면 첫 번째 것을 반환합니다.
+func (a *PostgresAdapter) GetObject(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	if err := conn.QueryRowContext(ctx, "SELECT COALESCE(NULLIF($1, ''), current_schema())", schema).Scan(&schema); err != nil {
+		return nil, fmt.Errorf("failed to resolve schema: %w", err)
+	}
+
+	var object *domain.DatabaseObject
+	var err error
+
+	switch objectType {
+	case domain.ObjectView, domain.ObjectMaterializedView:
+		object, err = a.getViewObject(ctx, conn, schema, objectType, name)
+	case domain.ObjectFunction, domain.ObjectProcedure:
+		object, err = a.getRoutineObject(ctx, conn, schema, objectType, name)
+	case domain.ObjectTrigger:
+		object, err = a.getTriggerObject(ctx, conn, schema, name)
+	case domain.ObjectSequence:
+		object, err = a.getSequenceObject(ctx, conn, schema, name)
+	default:
+		return nil, fmt.Errorf("%w: PostgreSQL has no %s objects", domain.ErrObjectNotFound, objectType)
+	}
+
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: %s %s", domain.ErrObjectNotFound, objectType, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	object.Schema = schema
+	object.Type = objectType
+	return object, nil
+}
+
+// getViewObject는 뷰/머티리얼라이즈드 뷰의 SELECT 문을 조회합니다.
+func (a *PostgresAdapter) getViewObject(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	relkind := "v"
+	if objectType == domain.ObjectMaterializedView {
+		relkind = "m"
+	}
+
+	query := `
+		SELECT c.relname, pg_get_viewdef(c.oid, true), c.relispopulated
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind = $3
+	`
+
+	object := &domain.DatabaseObject{Details: make(map[string]interface{})}
+	var populated bool
+
+	if err := conn.QueryRowContext(ctx, query, schema, name, relkind).Scan(&object.Name, &object.Definition, &populated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to query view definition: %w", err)
+	}
+
+	if objectType == domain.ObjectMaterializedView {
+		object.Details["populated"] = populated
+	}
+
+	return object, nil
+}
+
+// getRoutineObject는 함수/프로시저의 CREATE 문 전체를 조회합니다.
+func (a *PostgresAdapter) getRoutineObject(ctx context.Context, conn *sql.DB, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	// "add_user(name text)" → 이름 "add_user", 인자 "name text"
+	arguments, hasArguments := "", false
+	if i := strings.Index(name, "("); i > 0 && strings.HasSuffix(name, ")") {
+		arguments, hasArguments = name[i+1:len(name)-1], true
+		name = strings.TrimSpace(name[:i])
+	}
+
+	prokind := "f"
+	if objectType == domain.ObjectProcedure {
+		prokind = "p"
+	}
+
+	query := `
+		SELECT p.proname, pg_get_function_identity_arguments(p.oid),
+		       pg_get_functiondef(p.oid), pg_get_function_result(p.oid), l.lanname
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		JOIN pg_language l ON l.oid = p.prolang
+		WHERE n.nspname = $1 AND p.proname = $2 AND p.prokind = $3
+		ORDER BY p.oid
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, name, prokind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query routine definition: %w", err)
+	}
+	defer rows.Close()
+
+	var found *domain.DatabaseObject
+	overloads := 0
+
+	for rows.Next() {
+		object := &domain.DatabaseObject{Details: make(map[string]interface{})}
+		var result sql.NullString
+		var language string
+
+		if err := rows.Scan(&object.Name, &object.Arguments, &object.Definition, &result, &language); err != nil {
+			return nil, fmt.Errorf("failed to scan routine: %w", err)
+		}
+		overloads++
+
+		if found != nil || hasArguments && !sameArguments(object.Arguments, arguments) {
+			continue
+		}
+
+		object.Details["language"] = language
+		if result.Valid {
+			object.Details["returns"] = result.String
+		}
+		found = object
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	if found == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	found.Details["overloads"] = overloads
+	return found, nil
+}
+
+// sameArguments는 공백 차이를 무시하고 인자 목록을 비교합니다.
+func sameArguments(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Join(strings.Fields(strings.ReplaceAll(s, ",", " , ")), " "))
+	}
+	return normalize(a) == normalize(b)
+}
+
+// getTriggerObject는 트리거의 CREATE TRIGGER 문을 조회합니다.
+// 트리거 이름은 테이블 안에서만 유일하므로, 같은 이름이 여러 개면 테이블 이름순 첫 번째입니다.
+func (a *PostgresAdapter) getTriggerObject(ctx context.Context, conn *sql.DB, schema string, name string) (*domain.DatabaseObject, error) {
+	query := `
+		SELECT tg.tgname, tc.relname, pg_get_triggerdef(tg.oid, true),
+		       fn.proname, tg.tgenabled <> 'D'
+		FROM pg_trigger tg
+		JOIN pg_class tc ON tc.oid = tg.tgrelid
+		JOIN pg_namespace n ON n.oid = tc.relnamespace
+		JOIN pg_proc fn ON fn.oid = tg.tgfoid
+		WHERE n.nspname = $1 AND tg.tgname = $2 AND NOT tg.tgisinternal
+		ORDER BY tc.relname
+		LIMIT 1
+	`
+
+	object := &domain.DatabaseObject{Details: make(map[string]interface{})}
+	var function string
+	var enabled bool
+
+	if err := conn.QueryRowContext(ctx, query, schema, name).Scan(&object.Name, &object.Table,
+		&object.Definition, &function, &enabled); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to query trigger definition: %w", err)
+	}
+
+	object.Details["function"] = function
+	object.Details["enabled"] = enabled
+	return object, nil
+}
+
+// getSequenceObject는 시퀀스 설정을 조회하고 CREATE SEQUENCE 문을 만듭니다.
+// last_value는 한 번도 nextval()을 부르지 않았으면 NULL입니다.
+func (a *PostgresAdapter) getSequenceObject(ctx context.Context, conn *sql.DB, schema string, name string) (*domain.DatabaseObject, error) {
+	query := `
+		SELECT sequencename, data_type::text, start_value, min_value, max_value,
+		       increment_by, cycle, cache_size, last_value
+		FROM pg_sequences
+		WHERE schemaname = $1 AND sequencename = $2
+	`
+
+	var (
+		seqName, dataType               string
+		start, minValue, maxValue, step int64
+		cycle                           bool
+		cacheSize                       int64
+		lastValue                       sql.NullInt64
+	)
+
+	if err := conn.QueryRowContext(ctx, query, schema, name).Scan(&seqName, &dataType, &start,
+		&minValue, &maxValue, &step, &cycle, &cacheSize, &lastValue); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to query sequence: %w", err)
+	}
+
+	cycleClause := "NO CYCLE"
+	if cycle {
+		cycleClause = "CYCLE"
+	}
+
+	object := &domain.DatabaseObject{
+		Name: seqName,
+		Definition: fmt.Sprintf("CREATE SEQUENCE %s.%s AS %s START WITH %d INCREMENT BY %d MINVALUE %d MAXVALUE %d CACHE %d %s;",
+			pq.QuoteIdentifier(schema), pq.QuoteIdentifier(seqName), dataType,
+			start, step, minValue, maxValue, cacheSize, cycleClause),
+		Details: map[string]interface{}{
+			"data_type":    dataType,
+			"start_value":  start,
+			"min_value":    minValue,
+			"max_value":    maxValue,
+			"increment_by": step,
+			"cycle":        cycle,
+			"cache_size":   cacheSize,
+		},
+	}
+	if lastValue.Valid {
+		object.Details["last_value"] = lastValue.Int64
+	}
+
+	return object, nil
+}
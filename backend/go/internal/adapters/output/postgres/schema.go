@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// relkindTypes는 pg_class.relkind를 domain.TableType으로 바꾸는 표입니다.
+//   - r: 일반 테이블, p: 파티션 테이블 (부모)
+//   - v: 뷰, m: 머티리얼라이즈드 뷰, f: 외부 테이블
+var relkindTypes = map[string]domain.TableType{
+	"r": domain.TableTypeTable,
+	"p": domain.TableTypeTable,
+	"v": domain.TableTypeView,
+	"m": domain.TableTypeMaterializedView,
+	"f": domain.TableTypeForeignTable,
+}
+
+// GetSchemas는 USAGE 권한이 있는 스키마 목록을 조회합니다.
+// pg_catalog, information_schema, pg_toast, 임시 스키마는 제외합니다.
+func (a *PostgresAdapter) GetSchemas(ctx context.Context, conn *sql.DB) ([]string, error) {
+	query := `
+		SELECT nspname
+		FROM pg_namespace
+		WHERE nspname NOT IN ('pg_catalog', 'information_schema')
+		  AND nspname NOT LIKE 'pg\_toast%'
+		  AND nspname NOT LIKE 'pg\_temp\_%'
+		  AND has_schema_privilege(oid, 'USAGE')
+		ORDER BY nspname
+	`
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schemas: %w", err)
+	}
+	defer rows.Close()
+
+	var schemas []string
+
+	for rows.Next() {
+		var name string
+
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan schema name: %w", err)
+		}
+
+		schemas = append(schemas, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return schemas, nil
+}
+
+// GetTables는 PostgreSQL의 테이블과 뷰 목록을 조회합니다.
+//
+// pg_tables는 일반 테이블만 보여주므로 pg_class를 직접 조회합니다.
+// (information_schema.tables는 머티리얼라이즈드 뷰가 빠짐!)
+// schema가 비어 있으면 current_schema() (search_path의 첫 스키마)를 사용합니다.
+func (a *PostgresAdapter) GetTables(ctx context.Context, conn *sql.DB, schema string) ([]domain.TableInfo, error) {
+	query := `
+		SELECT n.nspname, c.relname, c.relkind
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema())
+		  AND c.relkind IN ('r', 'p', 'v', 'm', 'f')
+		  AND NOT c.relispartition
+		ORDER BY c.relname
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tables: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []domain.TableInfo
+
+	for rows.Next() {
+		var owner, name, relkind string
+
+		if err := rows.Scan(&owner, &name, &relkind); err != nil {
+			return nil, fmt.Errorf("failed to scan table: %w", err)
+		}
+
+		tables = append(tables, domain.TableInfo{
+			Schema: owner,
+			Name:   name,
+			Type:   relkindTypes[relkind],
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return tables, nil
+}
+
+// GetColumns는 특정 테이블(또는 뷰)의 컬럼 목록을 조회합니다.
+//
+// information_schema.columns는 머티리얼라이즈드 뷰가 빠지므로 pg_catalog를 직접 조회합니다.
+//   - format_type(): "character varying(100)"처럼 길이까지 포함한 타입 이름
+//   - atttypmod: 문자 길이, numeric 정밀도/소수 자릿수가 인코딩된 값
+//     (varchar/char: typmod - 4, numeric: ((typmod - 4) >> 16) / ((typmod - 4) & 65535))
+//   - pg_attrdef: 기본값 식, col_description(): 컬럼 주석
+//   - pg_constraint (contype = 'p'): 기본 키 순서
+//   - attidentity: identity 컬럼 ('a' = ALWAYS, 'd' = BY DEFAULT)
+//   - pg_get_serial_sequence(): serial/identity 컬럼이 쓰는 시퀀스
+func (a *PostgresAdapter) GetColumns(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.ColumnInfo, error) {
+	query := `
+		SELECT
+			a.attname,
+			a.attnum,
+			format_type(a.atttypid, a.atttypmod),
+			format_type(a.atttypid, NULL),
+			CASE WHEN a.atttypid IN (1042, 1043) AND a.atttypmod > 0
+			     THEN a.atttypmod - 4 END,
+			CASE WHEN a.atttypid = 1700 AND a.atttypmod > 0
+			     THEN ((a.atttypmod - 4) >> 16) & 65535 END,
+			CASE WHEN a.atttypid = 1700 AND a.atttypmod > 0
+			     THEN (a.atttypmod - 4) & 65535 END,
+			NOT a.attnotnull,
+			pg_get_expr(d.adbin, d.adrelid),
+			col_description(c.oid, a.attnum),
+			array_position(pk.conkey, a.attnum),
+			a.attidentity,
+			pg_get_serial_sequence(format('%I.%I', n.nspname, c.relname), a.attname)
+		FROM pg_attribute a
+		JOIN pg_class c ON c.oid = a.attrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+		LEFT JOIN pg_constraint pk ON pk.conrelid = c.oid AND pk.contype = 'p'
+		WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema())
+		  AND c.relname = $2
+		  AND a.attnum > 0
+		  AND NOT a.attisdropped
+		ORDER BY a.attnum
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query columns: %w", err)
+	}
+	defer rows.Close()
+
+	var columns []domain.ColumnInfo
+
+	for rows.Next() {
+		var (
+			column                   domain.ColumnInfo
+			length, precision, scale sql.NullInt64
+			defaultExpr, comment     sql.NullString
+			pkPosition               sql.NullInt64
+			identity, sequence       sql.NullString
+		)
+
+		if err := rows.Scan(&column.Name, &column.Position, &column.DataType, &column.BaseType,
+			&length, &precision, &scale, &column.Nullable, &defaultExpr, &comment,
+			&pkPosition, &identity, &sequence); err != nil {
+			return nil, fmt.Errorf("failed to scan column: %w", err)
+		}
+
+		if length.Valid {
+			column.Length = &length.Int64
+		}
+		if precision.Valid {
+			p := int(precision.Int64)
+			column.Precision = &p
+		}
+		if scale.Valid {
+			s := int(scale.Int64)
+			column.Scale = &s
+		}
+		if defaultExpr.Valid {
+			column.Default = &defaultExpr.String
+		}
+		column.Comment = comment.String
+
+		if pkPosition.Valid {
+			column.PrimaryKey = true
+			column.PrimaryKeyPosition = int(pkPosition.Int64)
+		}
+
+		switch {
+		case identity.String == "a":
+			column.Identity = &domain.ColumnIdentity{Generation: domain.IdentityAlways, Sequence: sequence.String}
+		case identity.String == "d":
+			column.Identity = &domain.ColumnIdentity{Generation: domain.IdentityByDefault, Sequence: sequence.String}
+		case sequence.Valid:
+			// serial: 기본값이 nextval('시퀀스')인 컬럼
+			column.Identity = &domain.ColumnIdentity{Generation: domain.IdentitySequence, Sequence: sequence.String}
+		}
+
+		columns = append(columns, column)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return columns, nil
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"space/internal/domain"
+)
+
+// GetTableStats는 테이블의 크기와 활동 통계를 조회합니다.
+// tableName이 비어 있으면 스키마의 모든 테이블(일반, 파티션, 구체화 뷰)을 조회합니다.
+//
+// 크기 함수:
+//   - pg_relation_size: 테이블 본체 (main fork)
+//   - pg_indexes_size: 모든 인덱스 합계
+//   - TOAST: pg_total_relation_size(reltoastrelid) (TOAST 인덱스 포함)
+//
+// 활동 통계(pg_stat_user_tables)는 서버 시작/통계 초기화 이후의 누적값입니다.
+func (a *PostgresAdapter) GetTableStats(ctx context.Context, conn *sql.DB, schema string, tableName string) ([]domain.TableStats, error) {
+	query := `
+		SELECT
+			n.nspname,
+			c.relname,
+			CASE WHEN c.reltuples >= 0 THEN c.reltuples::bigint ELSE s.n_live_tup END,
+			pg_relation_size(c.oid),
+			pg_indexes_size(c.oid),
+			CASE WHEN c.reltoastrelid <> 0 THEN pg_total_relation_size(c.reltoastrelid) ELSE 0 END,
+			GREATEST(s.last_vacuum, s.last_autovacuum),
+			GREATEST(s.last_analyze, s.last_autoanalyze),
+			s.n_dead_tup,
+			s.seq_scan,
+			s.idx_scan,
+			s.n_tup_ins,
+			s.n_tup_upd,
+			s.n_tup_del
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		LEFT JOIN pg_stat_user_tables s ON s.relid = c.oid
+		WHERE n.nspname = COALESCE(NULLIF($1, ''), current_schema())
+		  AND c.relkind IN ('r', 'p', 'm')
+		  AND ($2::text = '' OR c.relname::text = $2::text)
+		ORDER BY c.relname
+	`
+
+	rows, err := conn.QueryContext(ctx, query, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query table stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []domain.TableStats
+
+	for rows.Next() {
+		var (
+			stat                           domain.TableStats
+			estimatedRows                  sql.NullInt64
+			tableBytes, indexBytes, toast  int64
+			lastVacuum, lastAnalyzed       sql.NullTime
+			deadTuples, seqScan, indexScan sql.NullInt64
+			inserts, updates, deletes      sql.NullInt64
+		)
+
+		if err := rows.Scan(&stat.Schema, &stat.Table, &estimatedRows,
+			&tableBytes, &indexBytes, &toast, &lastVacuum, &lastAnalyzed,
+			&deadTuples, &seqScan, &indexScan, &inserts, &updates, &deletes); err != nil {
+			return nil, fmt.Errorf("failed to scan table stats: %w", err)
+		}
+
+		stat.EstimatedRows = int64Ptr(estimatedRows)
+		stat.TableBytes = &tableBytes
+		stat.IndexBytes = &indexBytes
+		stat.ToastBytes = &toast
+		stat.TotalBytes = domain.SumBytes(stat.TableBytes, stat.IndexBytes, stat.ToastBytes)
+		stat.LastVacuum = timePtr(lastVacuum)
+		stat.LastAnalyzed = timePtr(lastAnalyzed)
+		stat.DeadTuples = int64Ptr(deadTuples)
+		stat.SequentialScan = int64Ptr(seqScan)
+		stat.IndexScan = int64Ptr(indexScan)
+		stat.Inserts = int64Ptr(inserts)
+		stat.Updates = int64Ptr(updates)
+		stat.Deletes = int64Ptr(deletes)
+
+		stats = append(stats, stat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during iteration: %w", err)
+	}
+
+	return stats, nil
+}
+
+// int64Ptr은 NULL이면 nil, 아니면 값의 포인터를 반환합니다.
+func int64Ptr(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// timePtr은 NULL이면 nil, 아니면 값의 포인터를 반환합니다.
+func timePtr(v sql.NullTime) *time.Time {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Time
+}
@@ -0,0 +1,424 @@
+// Package snapshot은 스키마 스냅샷 이력 저장소 구현을 제공합니다.
+// 이 패키지는:
+// 1. output.SnapshotStore 인터페이스를 구현합니다
+// 2. 스냅샷을 버전별 JSON 파일로 디스크에 남깁니다 (서버 재시작 후에도 유지)
+// 3. 오래된 스냅샷 파일은 보관 개수 한도에 맞춰 지웁니다 (변경 로그는 남김)
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// 기본값 (설정이 없을 때)
+const (
+	DefaultDirectory   = "data/snapshots"
+	DefaultMaxVersions = 100
+)
+
+// formatVersion은 파일 형식 버전입니다. 형식이 바뀌면 올립니다.
+const formatVersion = 1
+
+// defaultSchemaDir은 스키마 이름이 비어 있을 때 쓰는 디렉터리 이름입니다.
+const defaultSchemaDir = "_default"
+
+// FileStore는 파일 기반 스냅샷 저장소입니다.
+//
+// 디렉터리 구조:
+//
+//	<directory>/<dbID>/<schema>/index.json      버전 목록 + 변경 로그
+//	<directory>/<dbID>/<schema>/v000001.json    버전별 전체 스냅샷
+//
+// index.json만 읽으면 이력을 볼 수 있어서, 목록 조회에 스냅샷 전체를 읽지 않습니다.
+type FileStore struct {
+	mu sync.Mutex
+
+	directory   string
+	maxVersions int
+}
+
+// indexFile은 index.json의 내용입니다.
+type indexFile struct {
+	Format   int          `json:"format"`
+	Versions []indexEntry `json:"versions"`
+}
+
+// indexEntry는 버전 하나의 요약과 이전 버전 대비 변경 목록입니다.
+type indexEntry struct {
+	Version    int                   `json:"version"`
+	TakenAt    time.Time             `json:"taken_at"`
+	TableCount int                   `json:"table_count"`
+	Changes    []domain.SchemaChange `json:"changes,omitempty"`
+	Pruned     bool                  `json:"pruned,omitempty"` // 스냅샷 파일이 보관 한도로 지워짐
+}
+
+// snapshotFile은 v000001.json의 내용입니다.
+type snapshotFile struct {
+	Format   int                    `json:"format"`
+	Version  int                    `json:"version"`
+	Snapshot *domain.SchemaSnapshot `json:"snapshot"`
+}
+
+// NewFileStore는 FileStore를 생성합니다.
+// 비어 있는 디렉터리와 0 이하의 보관 개수는 기본값으로 바꿉니다.
+func NewFileStore(directory string, maxVersions int) output.SnapshotStore {
+	if directory == "" {
+		directory = DefaultDirectory
+	}
+	if maxVersions <= 0 {
+		maxVersions = DefaultMaxVersions
+	}
+
+	return &FileStore{
+		directory:   directory,
+		maxVersions: maxVersions,
+	}
+}
+
+// Save는 스냅샷을 다음 버전 파일로 쓰고 index.json에 추가합니다.
+func (s *FileStore) Save(ctx context.Context, snapshot *domain.SchemaSnapshot, changes []domain.SchemaChange) (*domain.SnapshotVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir, err := s.schemaDir(snapshot.DatabaseID, snapshot.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	index, err := readIndex(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := indexEntry{
+		Version:    1,
+		TakenAt:    snapshot.TakenAt,
+		TableCount: len(snapshot.Tables),
+		Changes:    changes,
+	}
+	if n := len(index.Versions); n > 0 {
+		entry.Version = index.Versions[n-1].Version + 1
+	}
+
+	// 스냅샷 파일을 먼저 쓰고 index에 추가합니다.
+	// 중간에 실패해도 index에 없는 파일만 남을 뿐, 이력이 깨지지는 않습니다.
+	if err := writeJSON(filepath.Join(dir, versionFile(entry.Version)), snapshotFile{
+		Format:   formatVersion,
+		Version:  entry.Version,
+		Snapshot: snapshot,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	index.Versions = append(index.Versions, entry)
+	s.prune(dir, index)
+
+	if err := writeJSON(filepath.Join(dir, "index.json"), index); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot index: %w", err)
+	}
+
+	version := entry.toDomain(snapshot.DatabaseID, snapshot.Schema)
+	return &version, nil
+}
+
+// Latest는 가장 최근 버전의 스냅샷을 읽습니다.
+func (s *FileStore) Latest(ctx context.Context, dbID string, schema string) (*domain.SchemaSnapshot, *domain.SnapshotVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir, err := s.schemaDir(dbID, schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	index, err := readIndex(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(index.Versions) == 0 {
+		return nil, nil, fmt.Errorf("%w: %s", domain.ErrSnapshotNotFound, dbID)
+	}
+
+	entry := index.Versions[len(index.Versions)-1]
+	snapshot, err := readSnapshot(dir, entry.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := entry.toDomain(dbID, schema)
+	return snapshot, &version, nil
+}
+
+// Get은 특정 버전의 스냅샷 파일을 읽습니다.
+func (s *FileStore) Get(ctx context.Context, dbID string, schema string, version int) (*domain.SchemaSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir, err := s.schemaDir(dbID, schema)
+	if err != nil {
+		return nil, err
+	}
+	return readSnapshot(dir, version)
+}
+
+// ListVersions는 index.json의 버전 목록을 최신순으로 반환합니다.
+// 보관 한도로 스냅샷 파일이 지워진 버전은 제외합니다.
+func (s *FileStore) ListVersions(ctx context.Context, dbID string, schema string) ([]domain.SnapshotVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var versions []domain.SnapshotVersion
+	err := s.eachSchema(dbID, schema, func(schema string, index *indexFile) {
+		for _, entry := range index.Versions {
+			if !entry.Pruned {
+				versions = append(versions, entry.toDomain(dbID, schema))
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].TakenAt.After(versions[j].TakenAt)
+	})
+
+	return versions, nil
+}
+
+// ListChanges는 index.json의 변경 로그를 최신순으로 반환합니다.
+// 첫 버전(비교 대상 없음)은 변경 로그가 아니므로 제외합니다.
+func (s *FileStore) ListChanges(ctx context.Context, dbID string, schema string, since time.Time) ([]domain.SchemaChangeLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var logs []domain.SchemaChangeLog
+	err := s.eachSchema(dbID, schema, func(schema string, index *indexFile) {
+		for i, entry := range index.Versions {
+			if i == 0 || len(entry.Changes) == 0 {
+				continue
+			}
+			if !since.IsZero() && entry.TakenAt.Before(since) {
+				continue
+			}
+			logs = append(logs, domain.SchemaChangeLog{
+				DatabaseID:      dbID,
+				Schema:          schema,
+				Version:         entry.Version,
+				PreviousVersion: index.Versions[i-1].Version,
+				DetectedAt:      entry.TakenAt,
+				Changes:         entry.Changes,
+			})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].DetectedAt.After(logs[j].DetectedAt)
+	})
+
+	return logs, nil
+}
+
+// eachSchema는 schema의 index.json을 읽어서 fn을 부릅니다.
+// schema가 비어 있으면 DB 디렉터리 아래의 모든 스키마를 돕니다.
+func (s *FileStore) eachSchema(dbID string, schema string, fn func(schema string, index *indexFile)) error {
+	if schema != "" {
+		dir, err := s.schemaDir(dbID, schema)
+		if err != nil {
+			return err
+		}
+		index, err := readIndex(dir)
+		if err != nil {
+			return err
+		}
+		fn(schema, index)
+		return nil
+	}
+
+	dbDir, err := s.databaseDir(dbID)
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dbDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot directory: %w", err)
+	}
+
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		name := e.Name()
+		index, err := readIndex(filepath.Join(dbDir, name))
+		if err != nil {
+			return err
+		}
+
+		if name == defaultSchemaDir {
+			name = ""
+		} else if unescaped, err := url.PathUnescape(name); err == nil {
+			name = unescaped
+		}
+		fn(name, index)
+	}
+
+	return nil
+}
+
+// prune은 보관 한도를 넘은 오래된 스냅샷 파일을 지웁니다.
+// index의 항목(변경 로그)은 남겨서 "언제 무엇이 바뀌었는지"는 계속 볼 수 있게 합니다.
+func (s *FileStore) prune(dir string, index *indexFile) {
+	kept := 0
+	for i := len(index.Versions) - 1; i >= 0; i-- {
+		entry := &index.Versions[i]
+		if entry.Pruned {
+			continue
+		}
+
+		kept++
+		if kept <= s.maxVersions {
+			continue
+		}
+
+		// 지우지 못해도 다음 Save 때 다시 시도하면 되므로 에러는 무시합니다.
+		if err := os.Remove(filepath.Join(dir, versionFile(entry.Version))); err == nil || errors.Is(err, os.ErrNotExist) {
+			entry.Pruned = true
+		}
+	}
+}
+
+// databaseDir은 DB의 디렉터리 경로입니다.
+func (s *FileStore) databaseDir(dbID string) (string, error) {
+	if dbID == "" {
+		return "", errors.New("database ID is required for snapshots")
+	}
+	return s.within(filepath.Join(s.directory, escapeName(dbID)))
+}
+
+// schemaDir은 (DB, 스키마)의 디렉터리 경로입니다.
+func (s *FileStore) schemaDir(dbID string, schema string) (string, error) {
+	dbDir, err := s.databaseDir(dbID)
+	if err != nil {
+		return "", err
+	}
+
+	name := defaultSchemaDir
+	if schema != "" {
+		name = escapeName(schema)
+	}
+	return s.within(filepath.Join(dbDir, name))
+}
+
+// within은 path가 저장소 디렉터리 안에 있는지 확인합니다.
+// escapeName으로 막고 있지만, 이름 규칙이 바뀌어도 디렉터리 밖에 쓰지 않도록 한 번 더 확인합니다.
+func (s *FileStore) within(path string) (string, error) {
+	rel, err := filepath.Rel(s.directory, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid snapshot path %q", path)
+	}
+	return path, nil
+}
+
+// escapeName은 ID나 스키마 이름을 디렉터리 이름 하나로 바꿉니다.
+// url.PathEscape는 /를 이스케이프하지만 "."과 ".."은 그대로 두므로,
+// 점으로만 된 이름은 점도 %2E로 바꿉니다 ("..." → "%2E%2E%2E", PathUnescape로 되돌릴 수 있음).
+func escapeName(name string) string {
+	escaped := url.PathEscape(name)
+	if strings.Trim(escaped, ".") == "" {
+		escaped = strings.ReplaceAll(escaped, ".", "%2E")
+	}
+	return escaped
+}
+
+// toDomain은 index 항목을 domain.SnapshotVersion으로 바꿉니다.
+func (e indexEntry) toDomain(dbID string, schema string) domain.SnapshotVersion {
+	return domain.SnapshotVersion{
+		DatabaseID:  dbID,
+		Schema:      schema,
+		Version:     e.Version,
+		TakenAt:     e.TakenAt,
+		TableCount:  e.TableCount,
+		ChangeCount: len(e.Changes),
+	}
+}
+
+// versionFile은 버전의 파일 이름입니다 (정렬하기 쉽게 6자리).
+func versionFile(version int) string {
+	return fmt.Sprintf("v%06d.json", version)
+}
+
+// readIndex는 index.json을 읽습니다. 파일이 없으면 빈 index를 반환합니다.
+func readIndex(dir string) (*indexFile, error) {
+	index := &indexFile{Format: formatVersion}
+
+	data, err := os.ReadFile(filepath.Join(dir, "index.json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot index: %w", err)
+	}
+
+	if err := json.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot index %s: %w", dir, err)
+	}
+
+	return index, nil
+}
+
+// readSnapshot은 버전 파일에서 스냅샷을 읽습니다.
+func readSnapshot(dir string, version int) (*domain.SchemaSnapshot, error) {
+	data, err := os.ReadFile(filepath.Join(dir, versionFile(version)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: version %d", domain.ErrSnapshotNotFound, version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var file snapshotFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot version %d: %w", version, err)
+	}
+
+	return file.Snapshot, nil
+}
+
+// writeJSON은 임시 파일에 쓴 뒤 이름을 바꿉니다.
+// 쓰는 도중에 서버가 죽어도 기존 파일이 반쯤 쓰인 상태로 남지 않습니다.
+func writeJSON(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,82 @@
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"space/internal/domain"
+)
+
+func TestSaveStaysInDirectory(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "snapshots")
+
+	tests := []struct {
+		name   string
+		dbID   string
+		schema string
+	}{
+		{"dot dot schema", "db1", ".."},
+		{"dot schema", "db1", "."},
+		{"dot dot database", "..", "public"},
+		{"dot dot both", "..", ".."},
+		{"slash schema", "db1", "../../escape"},
+		{"many dots", "...", "...."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewFileStore(dir, 10)
+			snapshot := &domain.SchemaSnapshot{DatabaseID: tt.dbID, Schema: tt.schema, TakenAt: time.Now()}
+
+			if _, err := store.Save(context.Background(), snapshot, nil); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+
+			// 저장소 디렉터리 밖에는 아무것도 생기면 안 됩니다.
+			entries, err := os.ReadDir(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range entries {
+				if e.Name() != "snapshots" {
+					t.Errorf("Save(%q, %q) wrote %s outside the snapshot directory", tt.dbID, tt.schema, e.Name())
+				}
+			}
+
+			// 같은 이름으로 다시 읽을 수 있어야 합니다.
+			got, _, err := store.Latest(context.Background(), tt.dbID, tt.schema)
+			if err != nil {
+				t.Fatalf("Latest() error = %v", err)
+			}
+			if got.Schema != tt.schema {
+				t.Errorf("Latest() schema = %q, want %q", got.Schema, tt.schema)
+			}
+
+			versions, err := store.ListVersions(context.Background(), tt.dbID, "")
+			if err != nil {
+				t.Fatalf("ListVersions() error = %v", err)
+			}
+			found := false
+			for _, v := range versions {
+				found = found || v.Schema == tt.schema
+			}
+			if !found {
+				t.Errorf("ListVersions() = %v, want schema %q", versions, tt.schema)
+			}
+		})
+	}
+}
+
+func TestSaveRequiresDatabaseID(t *testing.T) {
+	store := NewFileStore(t.TempDir(), 10)
+
+	_, err := store.Save(context.Background(), &domain.SchemaSnapshot{Schema: "public"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "database ID") {
+		t.Errorf("Save() error = %v, want database ID error", err)
+	}
+}
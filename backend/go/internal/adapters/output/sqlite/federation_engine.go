@@ -0,0 +1,293 @@
+// Package sqlite는 in-memory SQLite 기반의 페더레이션 엔진을 제공합니다.
+// 이 패키지는:
+// 1. output.FederationEngine 인터페이스를 구현합니다
+// 2. modernc.org/sqlite 드라이버를 사용합니다 (cgo 없이 동작하는 순수 Go 구현)
+// 3. 여러 DB의 결과를 임시 테이블로 적재한 뒤 최종 SQL을 실행합니다
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	// modernc.org/sqlite의 init()가 "sqlite" 드라이버를 등록합니다.
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// pageSize는 SQLite 페이지 크기입니다.
+// max_page_count와 곱해서 메모리 상한을 계산합니다.
+const pageSize = 4096
+
+// FederationEngine은 요청마다 새 in-memory SQLite DB를 만들어 사용합니다.
+// 요청끼리 데이터를 공유하지 않으므로 동시 실행에 안전합니다.
+type FederationEngine struct {
+	// limits는 서버 설정에서 온 상한입니다.
+	limits domain.FederationLimits
+}
+
+// NewFederationEngine은 FederationEngine을 생성합니다.
+// limits의 0 값 항목은 domain.DefaultFederationLimits로 채웁니다.
+func NewFederationEngine(limits domain.FederationLimits) output.FederationEngine {
+	if limits.MaxRowsPerSource <= 0 {
+		limits.MaxRowsPerSource = domain.DefaultFederationLimits.MaxRowsPerSource
+	}
+	if limits.MaxMemoryBytes <= 0 {
+		limits.MaxMemoryBytes = domain.DefaultFederationLimits.MaxMemoryBytes
+	}
+
+	return &FederationEngine{limits: limits}
+}
+
+// Limits는 엔진에 설정된 상한을 반환합니다.
+func (e *FederationEngine) Limits() domain.FederationLimits {
+	return e.limits
+}
+
+// Execute는 소스 결과들을 테이블로 적재하고 최종 SQL을 실행합니다.
+func (e *FederationEngine) Execute(ctx context.Context, tables []domain.FederatedTable, query string, limits domain.FederationLimits) (*domain.QueryResult, error) {
+	limits = limits.Clamp(e.limits)
+
+	// ==========================================
+	// 1단계: 행 수 한도 확인
+	// ==========================================
+
+	// 적재 전에 먼저 확인해서 불필요한 작업을 피합니다.
+	for _, t := range tables {
+		if t.Result.RowCount() > limits.MaxRowsPerSource {
+			return nil, fmt.Errorf("%w: source %q returned %d rows (max %d)",
+				domain.ErrFederationLimitExceeded, t.Source.Alias, t.Result.RowCount(), limits.MaxRowsPerSource)
+		}
+	}
+
+	// ==========================================
+	// 2단계: 격리된 in-memory DB 열기
+	// ==========================================
+
+	// ":memory:"는 커넥션마다 별도 DB이므로 커넥션을 1개로 고정합니다.
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open failed: %w", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open federation connection: %w", err)
+	}
+	defer conn.Close()
+
+	// 다른 DB 파일을 붙이지 못하게 합니다 (ATTACH, VACUUM INTO로 서버 파일 접근 방지).
+	// 도메인에서 SELECT만 허용하지만, 분류가 틀려도 엔진에서 한 번 더 막습니다.
+	if _, err := sqlite.Limit(conn, sqlite3.SQLITE_LIMIT_ATTACHED, 0); err != nil {
+		return nil, fmt.Errorf("failed to configure federation engine: %w", err)
+	}
+
+	// max_page_count로 DB 크기를 제한합니다.
+	// 한도를 넘으면 SQLite가 SQLITE_FULL을 반환합니다.
+	pragmas := []string{
+		fmt.Sprintf("PRAGMA page_size = %d", pageSize),
+		fmt.Sprintf("PRAGMA max_page_count = %d", limits.MaxMemoryBytes/pageSize),
+		"PRAGMA temp_store = MEMORY",
+	}
+	for _, p := range pragmas {
+		if _, err := conn.ExecContext(ctx, p); err != nil {
+			return nil, fmt.Errorf("failed to configure federation engine: %w", err)
+		}
+	}
+
+	// ==========================================
+	// 3단계: 소스 결과 적재
+	// ==========================================
+
+	for _, t := range tables {
+		if err := loadTable(ctx, conn, t); err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	// ==========================================
+	// 4단계: 최종 SQL 실행
+	// ==========================================
+
+	result, err := runQuery(ctx, conn, query)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return result, nil
+}
+
+// loadTable은 소스 결과 하나를 테이블로 만들고 데이터를 넣습니다.
+// 컬럼 타입은 원본 DB의 타입 이름을 그대로 선언합니다.
+// SQLite는 선언된 타입 이름에서 affinity를 추론하므로
+// (VARCHAR2 → TEXT, NUMBER → NUMERIC, INT4 → INTEGER)
+// 최종 결과에서도 원본 타입 이름을 그대로 돌려받을 수 있습니다.
+func loadTable(ctx context.Context, conn *sql.Conn, t domain.FederatedTable) error {
+	result := t.Result
+
+	columnDefs := make([]string, len(result.Columns))
+	placeholders := make([]string, len(result.Columns))
+	binary := make([]bool, len(result.Columns))
+
+	for i, col := range result.Columns {
+		typeName := sanitizeTypeName(result.ColumnType(col))
+		columnDefs[i] = strings.TrimSpace(quoteIdent(col) + " " + typeName)
+		placeholders[i] = "?"
+		binary[i] = isBinaryType(typeName)
+	}
+
+	createSQL := fmt.Sprintf("CREATE TABLE %s (%s)",
+		quoteIdent(t.Source.Alias), strings.Join(columnDefs, ", "))
+	if _, err := conn.ExecContext(ctx, createSQL); err != nil {
+		return fmt.Errorf("failed to create table %s: %w", t.Source.Alias, err)
+	}
+
+	if result.IsEmpty() {
+		return nil
+	}
+
+	// 한 트랜잭션 안에서 prepared statement로 넣어야 빠릅니다.
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin load of %s: %w", t.Source.Alias, err)
+	}
+	defer tx.Rollback()
+
+	insertSQL := fmt.Sprintf("INSERT INTO %s VALUES (%s)",
+		quoteIdent(t.Source.Alias), strings.Join(placeholders, ", "))
+	stmt, err := tx.PrepareContext(ctx, insertSQL)
+	if err != nil {
+		return fmt.Errorf("failed to prepare load of %s: %w", t.Source.Alias, err)
+	}
+	defer stmt.Close()
+
+	args := make([]interface{}, len(result.Columns))
+	for _, row := range result.Rows {
+		for i, col := range result.Columns {
+			args[i] = toSQLiteValue(row[col], binary[i])
+		}
+
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return fmt.Errorf("failed to load %s: %w", t.Source.Alias, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit load of %s: %w", t.Source.Alias, err)
+	}
+
+	return nil
+}
+
+// runQuery는 최종 SQL을 실행하고 domain.QueryResult로 변환합니다.
+func runQuery(ctx context.Context, conn *sql.Conn, query string) (*domain.QueryResult, error) {
+	start := time.Now()
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("federated query failed: %w", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	typeNames := make([]string, len(columnTypes))
+	for i, ct := range columnTypes {
+		typeNames[i] = ct.DatabaseTypeName()
+	}
+
+	results := []map[string]interface{}{}
+
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		valuePtrs := make([]interface{}, len(columns))
+
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		row := make(map[string]interface{})
+		for i, col := range columns {
+			row[col] = values[i]
+		}
+
+		results = append(results, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during row iteration: %w", err)
+	}
+
+	return &domain.QueryResult{
+		Columns:       columns,
+		ColumnTypes:   typeNames,
+		Rows:          results,
+		RowsAffected:  int64(len(results)),
+		ExecutionTime: time.Since(start),
+	}, nil
+}
+
+// translateError는 SQLITE_FULL을 도메인 한도 초과 에러로 바꿉니다.
+func translateError(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code()&0xff == sqlite3.SQLITE_FULL {
+		return fmt.Errorf("%w: %v", domain.ErrFederationLimitExceeded, err)
+	}
+	return err
+}
+
+// toSQLiteValue는 드라이버 값을 SQLite에 넣을 수 있는 값으로 바꿉니다.
+// lib/pq는 NUMERIC, TEXT 등을 []byte로 돌려주므로
+// 바이너리 컬럼이 아니면 문자열로 바꿔야 affinity 변환이 동작합니다.
+func toSQLiteValue(v interface{}, binary bool) interface{} {
+	if b, ok := v.([]byte); ok && !binary {
+		return string(b)
+	}
+	return v
+}
+
+// isBinaryType은 바이너리 데이터를 담는 타입인지 확인합니다.
+func isBinaryType(typeName string) bool {
+	upper := strings.ToUpper(typeName)
+	return strings.Contains(upper, "BYTEA") ||
+		strings.Contains(upper, "BLOB") ||
+		strings.Contains(upper, "RAW")
+}
+
+// sanitizeTypeName은 타입 이름에서 DDL에 넣기 위험한 문자를 제거합니다.
+// 영문자, 숫자, 밑줄, 공백만 남깁니다.
+func sanitizeTypeName(typeName string) string {
+	var b strings.Builder
+	for _, r := range typeName {
+		if r == '_' || r == ' ' ||
+			(r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// quoteIdent는 SQLite 식별자를 큰따옴표로 감쌉니다.
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
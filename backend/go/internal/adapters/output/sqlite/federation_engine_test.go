@@ -0,0 +1,64 @@
+package sqlite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"space/internal/domain"
+)
+
+func TestExecuteRejectsAttach(t *testing.T) {
+	engine := NewFederationEngine(domain.FederationLimits{})
+	dir := t.TempDir()
+
+	tests := []struct {
+		name  string
+		query string
+		file  string
+	}{
+		{"attach", "ATTACH DATABASE '" + filepath.Join(dir, "attached.db") + "' AS other", "attached.db"},
+		{"vacuum into", "VACUUM INTO '" + filepath.Join(dir, "copy.db") + "'", "copy.db"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := engine.Execute(context.Background(), nil, tt.query, domain.FederationLimits{}); err == nil {
+				t.Fatalf("Execute(%q) succeeded, want error", tt.query)
+			}
+			if _, err := os.Stat(filepath.Join(dir, tt.file)); !os.IsNotExist(err) {
+				t.Errorf("Execute(%q) created %s", tt.query, tt.file)
+			}
+		})
+	}
+}
+
+func TestExecuteJoinsSources(t *testing.T) {
+	engine := NewFederationEngine(domain.FederationLimits{})
+	tables := []domain.FederatedTable{
+		{
+			Source: domain.FederatedSource{Alias: "students"},
+			Result: &domain.QueryResult{
+				Columns: []string{"id", "name"},
+				Rows:    []map[string]interface{}{{"id": int64(1), "name": "kim"}, {"id": int64(2), "name": "lee"}},
+			},
+		},
+		{
+			Source: domain.FederatedSource{Alias: "grades"},
+			Result: &domain.QueryResult{
+				Columns: []string{"student_id", "grade"},
+				Rows:    []map[string]interface{}{{"student_id": int64(2), "grade": "A"}},
+			},
+		},
+	}
+
+	result, err := engine.Execute(context.Background(), tables,
+		"SELECT s.name, g.grade FROM students s JOIN grades g ON g.student_id = s.id", domain.FederationLimits{})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if len(result.Rows) != 1 || result.Rows[0]["name"] != "lee" || result.Rows[0]["grade"] != "A" {
+		t.Errorf("Execute() rows = %v, want [{name:lee grade:A}]", result.Rows)
+	}
+}
@@ -12,9 +12,15 @@ import (
 
 // Config는 애플리케이션 전체 설정을 담는 구조체입니다.
 type Config struct {
-	Server    ServerConfig     `toml:"server"`
-	Databases []DatabaseConfig `toml:"databases"`
-	Logging   LoggingConfig    `toml:"logging"`
+	Server     ServerConfig     `toml:"server"`
+	Databases  []DatabaseConfig `toml:"databases"`
+	Logging    LoggingConfig    `toml:"logging"`
+	Federation FederationConfig `toml:"federation"`
+	Cache      CacheConfig      `toml:"cache"`
+	Snapshots  SnapshotConfig   `toml:"snapshots"`
+	Metadata   MetadataConfig   `toml:"metadata"`
+	ChangeLog  ChangeLogConfig  `toml:"changelog"`
+	CopyJobs   CopyJobConfig    `toml:"copy_jobs"`
 }
 
 // ServerConfig는 서버 설정입니다.
@@ -36,6 +42,21 @@ type DatabaseConfig struct {
 	Schema            string `toml:"schema"`
 	ConnectOnStartup  bool   `toml:"connect_on_startup"`
 	ConnectionTimeout string `toml:"connection_timeout"` // "60s"
+	CacheTTL          string `toml:"cache_ttl"`          // "30s" (비어 있으면 결과 캐시 안 함)
+
+	// Guard는 선택사항입니다. [databases.guard] 테이블이 없으면 nil
+	Guard *GuardConfig `toml:"guard"`
+
+	// Write는 DMS가 대신 실행하는 쓰기 작업별 허용 사용자입니다.
+	// [databases.write] 테이블이 없으면 읽기 전용 (예: comments = ["kim"], rows = ["dba"])
+	Write map[string][]string `toml:"write"`
+}
+
+// GuardConfig는 DB별 비용 기반 쿼리 가드 설정입니다.
+type GuardConfig struct {
+	MaxCost float64 `toml:"max_cost"` // 추정 비용 한도 (0이면 검사 안 함)
+	MaxRows float64 `toml:"max_rows"` // 계획 노드 하나의 추정 row 수 한도 (0이면 검사 안 함)
+	Action  string  `toml:"action"`   // "reject" 또는 "confirm" (기본값 "confirm")
 }
 
 // LoggingConfig는 로깅 설정입니다.
@@ -44,6 +65,59 @@ type LoggingConfig struct {
 	Prefix string `toml:"prefix"` // "[DMS]"
 }
 
+// FederationConfig는 페더레이션 쿼리(여러 DB 조인) 설정입니다.
+type FederationConfig struct {
+	MaxRowsPerSource int `toml:"max_rows_per_source"` // 소스 하나당 최대 row 수
+	MaxMemoryMB      int `toml:"max_memory_mb"`       // 임베디드 엔진 메모리 상한 (MB)
+}
+
+// CacheConfig는 쿼리 결과 캐시 설정입니다.
+type CacheConfig struct {
+	MaxEntries int `toml:"max_entries"` // 최대 항목 수 (0이면 기본값)
+	MaxSizeMB  int `toml:"max_size_mb"` // 최대 크기 (MB, 0이면 기본값)
+}
+
+// MetadataConfig는 메타데이터 캐시(스키마/테이블/컬럼 목록, 메타데이터 인덱스) 설정입니다.
+type MetadataConfig struct {
+	TTL        string `toml:"ttl"`         // "10m" (비어 있으면 기본값)
+	MaxEntries int    `toml:"max_entries"` // 최대 항목 수 (0이면 기본값)
+	MaxSizeMB  int    `toml:"max_size_mb"` // 최대 크기 (MB, 0이면 기본값)
+}
+
+// GetTTL은 ttl을 time.Duration으로 변환합니다 (잘못된 값이면 0 → 기본값 사용).
+func (m *MetadataConfig) GetTTL() time.Duration {
+	duration, err := time.ParseDuration(m.TTL)
+	if err != nil {
+		return 0
+	}
+	return duration
+}
+
+// GetMaxBytes는 max_size_mb를 바이트로 변환합니다.
+func (m *MetadataConfig) GetMaxBytes() int64 {
+	return int64(m.MaxSizeMB) * 1024 * 1024
+}
+
+// SnapshotConfig는 스키마 스냅샷(구조 변경 감지) 설정입니다.
+type SnapshotConfig struct {
+	Enabled     bool     `toml:"enabled"`      // 주기적으로 찍을지 (API로 찍는 것은 항상 가능)
+	Interval    string   `toml:"interval"`     // "1h"
+	Timeout     string   `toml:"timeout"`      // DB 하나를 찍는 시간 한도 "5m"
+	Directory   string   `toml:"directory"`    // 스냅샷 파일 저장 위치
+	MaxVersions int      `toml:"max_versions"` // 스키마당 보관할 스냅샷 수 (0이면 기본값)
+	Databases   []string `toml:"databases"`    // 대상 DB ID (비어 있으면 연결된 모든 DB)
+}
+
+// ChangeLogConfig는 변경 이력(주석 편집 등) 저장소 설정입니다.
+type ChangeLogConfig struct {
+	Directory string `toml:"directory"` // 이력 파일 저장 위치 (비어 있으면 기본값)
+}
+
+// CopyJobConfig는 테이블 복사 작업 저장소 설정입니다.
+type CopyJobConfig struct {
+	Directory string `toml:"directory"` // 작업 파일 저장 위치 (비어 있으면 기본값)
+}
+
 // Load는 지정된 경로의 TOML 파일을 읽어 Config 구조체를 반환합니다.
 func Load(configPath string) (*Config, error) {
 	// 파일 존재 확인
@@ -111,7 +185,7 @@ func (d *DatabaseConfig) ToDomain() (*domain.Database, error) {
 		return nil, fmt.Errorf("unsupported database type: %s", d.Type)
 	}
 
-	return &domain.Database{
+	db := &domain.Database{
 		ID:       d.ID,
 		Name:     d.Name,
 		Type:     dbType,
@@ -121,5 +195,67 @@ func (d *DatabaseConfig) ToDomain() (*domain.Database, error) {
 		Password: d.Password,
 		Schema:   d.Schema,
 		Status:   domain.Disconnected,
-	}, nil
+	}
+
+	if d.CacheTTL != "" {
+		ttl, err := time.ParseDuration(d.CacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cache_ttl %q: %w", d.CacheTTL, err)
+		}
+		db.CacheTTL = ttl
+	}
+
+	if d.Guard != nil {
+		action := domain.GuardAction(d.Guard.Action)
+		if action == "" {
+			action = domain.GuardConfirm // 기본값
+		}
+
+		db.Guard = &domain.QueryGuard{
+			MaxCost: d.Guard.MaxCost,
+			MaxRows: d.Guard.MaxRows,
+			Action:  action,
+		}
+	}
+
+	if len(d.Write) > 0 {
+		db.Write = make(domain.WritePolicy, len(d.Write))
+		for scope, users := range d.Write {
+			db.Write[domain.WriteScope(scope)] = users
+		}
+	}
+
+	return db, nil
+}
+
+// ToDomain은 FederationConfig를 domain.FederationLimits로 변환합니다.
+// 설정하지 않은 항목은 0으로 남기며, 엔진이 기본값으로 채웁니다.
+func (f *FederationConfig) ToDomain() domain.FederationLimits {
+	return domain.FederationLimits{
+		MaxRowsPerSource: f.MaxRowsPerSource,
+		MaxMemoryBytes:   int64(f.MaxMemoryMB) * 1024 * 1024,
+	}
+}
+
+// GetMaxBytes는 max_size_mb를 바이트로 변환합니다.
+func (c *CacheConfig) GetMaxBytes() int64 {
+	return int64(c.MaxSizeMB) * 1024 * 1024
+}
+
+// GetInterval은 interval을 time.Duration으로 변환합니다 (잘못된 값이면 0 → 기본값 사용).
+func (s *SnapshotConfig) GetInterval() time.Duration {
+	duration, err := time.ParseDuration(s.Interval)
+	if err != nil {
+		return 0
+	}
+	return duration
+}
+
+// GetTimeout은 timeout을 time.Duration으로 변환합니다 (잘못된 값이면 0 → 기본값 사용).
+func (s *SnapshotConfig) GetTimeout() time.Duration {
+	duration, err := time.ParseDuration(s.Timeout)
+	if err != nil {
+		return 0
+	}
+	return duration
 }
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"space/internal/domain"
+)
+
+// cacheTTL은 이 요청에 적용할 결과 캐시 TTL을 결정합니다.
+//
+// 우선순위:
+//  1. 요청별 TTL (opts.CacheTTL, 0이면 캐시 안 함)
+//  2. DB별 TTL (Database.CacheTTL)
+//
+// SELECT가 아니면 항상 0입니다 (쓰기 결과는 캐시하지 않음).
+func (s *databaseService) cacheTTL(ctx context.Context, dbID string, kind domain.StatementKind, opts domain.QueryOptions) time.Duration {
+	if s.cache == nil || kind != domain.StatementSelect {
+		return 0
+	}
+
+	if opts.CacheTTL != nil {
+		return *opts.CacheTTL
+	}
+
+	db, err := s.findDatabase(ctx, dbID)
+	if err != nil {
+		return 0
+	}
+	return db.CacheTTL
+}
+
+// storeCache는 결과를 캐시에 저장하고, 캐시 정보가 붙은 결과를 반환합니다.
+// 저장에 실패하면 (직렬화 불가 등) 원본 결과를 그대로 반환합니다.
+func (s *databaseService) storeCache(key domain.CacheKey, result *domain.QueryResult, ttl time.Duration) *domain.QueryResult {
+	entry, err := domain.NewCacheEntry(key, result, ttl, time.Now())
+	if err != nil {
+		log.Printf("result cache skipped for %s: %v", key.DatabaseID, err)
+		return result
+	}
+
+	s.cache.Set(entry)
+	return entry.WithCacheInfo(false)
+}
+
+// invalidateResults는 DB 하나의 결과 캐시를 비웁니다 (캐시가 없으면 아무 일도 안 함).
+// 쓰기가 커밋된 뒤에 호출합니다. 어떤 SELECT 결과가 바뀌었는지 알 수 없기 때문!
+func (s *databaseService) invalidateResults(dbID string) {
+	if s.cache == nil {
+		return
+	}
+	s.cache.InvalidateDatabase(dbID)
+}
+
+// InvalidateCache는 결과 캐시를 비웁니다 (dbID가 비어 있으면 전체).
+func (s *databaseService) InvalidateCache(ctx context.Context, dbID string) (int, error) {
+	if s.cache == nil {
+		return 0, nil
+	}
+
+	if dbID == "" {
+		return s.cache.InvalidateAll(), nil
+	}
+
+	return s.cache.InvalidateDatabase(dbID), nil
+}
+
+// CacheStats는 결과 캐시의 현재 상태를 반환합니다.
+func (s *databaseService) CacheStats(ctx context.Context) domain.CacheStats {
+	if s.cache == nil {
+		return domain.CacheStats{}
+	}
+	return s.cache.Stats()
+}
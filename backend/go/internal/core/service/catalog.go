@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"space/internal/domain"
+)
+
+// defaultSearchLimit은 스키마 검색 결과의 기본 최대 개수입니다.
+const defaultSearchLimit = 500
+
+// SearchSchema는 연결된 여러 DB에서 테이블/컬럼 이름과 주석을 검색합니다.
+//
+// DB마다 메타데이터 인덱스(catalog)를 캐시에서 꺼내 쓰므로,
+// 처음 한 번(또는 만료 후)만 DB 카탈로그를 조회합니다.
+// DB 여러 개의 인덱스는 동시에 읽고, 결과는 DB 순서대로 합칩니다.
+func (s *databaseService) SearchSchema(ctx context.Context, query domain.SchemaSearchQuery) (*domain.SchemaSearchResult, error) {
+	matcher, err := domain.NewSchemaMatcher(query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	databases, err := s.repo.ListConnections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list databases: %w", err)
+	}
+
+	result := &domain.SchemaSearchResult{}
+
+	// DatabaseIDs를 지정했으면 그 DB만 (등록되지 않은 ID는 에러로 알려줌)
+	if len(query.DatabaseIDs) > 0 {
+		byID := make(map[string]*domain.Database, len(databases))
+		for _, db := range databases {
+			byID[db.ID] = db
+		}
+
+		databases = databases[:0]
+		for _, id := range query.DatabaseIDs {
+			if db, ok := byID[id]; ok {
+				databases = append(databases, db)
+			} else {
+				result.Errors = append(result.Errors, domain.SearchError{DatabaseID: id, Message: domain.ErrDatabaseNotFound.Error()})
+			}
+		}
+	}
+
+	type searched struct {
+		matches   []domain.SchemaSearchMatch
+		truncated bool
+		err       error
+	}
+	results := make([]searched, len(databases))
+
+	var wg sync.WaitGroup
+	for i, db := range databases {
+		if !db.IsConnected() {
+			results[i].err = domain.ErrDatabaseNotConnected
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, db *domain.Database) {
+			defer wg.Done()
+
+			catalog, err := s.databaseCatalog(ctx, db)
+			if err != nil {
+				results[i].err = err
+				return
+			}
+			results[i].matches, results[i].truncated = matcher.Search(catalog, limit)
+		}(i, db)
+	}
+	wg.Wait()
+
+	for i, r := range results {
+		if r.err != nil {
+			result.Errors = append(result.Errors, domain.SearchError{DatabaseID: databases[i].ID, Message: r.err.Error()})
+			continue
+		}
+		result.Searched++
+
+		for _, match := range r.matches {
+			if len(result.Matches) >= limit {
+				result.Truncated = true
+				break
+			}
+			result.Matches = append(result.Matches, match)
+		}
+		if r.truncated {
+			result.Truncated = true
+		}
+	}
+
+	return result, nil
+}
+
+// databaseCatalog는 DB의 메타데이터 인덱스를 반환합니다.
+// 캐시에 있으면 그대로 쓰고, 없으면 카탈로그를 조회해서 이름을 정규화한 뒤 캐시에 넣습니다.
+// 인덱스는 읽기 전용으로만 쓰므로 복사하지 않습니다.
+func (s *databaseService) databaseCatalog(ctx context.Context, db *domain.Database) (*domain.DatabaseCatalog, error) {
+	key := domain.MetadataKey{DatabaseID: db.ID, Kind: domain.MetadataCatalog}
+	same := func(catalog *domain.DatabaseCatalog) *domain.DatabaseCatalog { return catalog }
+
+	return cachedMetadata(s, key, same, func() (*domain.DatabaseCatalog, error) {
+		catalog, err := s.repo.GetCatalog(ctx, db.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load catalog: %w", err)
+		}
+
+		catalog.DatabaseID = db.ID
+		catalog.DatabaseType = db.Type
+		catalog.LoadedAt = time.Now()
+		catalog.CurrentSchema = domain.DisplayIdentifier(db.Type, catalog.CurrentSchema)
+
+		tables := catalog.Tables
+		for i := range tables {
+			tables[i].Schema = domain.DisplayIdentifier(db.Type, tables[i].Schema)
+			tables[i].Name = domain.DisplayIdentifier(db.Type, tables[i].Name)
+			for j := range tables[i].Columns {
+				tables[i].Columns[j].Name = domain.DisplayIdentifier(db.Type, tables[i].Columns[j].Name)
+			}
+		}
+
+		return catalog, nil
+	})
+}
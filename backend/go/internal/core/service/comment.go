@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"space/internal/domain"
+)
+
+// SetComment는 테이블 또는 컬럼 주석을 바꾸고 변경 이력을 남깁니다 (빈 주석이면 지움).
+//
+// 순서:
+//  1. 쓰기 권한 확인 (DB 설정의 comments 허용 사용자)
+//  2. 캐시를 비우고 DB에서 현재 주석을 읽음 (이력의 Before)
+//  3. 방언에 맞는 COMMENT ON 실행
+//  4. 변경 이력 저장
+//
+// Oracle의 COMMENT는 DDL이라 바로 커밋됩니다. 그래서 이력 저장이 실패해도 주석은
+// 이미 바뀐 상태이며, 이 경우 에러에 그 사실을 적어서 반환합니다.
+func (s *databaseService) SetComment(ctx context.Context, dbID string, change domain.CommentChange) (*domain.ChangeLogEntry, error) {
+	if strings.TrimSpace(change.Table) == "" {
+		return nil, fmt.Errorf("table is required")
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.CheckWrite(domain.WriteComments, change.Author); err != nil {
+		return nil, err
+	}
+
+	schema, err := s.resolveSchema(ctx, db, change.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// 이력의 Before는 캐시가 아니라 지금 DB에 있는 값이어야 합니다.
+	s.invalidateMetadata(dbID)
+
+	tableName, columns, err := s.lookupColumns(ctx, db, schema, change.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.tableMetadata(ctx, dbID, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of %s: %w", tableName, err)
+	}
+
+	tableType, err := s.tableType(ctx, dbID, metadata.Schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	target := domain.CommentTarget{Schema: metadata.Schema, Table: tableName, TableType: tableType}
+	entry := &domain.ChangeLogEntry{
+		DatabaseID: dbID,
+		Schema:     domain.DisplayIdentifier(db.Type, metadata.Schema),
+		Table:      domain.DisplayIdentifier(db.Type, tableName),
+		Kind:       domain.ChangeTableComment,
+		Author:     change.Author,
+		Before:     metadata.Comment,
+		After:      change.Comment,
+	}
+
+	if change.Column != "" {
+		names := make([]string, len(columns))
+		for i, c := range columns {
+			names[i] = c.Name
+		}
+		columnName, ok := matchIdentifier(db.Type, names, change.Column)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s.%s", domain.ErrColumnNotFound, entry.Table, change.Column)
+		}
+
+		target.Column = columnName
+		entry.Kind = domain.ChangeColumnComment
+		entry.Column = domain.DisplayIdentifier(db.Type, columnName)
+		for _, c := range columns {
+			if c.Name == columnName {
+				entry.Before = c.Comment
+			}
+		}
+	}
+
+	statement, err := domain.CommentStatement(db.Type, target, change.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.ExecuteStatement(ctx, dbID, statement); err != nil {
+		return nil, fmt.Errorf("failed to set comment: %w", err)
+	}
+	s.invalidateMetadata(dbID)
+
+	entry.Statement = statement
+	entry.ChangedAt = time.Now()
+
+	if err := s.changes.Append(ctx, entry); err != nil {
+		return nil, fmt.Errorf("comment was changed but recording the change log failed: %w", err)
+	}
+
+	return entry, nil
+}
+
+// ListChangeLog는 DMS로 바꾼 내용의 변경 이력을 최신순으로 반환합니다.
+// 연결이 끊긴 DB의 이력도 볼 수 있도록 연결 여부는 확인하지 않습니다.
+func (s *databaseService) ListChangeLog(ctx context.Context, query domain.ChangeLogQuery) ([]domain.ChangeLogEntry, error) {
+	if query.Limit <= 0 {
+		query.Limit = domain.DefaultChangeLogLimit
+	}
+
+	entries, err := s.changes.List(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list change log: %w", err)
+	}
+	return entries, nil
+}
+
+// tableType은 테이블 목록에서 객체 종류(테이블, 뷰 등)를 찾습니다.
+// Postgres는 종류마다 COMMENT ON 뒤의 키워드가 다르기 때문에 필요합니다.
+func (s *databaseService) tableType(ctx context.Context, dbID string, schema string, tableName string) (domain.TableType, error) {
+	tables, err := s.tables(ctx, dbID, schema)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tables: %w", err)
+	}
+
+	for _, t := range tables {
+		if t.Name == tableName {
+			return t.Type, nil
+		}
+	}
+	return domain.TableTypeTable, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// CompleteSQL은 SQL 에디터의 커서 위치에 맞는 자동완성 후보를 반환합니다.
+//
+// 키 입력마다 호출되므로 DB를 조회하지 않고 캐시된 메타데이터 인덱스(databaseCatalog)만 씁니다.
+// 스키마 없이 쓴 테이블 이름은 요청한 스키마 → DB 설정 스키마 → 세션의 현재 스키마 순서로 찾습니다.
+func (s *databaseService) CompleteSQL(ctx context.Context, dbID string, req domain.CompletionRequest) (*domain.CompletionResult, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := s.databaseCatalog(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := catalog.CurrentSchema
+	switch {
+	case req.Schema != "":
+		found, ok := catalog.FindSchema(req.Schema)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrSchemaNotFound, req.Schema)
+		}
+		schema = found
+
+	case db.Schema != "":
+		// Oracle은 Schema에 서비스 이름이 들어 있을 수 있으므로 실제 스키마일 때만 (resolveSchema와 같은 규칙)
+		if found, ok := catalog.FindSchema(domain.DisplayIdentifier(db.Type, db.Schema)); ok {
+			schema = found
+		}
+	}
+
+	return domain.CompleteSQL(catalog, schema, req)
+}
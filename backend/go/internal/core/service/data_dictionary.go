@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"space/internal/domain"
+)
+
+// GenerateDataDictionary는 DB(또는 스키마)의 데이터 사전을 만듭니다.
+//
+// 읽기만 하므로 메타데이터 캐시를 그대로 씁니다.
+// 다른 도구로 바꾼 스키마나 주석을 바로 반영하려면 먼저 POST /metadata/refresh를 호출합니다.
+// 테이블마다 컬럼/메타데이터 조회를 하므로 테이블이 많으면 시간이 걸립니다.
+func (s *databaseService) GenerateDataDictionary(ctx context.Context, dbID string, options domain.DataDictionaryOptions) (*domain.DataDictionary, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := s.dictionarySchemas(ctx, db, options.Schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	dictionary := &domain.DataDictionary{
+		DatabaseID:   dbID,
+		DatabaseType: db.Type,
+		GeneratedAt:  time.Now(),
+	}
+
+	for _, schema := range schemas {
+		tables, err := s.tables(ctx, dbID, schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tables of %s: %w", schema, err)
+		}
+
+		for _, table := range tables {
+			// 스키마를 지정하지 않았으면 카탈로그가 알려준 현재 스키마를 씁니다.
+			if schema == "" {
+				schema = table.Schema
+			}
+
+			// 필터는 화면에 보이는 이름으로, 조회는 카탈로그 이름으로 합니다.
+			catalogName := table.Name
+			table.Name = domain.DisplayIdentifier(db.Type, table.Name)
+			if !options.IncludesTable(table) {
+				continue
+			}
+
+			entry, err := s.dictionaryTable(ctx, db, schema, catalogName, table.Type)
+			if err != nil {
+				return nil, err
+			}
+			dictionary.Tables = append(dictionary.Tables, entry)
+		}
+
+		if schema != "" {
+			dictionary.Schemas = append(dictionary.Schemas, domain.DisplayIdentifier(db.Type, schema))
+		}
+	}
+
+	sort.Strings(dictionary.Schemas)
+	sort.Slice(dictionary.Tables, func(i, j int) bool {
+		a, b := dictionary.Tables[i], dictionary.Tables[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Name < b.Name
+	})
+
+	return dictionary, nil
+}
+
+// dictionarySchemas는 스키마 패턴을 카탈로그 스키마 이름 목록으로 바꿉니다.
+//
+// 패턴이 없으면 DB 기본 스키마 하나입니다 (빈 문자열이면 세션의 현재 스키마).
+// 와일드카드가 없는 이름은 resolveSchema로 찾으므로 없으면 ErrSchemaNotFound입니다.
+func (s *databaseService) dictionarySchemas(ctx context.Context, db *domain.Database, patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		schema, err := s.resolveSchema(ctx, db, "")
+		if err != nil {
+			return nil, err
+		}
+		return []string{schema}, nil
+	}
+
+	var result []string
+	var all []string
+	seen := make(map[string]bool)
+
+	for _, pattern := range patterns {
+		if !domain.HasWildcard(pattern) {
+			schema, err := s.resolveSchema(ctx, db, pattern)
+			if err != nil {
+				return nil, err
+			}
+			if !seen[schema] {
+				seen[schema] = true
+				result = append(result, schema)
+			}
+			continue
+		}
+
+		if all == nil {
+			var err error
+			if all, err = s.schemas(ctx, db.ID); err != nil {
+				return nil, fmt.Errorf("failed to get schemas: %w", err)
+			}
+		}
+		for _, schema := range all {
+			if !seen[schema] && domain.MatchNamePattern(pattern, domain.DisplayIdentifier(db.Type, schema)) {
+				seen[schema] = true
+				result = append(result, schema)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// dictionaryTable은 테이블 하나의 컬럼, 주석, 키, 인덱스를 읽습니다.
+func (s *databaseService) dictionaryTable(ctx context.Context, db *domain.Database, schema string, tableName string, tableType domain.TableType) (domain.DictionaryTable, error) {
+	columns, err := s.columns(ctx, db.ID, schema, tableName)
+	if err != nil {
+		return domain.DictionaryTable{}, fmt.Errorf("failed to get columns of %s: %w", tableName, err)
+	}
+
+	metadata, err := s.tableMetadata(ctx, db.ID, schema, tableName)
+	if err != nil {
+		return domain.DictionaryTable{}, fmt.Errorf("failed to get metadata of %s: %w", tableName, err)
+	}
+
+	for i := range columns {
+		columns[i].Name = domain.DisplayIdentifier(db.Type, columns[i].Name)
+	}
+	displayTableMetadata(db.Type, metadata)
+
+	return domain.DictionaryTable{
+		Schema:   metadata.Schema,
+		Name:     metadata.Table,
+		Type:     tableType,
+		Comment:  metadata.Comment,
+		Columns:  columns,
+		Metadata: metadata,
+	}, nil
+}
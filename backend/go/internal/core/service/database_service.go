@@ -9,6 +9,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	// Domain import (안쪽)
 	"space/internal/domain"
@@ -31,6 +32,28 @@ type databaseService struct {
 	// 실제로 Postgres인지 Oracle인지 MongoDB인지 모릅니다!
 	// 그냥 "이 인터페이스를 만족하는 뭔가"만 알면 됩니다.
 	repo output.DatabaseRepository
+
+	// federation은 여러 DB 결과를 조인할 때 사용하는 임베디드 엔진입니다.
+	federation output.FederationEngine
+
+	// cache는 SELECT 결과 캐시입니다 (DB 또는 요청에 TTL이 있을 때만 사용).
+	cache output.ResultCache
+
+	// snapshots는 스키마 스냅샷 이력 저장소입니다 (구조 변경 감지용).
+	snapshots output.SnapshotStore
+
+	// metadata는 DB별 메타데이터 캐시입니다 (스키마 브라우저, 검색, 자동완성용).
+	metadata output.MetadataCache
+
+	// changes는 DMS로 바꾼 내용(주석 편집 등)의 변경 이력 저장소입니다.
+	changes output.ChangeLogStore
+
+	// copyJobs는 테이블 복사 작업 저장소입니다 (진행 상황, 이어서 복사할 위치).
+	copyJobs output.CopyJobStore
+
+	// copying은 이 서버에서 실행 중인 복사 작업 ID입니다 (같은 작업을 두 번 실행하지 않게).
+	copyMu  sync.Mutex
+	copying map[string]bool
 }
 
 // NewDatabaseService는 databaseService의 생성자 함수입니다.
@@ -41,17 +64,30 @@ type databaseService struct {
 //
 // 파라미터:
 //   - repo: output.DatabaseRepository - 의존성 주입(DI)
+//   - federation: output.FederationEngine - 페더레이션 쿼리용 임베디드 엔진
+//   - cache: output.ResultCache - 쿼리 결과 캐시
+//   - snapshots: output.SnapshotStore - 스키마 스냅샷 이력 저장소
+//   - metadata: output.MetadataCache - 메타데이터 캐시
+//   - changes: output.ChangeLogStore - 변경 이력 저장소
+//   - copyJobs: output.CopyJobStore - 테이블 복사 작업 저장소
 //
 // 반환값:
 //   - input.DatabaseService - 인터페이스 타입! (구현체 아님)
 //
 // 왜 인터페이스를 반환할까?
 // → 사용하는 쪽(HTTP Handler)도 구현체를 알 필요가 없게 하기 위해!
-func NewDatabaseService(repo output.DatabaseRepository) input.DatabaseService {
+func NewDatabaseService(repo output.DatabaseRepository, federation output.FederationEngine, cache output.ResultCache, snapshots output.SnapshotStore, metadata output.MetadataCache, changes output.ChangeLogStore, copyJobs output.CopyJobStore) input.DatabaseService {
 	// &databaseService{...}는 struct 포인터를 생성합니다.
 	// { } 안에 필드 값을 초기화합니다.
 	return &databaseService{
-		repo: repo, // repo 필드에 파라미터 repo 할당
+		repo:       repo, // repo 필드에 파라미터 repo 할당
+		federation: federation,
+		cache:      cache,
+		snapshots:  snapshots,
+		metadata:   metadata,
+		changes:    changes,
+		copyJobs:   copyJobs,
+		copying:    make(map[string]bool),
 	}
 }
 
@@ -117,6 +153,9 @@ func (s *databaseService) RegisterDatabase(ctx context.Context, db *domain.Datab
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	// 예전에 같은 ID로 등록했던 DB의 메타데이터가 남아 있을 수 있으므로 비웁니다.
+	s.invalidateMetadata(db.ID)
+
 	// ==========================================
 	// 5단계: 성공!
 	// ==========================================
@@ -128,7 +167,7 @@ func (s *databaseService) RegisterDatabase(ctx context.Context, db *domain.Datab
 }
 
 // ExecuteQuery는 특정 데이터베이스에 쿼리를 실행합니다.
-func (s *databaseService) ExecuteQuery(ctx context.Context, dbID string, query string) (*domain.QueryResult, error) {
+func (s *databaseService) ExecuteQuery(ctx context.Context, dbID string, query string, opts domain.QueryOptions) (*domain.QueryResult, error) {
 	// ==========================================
 	// 1단계: 입력값 검증
 	// ==========================================
@@ -156,7 +195,33 @@ func (s *databaseService) ExecuteQuery(ctx context.Context, dbID string, query s
 	}
 
 	// ==========================================
-	// 3단계: 쿼리 실행 (Output Port 호출!)
+	// 3단계: 결과 캐시 확인 (opt-in)
+	// ==========================================
+
+	// TTL이 있는 SELECT만 캐시를 사용합니다.
+	// 캐시에 있으면 가드 검사와 실행을 모두 건너뜁니다.
+	kind := domain.ClassifyStatement(query)
+	ttl := s.cacheTTL(ctx, dbID, kind, opts)
+	cacheKey := domain.NewCacheKey(dbID, query)
+
+	if ttl > 0 && !opts.RefreshCache {
+		if entry, ok := s.cache.Get(cacheKey); ok {
+			return entry.WithCacheInfo(true), nil
+		}
+	}
+
+	// ==========================================
+	// 4단계: 비용 가드 검사 (설정된 DB만)
+	// ==========================================
+
+	// 운영 DB에서 무거운 쿼리(카테시안 조인 등)를 실행하기 전에
+	// EXPLAIN으로 추정 비용을 확인합니다.
+	if err := s.checkGuard(ctx, dbID, query, opts); err != nil {
+		return nil, err
+	}
+
+	// ==========================================
+	// 5단계: 쿼리 실행 (Output Port 호출!)
 	// ==========================================
 
 	// 🔥 실제 쿼리 실행
@@ -169,7 +234,27 @@ func (s *databaseService) ExecuteQuery(ctx context.Context, dbID string, query s
 	}
 
 	// ==========================================
-	// 4단계: 결과 반환
+	// 6단계: 캐시 저장 / 무효화
+	// ==========================================
+
+	// DDL은 스키마를 바꾸므로 이 DB의 메타데이터 캐시도 비웁니다 (다른 DB는 그대로).
+	if kind == domain.StatementDDL {
+		s.invalidateMetadata(dbID)
+	}
+
+	// SELECT가 아닌 문장(INSERT/UPDATE/DELETE/DDL/CALL 등)은 이 DB의 캐시를 모두 비웁니다.
+	// 어떤 SELECT 결과가 바뀌었는지 알 수 없기 때문!
+	if kind.IsWrite() {
+		s.invalidateResults(dbID)
+		return result, nil
+	}
+
+	if ttl > 0 {
+		result = s.storeCache(cacheKey, result, ttl)
+	}
+
+	// ==========================================
+	// 7단계: 결과 반환
 	// ==========================================
 
 	// domain.QueryResult를 그대로 반환
@@ -228,6 +313,9 @@ func (s *databaseService) DisconnectDatabase(ctx context.Context, dbID string) e
 		return fmt.Errorf("failed to disconnect: %w", err)
 	}
 
+	// 같은 ID로 다른 DB를 다시 등록할 수도 있으므로 메타데이터 캐시를 비웁니다.
+	s.invalidateMetadata(dbID)
+
 	return nil
 }
 
@@ -279,26 +367,30 @@ func (s *databaseService) GetDatabaseInfo(ctx context.Context, dbID string) (*do
 	return nil, domain.ErrDatabaseNotFound
 }
 
-// 추가 헬퍼 메서드들 (선택사항)
+// ExplainQuery는 쿼리의 실행 계획을 조회합니다.
+func (s *databaseService) ExplainQuery(ctx context.Context, dbID string, query string, analyze bool) (*domain.QueryPlan, error) {
+	if len(dbID) == 0 {
+		return nil, fmt.Errorf("dbID is required")
+	}
+
+	if len(query) == 0 {
+		return nil, fmt.Errorf("query is required")
+	}
 
-// GetTables는 특정 데이터베이스의 테이블 목록을 조회합니다.
-// 이 메서드는 input.DatabaseService 인터페이스에는 없지만,
-// 추가 기능으로 제공할 수 있습니다.
-func (s *databaseService) GetTables(ctx context.Context, dbID string) ([]string, error) {
-	// 연결 확인
 	if !s.repo.IsConnected(ctx, dbID) {
 		return nil, domain.ErrDatabaseNotConnected
 	}
 
-	// Output Port 호출
-	tables, err := s.repo.GetTables(ctx, dbID)
+	plan, err := s.repo.Explain(ctx, dbID, query, analyze)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tables: %w", err)
+		return nil, fmt.Errorf("explain failed: %w", err)
 	}
 
-	return tables, nil
+	return plan, nil
 }
 
+// 추가 헬퍼 메서드들 (선택사항)
+
 // ValidateQuery는 쿼리의 기본적인 유효성을 검사합니다.
 // (실제 구문 분석은 하지 않고, 위험한 키워드만 체크)
 //
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// fakeRepository는 연결된 DB 하나에 쿼리를 실행하는 척하는 저장소입니다.
+// 테스트에서 쓰지 않는 메서드는 임베드한 인터페이스(nil)로 남겨 둡니다.
+type fakeRepository struct {
+	output.DatabaseRepository
+	executed []string
+}
+
+func (r *fakeRepository) IsConnected(ctx context.Context, dbID string) bool {
+	return dbID == "db1"
+}
+
+func (r *fakeRepository) ListConnections(ctx context.Context) ([]*domain.Database, error) {
+	return []*domain.Database{{ID: "db1"}}, nil
+}
+
+func (r *fakeRepository) ExecuteQuery(ctx context.Context, dbID string, query string, args ...interface{}) (*domain.QueryResult, error) {
+	r.executed = append(r.executed, query)
+	return &domain.QueryResult{}, nil
+}
+
+// fakeCache는 InvalidateDatabase 호출만 기록하는 캐시입니다.
+type fakeCache struct {
+	output.ResultCache
+	invalidated []string
+}
+
+func (c *fakeCache) Get(key domain.CacheKey) (*domain.CacheEntry, bool) {
+	return nil, false
+}
+
+func (c *fakeCache) Set(entry *domain.CacheEntry) {}
+
+func (c *fakeCache) InvalidateDatabase(dbID string) int {
+	c.invalidated = append(c.invalidated, dbID)
+	return 0
+}
+
+func TestExecuteQueryInvalidatesCache(t *testing.T) {
+	tests := []struct {
+		query      string
+		invalidate bool
+	}{
+		{"SELECT * FROM users", false},
+		{"WITH a AS (SELECT 1) SELECT * FROM a", false},
+		{"INSERT INTO users (id) VALUES (1)", true},
+		{"WITH d AS (DELETE FROM users RETURNING *) SELECT * FROM d", true},
+		{"CREATE TABLE t (id int)", true},
+		{"CALL refresh_stats()", true},
+		{"DO $$ BEGIN DELETE FROM users; END $$", true},
+		{"EXEC refresh_stats", true},
+		{"BEGIN refresh_stats; END;", true},
+		{"SELECT 1; DELETE FROM users", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			repo := &fakeRepository{}
+			cache := &fakeCache{}
+			svc := &databaseService{repo: repo, cache: cache}
+
+			if _, err := svc.ExecuteQuery(context.Background(), "db1", tt.query, domain.QueryOptions{}); err != nil {
+				t.Fatalf("ExecuteQuery(%q) error = %v", tt.query, err)
+			}
+			if len(repo.executed) != 1 {
+				t.Fatalf("ExecuteQuery(%q) executed %d queries, want 1", tt.query, len(repo.executed))
+			}
+
+			invalidated := len(cache.invalidated) > 0
+			if invalidated != tt.invalidate {
+				t.Errorf("ExecuteQuery(%q) invalidated cache = %v, want %v", tt.query, invalidated, tt.invalidate)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"space/internal/domain"
+)
+
+// GenerateDDL은 객체를 다시 만드는 DDL(CREATE 문)을 반환합니다.
+//
+// dialect가 비어 있거나 DB와 같은 방언이면 DB가 만든 DDL을 그대로 돌려줍니다
+// (Oracle: DBMS_METADATA, Postgres: 카탈로그 재구성).
+// 다른 방언이면 테이블 구조를 읽어서 domain.GenerateTableDDL로 변환합니다.
+// 변환은 마이그레이션 계획용이므로, 정확히 옮기지 못한 부분은 Warnings에 담습니다.
+func (s *databaseService) GenerateDDL(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string, dialect domain.SQLDialect) (*domain.DDLResult, error) {
+	if !objectType.HasDDL() {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidObjectType, objectType)
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("object name is required")
+	}
+
+	source := domain.DialectOf(db.Type)
+	if dialect == "" {
+		dialect = source
+	}
+	if !dialect.IsValid() || !source.IsValid() {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnsupportedDialect, dialect)
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.DDLResult{
+		Schema:  domain.DisplayIdentifier(db.Type, schema),
+		Name:    domain.DisplayIdentifier(db.Type, name),
+		Type:    objectType,
+		Dialect: dialect,
+	}
+
+	if dialect == source {
+		ddl, err := s.ddl(ctx, dbID, schema, objectType, name)
+		if errors.Is(err, domain.ErrObjectNotFound) {
+			if folded := domain.FoldIdentifier(db.Type, name); folded != name {
+				ddl, err = s.ddl(ctx, dbID, schema, objectType, folded)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DDL: %w", err)
+		}
+
+		result.DDL = ddl
+		return result, nil
+	}
+
+	switch objectType {
+	case domain.ObjectTable:
+		err = s.convertTableDDL(ctx, db, schema, name, result)
+	case domain.ObjectView, domain.ObjectMaterializedView:
+		err = s.convertViewDDL(ctx, db, schema, name, result)
+	default:
+		return nil, fmt.Errorf("%w: %s (%s → %s)", domain.ErrDDLNotSupported, objectType, source, dialect)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// convertTableDDL은 테이블 구조(컬럼 + 메타데이터)를 읽어서 다른 방언의 DDL을 만듭니다.
+func (s *databaseService) convertTableDDL(ctx context.Context, db *domain.Database, schema string, name string, result *domain.DDLResult) error {
+	tableName, columns, err := s.lookupColumns(ctx, db, schema, name)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := s.tableMetadata(ctx, db.ID, schema, tableName)
+	if err != nil {
+		return fmt.Errorf("failed to get table metadata: %w", err)
+	}
+
+	// 이름을 정규화하면 "USERS"(Oracle) → users처럼 따옴표 없는 이름이 되어
+	// 대상 DB의 기본 대소문자로 만들어집니다.
+	for i := range columns {
+		columns[i].Name = domain.DisplayIdentifier(db.Type, columns[i].Name)
+	}
+	displayTableMetadata(db.Type, metadata)
+
+	result.Name = metadata.Table
+	result.DDL, result.Warnings = domain.GenerateTableDDL(domain.TableDefinition{
+		Source:   domain.DialectOf(db.Type),
+		Metadata: metadata,
+		Columns:  columns,
+	}, result.Dialect)
+
+	return nil
+}
+
+// convertViewDDL은 뷰 정의를 다른 방언의 CREATE VIEW 문으로 감쌉니다.
+// SELECT 문 자체는 번역하지 않으므로 항상 경고를 남깁니다.
+func (s *databaseService) convertViewDDL(ctx context.Context, db *domain.Database, schema string, name string, result *domain.DDLResult) error {
+	object, err := s.object(ctx, db.ID, schema, result.Type, name)
+	if errors.Is(err, domain.ErrObjectNotFound) {
+		if folded := domain.FoldIdentifier(db.Type, name); folded != name {
+			object, err = s.object(ctx, db.ID, schema, result.Type, folded)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get view: %w", err)
+	}
+
+	displayObject(db.Type, object)
+	result.Name = object.Name
+
+	qualified := domain.QuoteIdentifier(object.Schema) + "." + domain.QuoteIdentifier(object.Name)
+	body := strings.TrimRight(object.Definition, "; \n")
+
+	if result.Type == domain.ObjectMaterializedView {
+		result.DDL = fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS\n%s;\n", qualified, body)
+	} else {
+		result.DDL = fmt.Sprintf("CREATE OR REPLACE VIEW %s AS\n%s;\n", qualified, body)
+	}
+	result.Warnings = []string{"view query copied without translation; review functions, casts and quoted identifiers"}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// GenerateERDiagram은 외래 키 정보로 ER 다이어그램(Mermaid, Graphviz DOT)을 만듭니다.
+//
+// options.Tables가 비어 있으면 스키마 전체를 그립니다.
+// 테이블을 지정하면 그 테이블에서 외래 키를 따라 options.Hops 단계까지의 이웃만 읽습니다
+// (스키마 전체를 읽지 않으므로 테이블이 많은 DB에서도 빠릅니다).
+func (s *databaseService) GenerateERDiagram(ctx context.Context, dbID string, schema string, options domain.ERDiagramOptions) (*domain.ERDiagram, error) {
+	if options.Hops < 0 {
+		return nil, fmt.Errorf("hops must not be negative")
+	}
+	if options.Format == "" {
+		options.Format = domain.DiagramMermaid
+	}
+
+	var snapshot *domain.SchemaSnapshot
+	var err error
+
+	if len(options.Tables) == 0 {
+		snapshot, err = s.loadSchemaSnapshot(ctx, dbID, schema)
+	} else {
+		snapshot, err = s.loadNeighbourhood(ctx, dbID, schema, options.Tables, options.Hops)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	diagram := &domain.ERDiagram{
+		DatabaseID: dbID,
+		Schema:     snapshot.Schema,
+		Format:     options.Format,
+		Tables:     make([]string, 0, len(snapshot.Tables)),
+	}
+	for _, t := range snapshot.Tables {
+		diagram.Tables = append(diagram.Tables, t.Name)
+	}
+	diagram.Diagram, diagram.Relationships = domain.RenderERDiagram(snapshot.Tables, options.Format)
+
+	return diagram, nil
+}
+
+// loadNeighbourhood는 지정한 테이블과 외래 키로 hops 단계 안에 연결된 테이블을 읽습니다 (BFS).
+//
+// 참조하는 쪽(ForeignKeys)과 참조되는 쪽(ReferencedBy)을 모두 따라가며,
+// 다른 스키마의 테이블은 따라가지 않습니다.
+func (s *databaseService) loadNeighbourhood(ctx context.Context, dbID string, schema string, tables []string, hops int) (*domain.SchemaSnapshot, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// 지정한 테이블은 lookupColumns로 카탈로그 이름을 찾습니다 (없으면 ErrTableNotFound).
+	// 이웃은 메타데이터가 알려준 카탈로그 이름을 그대로 씁니다.
+	type pending struct {
+		name  string
+		depth int
+	}
+	var queue []pending
+	for _, table := range tables {
+		queue = append(queue, pending{name: table})
+	}
+
+	snapshot := &domain.SchemaSnapshot{
+		DatabaseID:   dbID,
+		DatabaseType: db.Type,
+		Schema:       domain.DisplayIdentifier(db.Type, schema),
+	}
+	visited := make(map[string]bool)
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		tableName, columns, err := s.lookupColumns(ctx, db, schema, next.name)
+		if err != nil {
+			return nil, err
+		}
+		if visited[tableName] {
+			continue
+		}
+		visited[tableName] = true
+
+		metadata, err := s.tableMetadata(ctx, dbID, schema, tableName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata of %s: %w", tableName, err)
+		}
+		if schema == "" {
+			// 현재 스키마를 썼으면 메타데이터가 알려준 실제 스키마로 고정합니다.
+			schema = metadata.Schema
+			snapshot.Schema = domain.DisplayIdentifier(db.Type, schema)
+		}
+
+		if next.depth < hops {
+			for _, fk := range metadata.ForeignKeys {
+				if fk.RefSchema == metadata.Schema && !visited[fk.RefTable] {
+					queue = append(queue, pending{name: fk.RefTable, depth: next.depth + 1})
+				}
+			}
+			for _, fk := range metadata.ReferencedBy {
+				if fk.Schema == metadata.Schema && !visited[fk.Table] {
+					queue = append(queue, pending{name: fk.Table, depth: next.depth + 1})
+				}
+			}
+		}
+
+		for i := range columns {
+			columns[i].Name = domain.DisplayIdentifier(db.Type, columns[i].Name)
+		}
+		displayTableMetadata(db.Type, metadata)
+
+		snapshot.Tables = append(snapshot.Tables, domain.TableSnapshot{
+			Name:     metadata.Table,
+			Columns:  columns,
+			Metadata: metadata,
+		})
+	}
+
+	snapshot.SortTables()
+	return snapshot, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// ExecuteFederatedQuery는 여러 DB의 서브 쿼리 결과를 임베디드 엔진에 적재하고
+// 그 위에서 최종 SQL을 실행합니다.
+func (s *databaseService) ExecuteFederatedQuery(ctx context.Context, fq *domain.FederatedQuery) (*domain.FederatedResult, error) {
+	// ==========================================
+	// 1단계: 입력값 검증
+	// ==========================================
+
+	if s.federation == nil {
+		return nil, fmt.Errorf("%w: federation engine is not configured", domain.ErrInvalidFederation)
+	}
+
+	if err := fq.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 실행 전에 모든 소스 DB의 연결 상태를 먼저 확인합니다.
+	// 중간에 실패하면 앞서 실행한 서브 쿼리가 낭비되기 때문!
+	for _, src := range fq.Sources {
+		if !s.repo.IsConnected(ctx, src.DatabaseID) {
+			return nil, fmt.Errorf("source %q (%s): %w", src.Alias, src.DatabaseID, domain.ErrDatabaseNotConnected)
+		}
+	}
+
+	// ==========================================
+	// 2단계: 소스별 서브 쿼리 실행
+	// ==========================================
+
+	limits := fq.Limits.Clamp(s.federation.Limits())
+
+	tables := make([]domain.FederatedTable, 0, len(fq.Sources))
+	for _, src := range fq.Sources {
+		db, err := s.findDatabase(ctx, src.DatabaseID)
+		if err != nil {
+			return nil, fmt.Errorf("source %q (%s): %w", src.Alias, src.DatabaseID, err)
+		}
+
+		// 한도보다 1개 더 가져와서 넘었는지 확인합니다.
+		// 전부 가져온 뒤에 세면 큰 소스 하나로도 메모리가 바닥날 수 있기 때문!
+		query := domain.FederatedSourceQuery(db.Type, src.Query, limits.MaxRowsPerSource)
+		result, err := s.repo.ExecuteQuery(ctx, src.DatabaseID, query)
+		if err != nil {
+			return nil, fmt.Errorf("source %q (%s) failed: %w", src.Alias, src.DatabaseID, err)
+		}
+
+		// 엔진에서도 확인하지만, 다음 소스를 실행하기 전에 빨리 실패시킵니다.
+		if result.RowCount() > limits.MaxRowsPerSource {
+			return nil, fmt.Errorf("%w: source %q returned more than %d rows",
+				domain.ErrFederationLimitExceeded, src.Alias, limits.MaxRowsPerSource)
+		}
+
+		tables = append(tables, domain.FederatedTable{
+			Source: src,
+			Result: result,
+		})
+	}
+
+	// ==========================================
+	// 3단계: 임베디드 엔진에서 최종 SQL 실행
+	// ==========================================
+
+	result, err := s.federation.Execute(ctx, tables, fq.Query, limits)
+	if err != nil {
+		return nil, fmt.Errorf("federated query failed: %w", err)
+	}
+
+	return &domain.FederatedResult{
+		Result:  result,
+		Sources: tables,
+	}, nil
+}
+
+// findDatabase는 연결된 DB 중에서 dbID에 해당하는 것을 찾습니다.
+// GetDatabaseInfo와 달리 비밀번호를 마스킹하지 않으므로 내부용으로만 사용합니다.
+func (s *databaseService) findDatabase(ctx context.Context, dbID string) (*domain.Database, error) {
+	databases, err := s.repo.ListConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, db := range databases {
+		if db.ID == dbID {
+			return db, nil
+		}
+	}
+
+	return nil, domain.ErrDatabaseNotFound
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"space/internal/domain"
+)
+
+// checkGuard는 DB에 설정된 비용 가드로 쿼리를 검사합니다.
+//
+// 가드가 없거나, EXPLAIN 할 수 없는 문장(DDL 등)이면 그냥 통과합니다.
+// EXPLAIN 자체가 실패하면 (권한 부족, PLAN_TABLE 없음 등) 로그만 남기고 통과시킵니다.
+// 가드 때문에 정상 쿼리까지 막히는 것을 피하기 위해서입니다.
+func (s *databaseService) checkGuard(ctx context.Context, dbID string, query string, opts domain.QueryOptions) error {
+	db, err := s.findDatabase(ctx, dbID)
+	if err != nil || db.Guard == nil {
+		return nil
+	}
+	guard := db.Guard
+
+	// 확인이 끝난 요청은 confirm 가드를 건너뜁니다 (reject 가드는 항상 검사).
+	if opts.Confirmed && guard.Action == domain.GuardConfirm {
+		return nil
+	}
+
+	if !domain.ClassifyStatement(query).IsExplainable() {
+		return nil
+	}
+
+	// analyze=false: 실행하지 않고 추정값만 봅니다.
+	plan, err := s.repo.Explain(ctx, dbID, query, false)
+	if err != nil {
+		log.Printf("query guard skipped for %s: %v", dbID, err)
+		return nil
+	}
+
+	violations := guard.Check(plan)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &domain.QueryGuardError{
+		Action:        guard.Action,
+		TotalCost:     plan.TotalCost(),
+		EstimatedRows: plan.EstimatedRows(),
+		Violations:    violations,
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"space/internal/domain"
+)
+
+// 메타데이터 캐시
+//
+// 스키마 브라우저의 조회(스키마/테이블/컬럼 목록, 테이블 메타데이터, 객체, DDL)는
+// 아래 함수들을 거쳐서 캐시에 있으면 DB를 조회하지 않습니다.
+//
+// 캐시에는 Repository가 돌려준 원본을 두고 항상 복사본을 반환합니다.
+// 호출하는 쪽이 DisplayIdentifier로 이름을 고쳐 쓰기 때문입니다 (원본을 고치면 다음 요청에 섞임).
+//
+// 무효화:
+//   - TTL이 지나면 자동으로 (다른 도구로 바꾼 스키마도 결국 반영)
+//   - ExecuteQuery로 DDL(CREATE, ALTER, DROP, COMMENT ...)을 실행하면 그 DB만
+//   - 연결을 끊거나 다시 등록하면 그 DB만
+//   - RefreshMetadata로 직접
+
+// cachedMetadata는 key의 값을 캐시에서 꺼내거나 load로 읽어서 캐시에 넣습니다.
+// 에러는 캐시하지 않습니다.
+func cachedMetadata[T any](s *databaseService, key domain.MetadataKey, clone func(T) T, load func() (T, error)) (T, error) {
+	if s.metadata == nil {
+		return load()
+	}
+
+	if value, ok := s.metadata.Get(key); ok {
+		if cached, ok := value.(T); ok {
+			return clone(cached), nil
+		}
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	s.metadata.Set(key, value)
+	return clone(value), nil
+}
+
+// schemas는 스키마 목록을 반환합니다 (캐시 사용).
+func (s *databaseService) schemas(ctx context.Context, dbID string) ([]string, error) {
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataSchemas}
+	return cachedMetadata(s, key, slices.Clone, func() ([]string, error) {
+		return s.repo.GetSchemas(ctx, dbID)
+	})
+}
+
+// tables는 스키마의 테이블/뷰 목록을 반환합니다 (캐시 사용).
+func (s *databaseService) tables(ctx context.Context, dbID string, schema string) ([]domain.TableInfo, error) {
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataTables, Schema: schema}
+	return cachedMetadata(s, key, slices.Clone, func() ([]domain.TableInfo, error) {
+		return s.repo.GetTables(ctx, dbID, schema)
+	})
+}
+
+// columns는 테이블의 컬럼 목록을 반환합니다 (캐시 사용).
+// 없는 테이블(빈 목록)도 캐시해서 lookupColumns의 대소문자 재시도가 매번 DB에 가지 않게 합니다.
+func (s *databaseService) columns(ctx context.Context, dbID string, schema string, tableName string) ([]domain.ColumnInfo, error) {
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataColumns, Schema: schema, Name: tableName}
+	return cachedMetadata(s, key, slices.Clone, func() ([]domain.ColumnInfo, error) {
+		return s.repo.GetColumns(ctx, dbID, schema, tableName)
+	})
+}
+
+// tableMetadata는 테이블의 인덱스/제약조건/외래 키를 반환합니다 (캐시 사용).
+func (s *databaseService) tableMetadata(ctx context.Context, dbID string, schema string, tableName string) (*domain.TableMetadata, error) {
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataTableMetadata, Schema: schema, Name: tableName}
+	return cachedMetadata(s, key, (*domain.TableMetadata).Clone, func() (*domain.TableMetadata, error) {
+		return s.repo.GetTableMetadata(ctx, dbID, schema, tableName)
+	})
+}
+
+// objects는 스키마 객체 목록을 반환합니다 (캐시 사용).
+func (s *databaseService) objects(ctx context.Context, dbID string, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error) {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataObjects, Schema: schema, Object: strings.Join(names, ",")}
+	return cachedMetadata(s, key, slices.Clone, func() ([]domain.DatabaseObject, error) {
+		return s.repo.ListObjects(ctx, dbID, schema, types)
+	})
+}
+
+// object는 객체 하나의 상세 정보를 반환합니다 (캐시 사용).
+func (s *databaseService) object(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataObject, Schema: schema, Name: name, Object: string(objectType)}
+	clone := func(o *domain.DatabaseObject) *domain.DatabaseObject {
+		copied := *o
+		return &copied
+	}
+	return cachedMetadata(s, key, clone, func() (*domain.DatabaseObject, error) {
+		return s.repo.GetObject(ctx, dbID, schema, objectType, name)
+	})
+}
+
+// ddl은 객체의 원본 DDL을 반환합니다 (캐시 사용).
+func (s *databaseService) ddl(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (string, error) {
+	key := domain.MetadataKey{DatabaseID: dbID, Kind: domain.MetadataDDL, Schema: schema, Name: name, Object: string(objectType)}
+	same := func(ddl string) string { return ddl }
+	return cachedMetadata(s, key, same, func() (string, error) {
+		return s.repo.GetDDL(ctx, dbID, schema, objectType, name)
+	})
+}
+
+// invalidateMetadata는 DB 하나의 메타데이터 캐시를 비우고 지운 개수를 반환합니다.
+func (s *databaseService) invalidateMetadata(dbID string) int {
+	if s.metadata == nil {
+		return 0
+	}
+	return s.metadata.InvalidateDatabase(dbID)
+}
+
+// RefreshMetadata는 DB의 메타데이터 캐시를 비우고 메타데이터 인덱스를 다시 읽습니다.
+// 다른 도구로 스키마를 바꾼 뒤 TTL을 기다리지 않고 반영할 때 씁니다.
+func (s *databaseService) RefreshMetadata(ctx context.Context, dbID string) (*domain.MetadataRefresh, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh := &domain.MetadataRefresh{
+		DatabaseID:  dbID,
+		Invalidated: s.invalidateMetadata(dbID),
+	}
+
+	// 검색/자동완성이 첫 요청에서 기다리지 않도록 인덱스는 바로 다시 채웁니다.
+	catalog, err := s.databaseCatalog(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	refresh.Tables = len(catalog.Tables)
+	refresh.Columns = catalog.ColumnCount()
+	refresh.LoadedAt = catalog.LoadedAt
+
+	return refresh, nil
+}
+
+// InvalidateMetadata는 메타데이터 캐시를 비웁니다 (dbID가 비어 있으면 전체).
+func (s *databaseService) InvalidateMetadata(ctx context.Context, dbID string) (int, error) {
+	if s.metadata == nil {
+		return 0, nil
+	}
+
+	if dbID == "" {
+		return s.metadata.InvalidateAll(), nil
+	}
+
+	return s.metadata.InvalidateDatabase(dbID), nil
+}
+
+// MetadataCacheStats는 메타데이터 캐시의 현재 상태를 반환합니다.
+func (s *databaseService) MetadataCacheStats(ctx context.Context) domain.CacheStats {
+	if s.metadata == nil {
+		return domain.CacheStats{}
+	}
+	return s.metadata.Stats()
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// ListObjects는 스키마의 뷰, 함수, 트리거 같은 객체 목록을 반환합니다.
+func (s *databaseService) ListObjects(ctx context.Context, dbID string, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error) {
+	for _, t := range types {
+		if !t.IsValid() {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidObjectType, t)
+		}
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := s.objects(ctx, dbID, schema, types)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	for i := range objects {
+		displayObject(db.Type, &objects[i])
+	}
+
+	return objects, nil
+}
+
+// GetObject는 객체 하나의 정의(소스)를 반환합니다.
+// 이름은 GetColumns처럼 입력 그대로 먼저 찾고, 없으면 DB 기본 대소문자로 바꿔서 다시 찾습니다.
+func (s *databaseService) GetObject(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error) {
+	if !objectType.IsValid() {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidObjectType, objectType)
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("object name is required")
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := s.object(ctx, dbID, schema, objectType, name)
+	if errors.Is(err, domain.ErrObjectNotFound) {
+		if folded := domain.FoldIdentifier(db.Type, name); folded != name {
+			object, err = s.object(ctx, dbID, schema, objectType, folded)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	displayObject(db.Type, object)
+	return object, nil
+}
+
+// displayObject는 객체의 이름들을 화면 표시용으로 정규화합니다.
+func displayObject(dbType domain.DatabaseType, object *domain.DatabaseObject) {
+	object.Schema = domain.DisplayIdentifier(dbType, object.Schema)
+	object.Name = domain.DisplayIdentifier(dbType, object.Name)
+	if object.Table != "" {
+		object.Table = domain.DisplayIdentifier(dbType, object.Table)
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"space/internal/domain"
+)
+
+// ProfileColumns는 테이블 또는 쿼리 결과의 컬럼별 데이터 프로파일을 만듭니다.
+//
+// 순서:
+//  1. 프로파일링할 컬럼과 타입 확인 (테이블은 카탈로그, 쿼리는 row 없이 한 번 실행)
+//  2. 집계 쿼리 한 번으로 row 수, NULL, 고유값, 최소/최대, 평균/표준편차, 길이 통계
+//  3. 문자열 컬럼마다 길이 분포 쿼리
+//  4. 컬럼마다 최빈값 쿼리
+//
+// 표본을 쓰면 모든 쿼리가 같은 시드를 써서 같은 표본을 봅니다.
+// 대상 row를 여러 번 읽으므로 큰 테이블은 표본(SamplePercent)이나 컬럼 지정을 권장합니다.
+func (s *databaseService) ProfileColumns(ctx context.Context, dbID string, req domain.ProfileRequest) (*domain.TableProfile, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if domain.DialectOf(db.Type) == "" {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnsupportedDialect, db.Type)
+	}
+
+	start := time.Now()
+	profile := &domain.TableProfile{
+		DatabaseID: dbID,
+		Query:      req.Query,
+	}
+
+	var source string
+	var columns []domain.ProfileColumn
+
+	if req.Table != "" {
+		source, columns, err = s.profileTable(ctx, db, &req, profile)
+	} else {
+		source, columns, err = s.profileQuery(ctx, db, req)
+		profile.Queries++
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err = selectProfileColumns(db.Type, columns, req.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate, approx := domain.ProfileAggregateQuery(db.Type, source, columns, req.ApproxDistinct)
+	result, err := s.profileExecute(ctx, dbID, aggregate, profile)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Rows) == 0 {
+		return nil, fmt.Errorf("profile aggregate returned no rows")
+	}
+
+	profile.RowCount, profile.Columns = domain.ApplyProfileAggregates(result.Rows[0], columns, approx)
+
+	for i, col := range columns {
+		p := &profile.Columns[i]
+
+		if p.MinLength != nil && p.MaxLength != nil {
+			width := domain.LengthBucketWidth(*p.MinLength, *p.MaxLength, req.LengthBuckets)
+			query := domain.ProfileLengthQuery(db.Type, source, col.Name, *p.MinLength, width)
+
+			lengths, err := s.profileExecute(ctx, dbID, query, profile)
+			if err != nil {
+				return nil, err
+			}
+			p.LengthHistogram = domain.ApplyLengthHistogram(lengths, *p.MinLength, *p.MaxLength, width)
+		}
+
+		if col.Category != domain.ProfileLOB && p.NullCount < profile.RowCount {
+			top, err := s.profileExecute(ctx, dbID, domain.ProfileTopValuesQuery(db.Type, source, col, req.TopN), profile)
+			if err != nil {
+				return nil, err
+			}
+			p.TopValues = domain.ApplyTopValues(top, profile.RowCount)
+		}
+
+		p.Name = domain.DisplayIdentifier(db.Type, p.Name)
+	}
+
+	profile.ExecutionTime = time.Since(start)
+	return profile, nil
+}
+
+// profileTable은 테이블의 컬럼을 카탈로그에서 찾고, 표본 설정을 반영한 FROM 절을 만듭니다.
+func (s *databaseService) profileTable(ctx context.Context, db *domain.Database, req *domain.ProfileRequest, profile *domain.TableProfile) (string, []domain.ProfileColumn, error) {
+	schema, err := s.resolveSchema(ctx, db, req.Schema)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tableName, infos, err := s.lookupColumns(ctx, db, schema, req.Table)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if req.SamplePercent > 0 {
+		if req.Seed == 0 {
+			req.Seed = rand.Int64N(math.MaxInt32) + 1
+		}
+		profile.Sampled = true
+		profile.SamplePercent = req.SamplePercent
+		profile.Seed = req.Seed
+	}
+
+	source, err := domain.ProfileTableSource(db.Type, schema, tableName, req.SamplePercent, req.Seed)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if schema != "" {
+		profile.Schema = domain.DisplayIdentifier(db.Type, schema)
+	}
+	profile.Table = domain.DisplayIdentifier(db.Type, tableName)
+
+	columns := make([]domain.ProfileColumn, len(infos))
+	for i, info := range infos {
+		columns[i] = domain.ProfileColumn{
+			Name:     info.Name,
+			DataType: info.DataType,
+			Category: domain.ProfileCategoryOf(info.BaseType),
+		}
+	}
+	return source, columns, nil
+}
+
+// profileQuery는 쿼리를 인라인 뷰로 감싸고, row 없이 한 번 실행해서 결과 컬럼과 타입을 얻습니다.
+func (s *databaseService) profileQuery(ctx context.Context, db *domain.Database, req domain.ProfileRequest) (string, []domain.ProfileColumn, error) {
+	source := domain.ProfileQuerySource(req.Query)
+
+	shape, err := s.repo.ExecuteQuery(ctx, db.ID, domain.ProfileShapeQuery(source))
+	if err != nil {
+		return "", nil, fmt.Errorf("query execution failed: %w", err)
+	}
+
+	columns := make([]domain.ProfileColumn, len(shape.Columns))
+	for i, name := range shape.Columns {
+		typeName := ""
+		if i < len(shape.ColumnTypes) {
+			typeName = shape.ColumnTypes[i]
+		}
+		columns[i] = domain.ProfileColumn{
+			Name:     name,
+			DataType: typeName,
+			Category: domain.ProfileCategoryOf(typeName),
+		}
+	}
+	return source, columns, nil
+}
+
+// profileExecute는 프로파일링 쿼리 하나를 실행하고 실행 횟수를 셉니다.
+func (s *databaseService) profileExecute(ctx context.Context, dbID string, query string, profile *domain.TableProfile) (*domain.QueryResult, error) {
+	profile.Queries++
+
+	result, err := s.repo.ExecuteQuery(ctx, dbID, query)
+	if err != nil {
+		return nil, fmt.Errorf("profile query failed: %w", err)
+	}
+	return result, nil
+}
+
+// selectProfileColumns는 요청한 컬럼만 요청 순서대로 남깁니다 (비어 있으면 전체).
+// 이름은 GetColumns와 같은 규칙으로 찾습니다 (정확한 이름 → DB 기본 대소문자).
+func selectProfileColumns(dbType domain.DatabaseType, columns []domain.ProfileColumn, names []string) ([]domain.ProfileColumn, error) {
+	if len(names) == 0 {
+		return columns, nil
+	}
+
+	available := make([]string, len(columns))
+	for i, col := range columns {
+		available[i] = col.Name
+	}
+
+	selected := make([]domain.ProfileColumn, 0, len(names))
+	for _, name := range names {
+		match, ok := matchIdentifier(dbType, available, name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrColumnNotFound, name)
+		}
+		for _, col := range columns {
+			if col.Name == match {
+				selected = append(selected, col)
+				break
+			}
+		}
+	}
+	return selected, nil
+}
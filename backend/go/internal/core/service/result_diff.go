@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// DiffQueryResults는 두 DB(또는 두 쿼리)의 결과를 키 컬럼 기준으로 비교합니다.
+// 마이그레이션 검증처럼 "같은 쿼리를 양쪽에서 돌려서 결과가 같은지" 확인할 때 사용합니다.
+func (s *databaseService) DiffQueryResults(ctx context.Context, req *domain.ResultDiffRequest) (*domain.ResultDiff, error) {
+	// ==========================================
+	// 1단계: 입력값 검증
+	// ==========================================
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	for _, side := range []domain.DiffSide{req.Source, req.Target} {
+		if !s.repo.IsConnected(ctx, side.DatabaseID) {
+			return nil, fmt.Errorf("%s: %w", side.DatabaseID, domain.ErrDatabaseNotConnected)
+		}
+	}
+
+	// 양쪽 모두 실행하기 전에 비용 가드를 확인합니다.
+	// 한쪽만 실행하고 다른 쪽에서 막히면 앞의 실행이 낭비되기 때문!
+	opts := domain.QueryOptions{Confirmed: req.Confirmed}
+	if err := s.checkGuard(ctx, req.Source.DatabaseID, req.Source.Query, opts); err != nil {
+		return nil, fmt.Errorf("source query: %w", err)
+	}
+	if err := s.checkGuard(ctx, req.Target.DatabaseID, req.Target.Query, opts); err != nil {
+		return nil, fmt.Errorf("target query: %w", err)
+	}
+
+	// ==========================================
+	// 2단계: 양쪽 쿼리 실행
+	// ==========================================
+
+	source, err := s.fetchDiffSide(ctx, req.Source, req.MaxRowsPerSide)
+	if err != nil {
+		return nil, fmt.Errorf("source query: %w", err)
+	}
+
+	target, err := s.fetchDiffSide(ctx, req.Target, req.MaxRowsPerSide)
+	if err != nil {
+		return nil, fmt.Errorf("target query: %w", err)
+	}
+
+	// ==========================================
+	// 3단계: 비교 (순수 도메인 로직)
+	// ==========================================
+
+	return domain.DiffResults(source, target, req)
+}
+
+// fetchDiffSide는 한쪽 쿼리를 row 수 한도를 씌워서 실행합니다.
+//
+// 페더레이션 소스처럼 한도보다 1개 더 가져와서 넘었는지 확인합니다.
+// 전부 가져온 뒤에 세면 큰 결과 하나로도 메모리가 바닥날 수 있기 때문!
+// 잘린 결과로 비교하면 "없는 row"가 차이로 잡히므로, 넘으면 비교하지 않고 실패합니다.
+func (s *databaseService) fetchDiffSide(ctx context.Context, side domain.DiffSide, maxRows int) (*domain.QueryResult, error) {
+	db, err := s.findDatabase(ctx, side.DatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", side.DatabaseID, err)
+	}
+
+	query := domain.FederatedSourceQuery(db.Type, side.Query, maxRows)
+	result, err := s.repo.ExecuteQuery(ctx, side.DatabaseID, query)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+
+	if result.RowCount() > maxRows {
+		return nil, fmt.Errorf("%w: %s returned more than %d rows (narrow the query with WHERE)",
+			domain.ErrDiffLimitExceeded, side.DatabaseID, maxRows)
+	}
+
+	return result, nil
+}
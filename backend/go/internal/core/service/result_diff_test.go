@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"space/internal/domain"
+	"space/internal/ports/output"
+)
+
+// diffRepository는 DB마다 정해진 개수의 row를 돌려주는 저장소입니다.
+// 실행한 쿼리를 기록해서 row 수 제한이 쿼리에 들어갔는지 확인합니다.
+type diffRepository struct {
+	output.DatabaseRepository
+	rows     map[string]int
+	executed []string
+}
+
+func (r *diffRepository) IsConnected(ctx context.Context, dbID string) bool {
+	_, ok := r.rows[dbID]
+	return ok
+}
+
+func (r *diffRepository) ListConnections(ctx context.Context) ([]*domain.Database, error) {
+	return []*domain.Database{
+		{ID: "pg", Type: domain.PostgreSQL},
+		{ID: "ora", Type: domain.Oracle19c},
+	}, nil
+}
+
+func (r *diffRepository) ExecuteQuery(ctx context.Context, dbID string, query string, args ...interface{}) (*domain.QueryResult, error) {
+	r.executed = append(r.executed, query)
+
+	result := &domain.QueryResult{Columns: []string{"id"}}
+	for i := 0; i < r.rows[dbID]; i++ {
+		result.Rows = append(result.Rows, map[string]interface{}{"id": int64(i)})
+	}
+	return result, nil
+}
+
+func TestDiffQueryResultsRowLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceRows int
+		targetRows int
+		maxRows    int
+		wantErr    bool
+		executed   int
+	}{
+		{"within limit", 3, 3, 3, false, 2},
+		{"source over limit", 4, 3, 3, true, 1},
+		{"target over limit", 3, 4, 3, true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &diffRepository{rows: map[string]int{"pg": tt.sourceRows, "ora": tt.targetRows}}
+			svc := &databaseService{repo: repo}
+
+			_, err := svc.DiffQueryResults(context.Background(), &domain.ResultDiffRequest{
+				Source:         domain.DiffSide{DatabaseID: "pg", Query: "SELECT id FROM users"},
+				Target:         domain.DiffSide{DatabaseID: "ora", Query: "SELECT id FROM users"},
+				KeyColumns:     []string{"id"},
+				MaxRowsPerSide: tt.maxRows,
+			})
+
+			if tt.wantErr != errors.Is(err, domain.ErrDiffLimitExceeded) {
+				t.Errorf("DiffQueryResults() error = %v, want limit error = %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("DiffQueryResults() error = %v", err)
+			}
+
+			// 한도를 넘은 쪽에서 바로 멈추고, 실행한 쿼리에는 한도 + 1 제한이 들어갑니다.
+			if len(repo.executed) != tt.executed {
+				t.Fatalf("executed %d queries, want %d", len(repo.executed), tt.executed)
+			}
+			if !strings.HasSuffix(repo.executed[0], "LIMIT 4") {
+				t.Errorf("source query = %q, want LIMIT 4", repo.executed[0])
+			}
+			if len(repo.executed) > 1 && !strings.HasSuffix(repo.executed[1], "FETCH FIRST 4 ROWS ONLY") {
+				t.Errorf("target query = %q, want FETCH FIRST 4 ROWS ONLY", repo.executed[1])
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"space/internal/domain"
+)
+
+// ChangeRow는 기본 키로 row 하나를 추가/수정/삭제하고 변경 이력을 남깁니다.
+//
+// 순서:
+//  1. 쓰기 권한 확인 (DB 설정의 rows 허용 사용자)
+//  2. 요청의 컬럼 이름을 카탈로그 컬럼으로 해석하고 값을 컬럼 타입에 맞게 바꿈
+//  3. 한 트랜잭션 안에서
+//     - (update, delete) SELECT ... FOR UPDATE로 바꾸기 전 row를 잠가서 읽음 (정확히 1개)
+//     - INSERT/UPDATE/DELETE 실행 (정확히 1개 row에 영향을 줘야 함)
+//     - (insert, update) 바꾼 뒤 row를 읽음
+//  4. 변경 이력 저장 (Before/After는 row를 JSON으로)
+//
+// 영향받은 row 수가 1이 아니면 트랜잭션 전체를 롤백하므로
+// 기본 키가 잘못되어 여러 row가 바뀌는 일은 생기지 않습니다.
+func (s *databaseService) ChangeRow(ctx context.Context, dbID string, change domain.RowChange) (*domain.RowChangeResult, error) {
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.CheckWrite(domain.WriteRows, change.Author); err != nil {
+		return nil, err
+	}
+
+	schema, err := s.resolveSchema(ctx, db, change.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	tableName, columns, err := s.lookupColumns(ctx, db, schema, change.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	keyColumns := domain.PrimaryKeyColumns(columns)
+	if len(keyColumns) == 0 && change.Operation != domain.RowInsert {
+		return nil, fmt.Errorf("%w: %s", domain.ErrNoPrimaryKey, domain.DisplayIdentifier(db.Type, tableName))
+	}
+
+	values, err := rowValues(db.Type, tableName, columns, change.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	var key []domain.ColumnValue
+	if change.Operation != domain.RowInsert {
+		key, err = rowKey(db.Type, tableName, columns, keyColumns, change.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &domain.RowChangeResult{
+		Table:     domain.DisplayIdentifier(db.Type, tableName),
+		Operation: change.Operation,
+	}
+	if schema != "" {
+		result.Schema = domain.DisplayIdentifier(db.Type, schema)
+	}
+
+	var statements []domain.TxStatement
+	if change.Operation != domain.RowInsert {
+		query, args, err := domain.RowImageQuery(db.Type, schema, tableName, columns, key, true)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, domain.TxStatement{SQL: query, Args: args, Query: true, ExpectRows: 1})
+	}
+
+	// 바꾼 뒤 row를 찾을 기본 키 (update에서 기본 키를 바꾸면 새 값으로 찾음)
+	afterKey := key
+	var dml domain.TxStatement
+	switch change.Operation {
+	case domain.RowInsert:
+		statement, args, err := domain.RowInsertStatement(db.Type, schema, tableName, values, columns)
+		if err != nil {
+			return nil, err
+		}
+		dml = domain.TxStatement{SQL: statement, Args: args, Query: domain.DialectOf(db.Type) == domain.DialectPostgres, ExpectRows: 1}
+
+		afterKey = nil
+		if !dml.Query && len(keyColumns) > 0 {
+			afterKey = insertedKey(keyColumns, values)
+		}
+	case domain.RowUpdate:
+		statement, args, err := domain.RowUpdateStatement(db.Type, schema, tableName, values, key)
+		if err != nil {
+			return nil, err
+		}
+		dml = domain.TxStatement{SQL: statement, Args: args, ExpectRows: 1}
+		afterKey = updatedKey(key, values)
+	case domain.RowDelete:
+		statement, args, err := domain.RowDeleteStatement(db.Type, schema, tableName, key)
+		if err != nil {
+			return nil, err
+		}
+		dml = domain.TxStatement{SQL: statement, Args: args, ExpectRows: 1}
+	}
+	statements = append(statements, dml)
+	result.Statement = dml.SQL
+
+	if change.Operation != domain.RowDelete && !dml.Query {
+		if afterKey != nil {
+			query, args, err := domain.RowImageQuery(db.Type, schema, tableName, columns, afterKey, false)
+			if err != nil {
+				return nil, err
+			}
+			statements = append(statements, domain.TxStatement{SQL: query, Args: args, Query: true, ExpectRows: 1})
+		} else {
+			result.Warnings = append(result.Warnings,
+				"the inserted row could not be read back because the database generated part of its primary key")
+		}
+	}
+
+	txResults, err := s.repo.ExecuteTransaction(ctx, dbID, statements)
+	if err != nil {
+		var countErr *domain.RowCountError
+		if errors.As(err, &countErr) && countErr.Statement == 0 && countErr.Actual == 0 && change.Operation != domain.RowInsert {
+			return nil, fmt.Errorf("%w: %s", domain.ErrRowNotFound, result.Table)
+		}
+		return nil, fmt.Errorf("failed to %s row: %w", change.Operation, err)
+	}
+
+	// 커밋되었으므로 이 DB의 캐시된 SELECT 결과는 더 이상 맞지 않습니다.
+	s.invalidateResults(dbID)
+
+	for i, stmt := range statements {
+		if !stmt.Query || len(txResults[i].Rows) == 0 {
+			continue
+		}
+		image := rowImage(db.Type, columns, txResults[i].Rows[0])
+		if i == 0 && change.Operation != domain.RowInsert {
+			result.Before = image
+		} else {
+			result.After = image
+		}
+	}
+
+	switch {
+	case result.After != nil:
+		result.Key = keyImage(db.Type, keyColumns, result.After)
+	case result.Before != nil:
+		result.Key = keyImage(db.Type, keyColumns, result.Before)
+	}
+
+	entry, err := s.recordRowChange(ctx, db, schema, tableName, change, result)
+	if err != nil {
+		return nil, err
+	}
+	result.ChangeLogID = entry.ID
+
+	return result, nil
+}
+
+// recordRowChange는 row 변경을 변경 이력에 남깁니다.
+// 데이터는 이미 커밋되었으므로 저장이 실패하면 그 사실을 에러에 적어서 반환합니다.
+func (s *databaseService) recordRowChange(ctx context.Context, db *domain.Database, schema, tableName string, change domain.RowChange, result *domain.RowChangeResult) (*domain.ChangeLogEntry, error) {
+	entry := &domain.ChangeLogEntry{
+		DatabaseID: db.ID,
+		Schema:     result.Schema,
+		Table:      result.Table,
+		Kind:       change.Operation.ChangeKind(),
+		Author:     change.Author,
+		Statement:  result.Statement,
+		ChangedAt:  time.Now(),
+	}
+
+	if schema == "" {
+		entry.Schema = s.changeLogSchema(ctx, db, tableName)
+	}
+
+	var err error
+	if entry.Before, err = rowJSON(result.Before); err != nil {
+		return nil, fmt.Errorf("row was changed but recording the change log failed: %w", err)
+	}
+	if entry.After, err = rowJSON(result.After); err != nil {
+		return nil, fmt.Errorf("row was changed but recording the change log failed: %w", err)
+	}
+
+	if err := s.changes.Append(ctx, entry); err != nil {
+		return nil, fmt.Errorf("row was changed but recording the change log failed: %w", err)
+	}
+	return entry, nil
+}
+
+// changeLogSchema는 기본 스키마를 쓴 변경의 실제 스키마 이름을 찾습니다.
+// 이력은 나중에 스키마별로 조회하므로 빈 스키마 대신 실제 이름을 남깁니다 (찾지 못하면 빈 문자열).
+func (s *databaseService) changeLogSchema(ctx context.Context, db *domain.Database, tableName string) string {
+	metadata, err := s.tableMetadata(ctx, db.ID, "", tableName)
+	if err != nil {
+		return ""
+	}
+	return domain.DisplayIdentifier(db.Type, metadata.Schema)
+}
+
+// rowValues는 요청의 값을 카탈로그 컬럼과 짝지어 테이블 컬럼 순서로 반환합니다.
+// GENERATED ALWAYS 컬럼은 DB만 값을 만들 수 있으므로 값을 넣으면 에러입니다.
+func rowValues(dbType domain.DatabaseType, tableName string, columns []domain.ColumnInfo, values map[string]interface{}) ([]domain.ColumnValue, error) {
+	result, err := matchColumnValues(dbType, tableName, columns, values)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range result {
+		if v.Column.Identity != nil && v.Column.Identity.Generation == domain.IdentityAlways {
+			return nil, fmt.Errorf("%w: column %s is GENERATED ALWAYS and cannot be set", domain.ErrInvalidRowChange, domain.DisplayIdentifier(dbType, v.Column.Name))
+		}
+	}
+	return result, nil
+}
+
+// matchColumnValues는 이름 → 값 맵을 카탈로그 컬럼과 짝지어 테이블 컬럼 순서로 반환합니다.
+// 같은 컬럼을 두 번 지정하면 (예: "name"과 "NAME") 에러입니다.
+func matchColumnValues(dbType domain.DatabaseType, tableName string, columns []domain.ColumnInfo, values map[string]interface{}) ([]domain.ColumnValue, error) {
+	names := columnNames(columns)
+	position := make(map[string]int, len(columns))
+	for i, c := range columns {
+		position[c.Name] = i
+	}
+
+	matched := make(map[string]bool, len(values))
+	result := make([]domain.ColumnValue, 0, len(values))
+	for name, value := range values {
+		match, ok := matchIdentifier(dbType, names, name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s.%s", domain.ErrColumnNotFound, domain.DisplayIdentifier(dbType, tableName), name)
+		}
+		if matched[match] {
+			return nil, fmt.Errorf("%w: column %s is given more than once", domain.ErrInvalidRowChange, domain.DisplayIdentifier(dbType, match))
+		}
+		matched[match] = true
+		result = append(result, domain.ColumnValue{Column: columns[position[match]], Value: value})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return position[result[i].Column.Name] < position[result[j].Column.Name]
+	})
+	return result, nil
+}
+
+// rowKey는 요청의 기본 키를 기본 키 순서로 반환합니다.
+// 기본 키 컬럼을 모두, 기본 키 컬럼만 넣어야 row 하나를 정확히 가리킵니다.
+func rowKey(dbType domain.DatabaseType, tableName string, columns, keyColumns []domain.ColumnInfo, key map[string]interface{}) ([]domain.ColumnValue, error) {
+	values, err := matchColumnValues(dbType, tableName, columns, key)
+	if err != nil {
+		return nil, err
+	}
+
+	given := make(map[string]domain.ColumnValue, len(values))
+	for _, v := range values {
+		if !v.Column.PrimaryKey {
+			return nil, fmt.Errorf("%w: %s is not a primary key column", domain.ErrInvalidRowChange, domain.DisplayIdentifier(dbType, v.Column.Name))
+		}
+		given[v.Column.Name] = v
+	}
+
+	result := make([]domain.ColumnValue, len(keyColumns))
+	for i, c := range keyColumns {
+		v, ok := given[c.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: primary key column %s is missing", domain.ErrInvalidRowChange, domain.DisplayIdentifier(dbType, c.Name))
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// insertedKey는 INSERT 값에서 기본 키를 꺼냅니다.
+// 기본 키 값을 DB가 만드는 경우(값을 주지 않음)에는 nil을 반환합니다.
+func insertedKey(keyColumns []domain.ColumnInfo, values []domain.ColumnValue) []domain.ColumnValue {
+	key := make([]domain.ColumnValue, 0, len(keyColumns))
+	for _, c := range keyColumns {
+		found := false
+		for _, v := range values {
+			if v.Column.Name == c.Name && v.Value != nil {
+				key = append(key, v)
+				found = true
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+	return key
+}
+
+// updatedKey는 UPDATE로 기본 키 값을 바꾼 경우 새 값을 넣은 기본 키를 반환합니다.
+func updatedKey(key, values []domain.ColumnValue) []domain.ColumnValue {
+	result := make([]domain.ColumnValue, len(key))
+	copy(result, key)
+	for i, k := range result {
+		for _, v := range values {
+			if v.Column.Name == k.Column.Name {
+				result[i].Value = v.Value
+			}
+		}
+	}
+	return result
+}
+
+// rowImage는 조회한 row 하나를 DisplayIdentifier 이름의 맵으로 바꿉니다.
+// Oracle은 따옴표 없는 이름을 대문자로 돌려주므로 대소문자를 무시하고도 찾습니다.
+func rowImage(dbType domain.DatabaseType, columns []domain.ColumnInfo, row map[string]interface{}) map[string]interface{} {
+	names := columnNames(columns)
+	normalized := make(map[string]interface{}, len(row))
+	for name, value := range row {
+		if match, ok := matchIdentifier(dbType, names, name); ok {
+			normalized[match] = value
+		} else {
+			normalized[name] = value
+		}
+	}
+
+	_, _, display := displayRows(dbType, columns, []map[string]interface{}{normalized})
+	return display[0]
+}
+
+// keyImage는 row 이미지에서 기본 키 컬럼만 꺼냅니다.
+func keyImage(dbType domain.DatabaseType, keyColumns []domain.ColumnInfo, image map[string]interface{}) map[string]interface{} {
+	if len(keyColumns) == 0 {
+		return nil
+	}
+	key := make(map[string]interface{}, len(keyColumns))
+	for _, c := range keyColumns {
+		name := domain.DisplayIdentifier(dbType, c.Name)
+		key[name] = image[name]
+	}
+	return key
+}
+
+// rowJSON은 변경 이력에 남길 row 이미지를 JSON으로 만듭니다 (row가 없으면 빈 문자열).
+func rowJSON(image map[string]interface{}) (string, error) {
+	if image == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(image)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// columnNames는 컬럼 이름 목록을 반환합니다.
+func columnNames(columns []domain.ColumnInfo) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+	return names
+}
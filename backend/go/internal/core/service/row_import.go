@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"space/internal/domain"
+)
+
+// ImportRows는 CSV/JSON Lines 데이터를 기존 테이블에 넣고 변경 이력을 남깁니다.
+//
+// 순서:
+//  1. 쓰기 권한 확인 (DB 설정의 rows 허용 사용자)
+//  2. 헤더의 이름을 카탈로그 컬럼으로 해석 (GetColumns와 같은 규칙)
+//  3. 파일을 묶음(BatchSize) 단위로 읽으면서 값을 컬럼 타입에 맞게 바꾸고
+//     저장소가 한 트랜잭션 안에서 넣음 (Postgres: COPY, Oracle: 배열 바인드)
+//  4. 커밋했으면 변경 이력 저장
+//
+// 값 변환 오류와 DB 오류(제약조건 위반 등)는 row별로 결과에 담습니다.
+// 오류 row가 MaxErrors를 넘으면 전체를 롤백하고 Aborted에 이유를 담아 반환합니다 (에러가 아님).
+func (s *databaseService) ImportRows(ctx context.Context, dbID string, req domain.ImportRequest) (*domain.ImportResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.CheckWrite(domain.WriteRows, req.Author); err != nil {
+		return nil, err
+	}
+
+	schema, err := s.resolveSchema(ctx, db, req.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	tableName, columns, err := s.lookupColumns(ctx, db, schema, req.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := domain.NewImportSource(req.Format, req.Data, req.Delimiter, req.Null)
+	if err != nil {
+		return nil, err
+	}
+
+	targets, err := importColumns(db.Type, tableName, columns, source.Header())
+	if err != nil {
+		return nil, err
+	}
+
+	var key []domain.ColumnInfo
+	if req.Upsert {
+		key, err = importKey(db.Type, tableName, columns, targets, req.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plan, err := domain.NewImportPlan(db.Type, schema, tableName, targets, key, req.Truncate)
+	if err != nil {
+		return nil, err
+	}
+	plan.DryRun = req.DryRun
+
+	result := &domain.ImportResult{
+		Table:     domain.DisplayIdentifier(db.Type, tableName),
+		Format:    req.Format,
+		Truncated: req.Truncate,
+		DryRun:    req.DryRun,
+		Statement: plan.Statement,
+	}
+	if schema != "" {
+		result.Schema = domain.DisplayIdentifier(db.Type, schema)
+	}
+	for _, c := range targets {
+		result.Columns = append(result.Columns, domain.DisplayIdentifier(db.Type, c.Name))
+	}
+	for _, c := range key {
+		result.Key = append(result.Key, domain.DisplayIdentifier(db.Type, c.Name))
+	}
+
+	report := &domain.ImportReport{MaxErrors: req.MaxErrors}
+	next := func() ([]domain.ImportRow, error) {
+		return readImportBatch(db.Type, source, targets, req.BatchSize, report)
+	}
+
+	start := time.Now()
+	err = s.repo.ImportRows(ctx, dbID, plan, next, report)
+	result.ExecutionTime = time.Since(start)
+	result.Read, result.Loaded, result.Rejected, result.Errors = report.Read, report.Loaded, report.Rejected, report.Errors
+
+	if err != nil {
+		if errors.Is(err, domain.ErrTooManyImportErrors) {
+			result.Aborted = err.Error()
+			return result, nil
+		}
+		return nil, fmt.Errorf("import failed: %w", err)
+	}
+
+	if req.DryRun {
+		return result, nil
+	}
+	result.Committed = true
+
+	// 커밋되었으므로 이 DB의 캐시된 SELECT 결과는 더 이상 맞지 않습니다.
+	s.invalidateResults(dbID)
+
+	entry, err := s.recordImport(ctx, db, schema, tableName, req.Author, result)
+	if err != nil {
+		return nil, err
+	}
+	result.ChangeLogID = entry.ID
+
+	return result, nil
+}
+
+// readImportBatch는 파일에서 row를 batchSize개까지 읽고 컬럼 타입에 맞게 바꿉니다.
+// 잘못된 row는 report에 기록하고 건너뜁니다. 파일 끝이면 빈 묶음을 반환합니다.
+func readImportBatch(dbType domain.DatabaseType, source domain.ImportSource, targets []domain.ColumnInfo, batchSize int, report *domain.ImportReport) ([]domain.ImportRow, error) {
+	batch := make([]domain.ImportRow, 0, batchSize)
+	for len(batch) < batchSize {
+		record, err := source.Next()
+		if err == io.EOF {
+			break
+		}
+
+		var rowErr *domain.ImportRowError
+		if errors.As(err, &rowErr) {
+			report.Read++
+			if err := report.Reject(*rowErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read import data: %w", err)
+		}
+
+		report.Read++
+		row, rowErr := coerceImportRecord(dbType, targets, record)
+		if rowErr != nil {
+			if err := report.Reject(*rowErr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		batch = append(batch, row)
+	}
+	return batch, nil
+}
+
+// coerceImportRecord는 파일의 값을 컬럼 타입에 맞게 바꿉니다 (domain.CoerceValue).
+func coerceImportRecord(dbType domain.DatabaseType, targets []domain.ColumnInfo, record domain.ImportRecord) (domain.ImportRow, *domain.ImportRowError) {
+	values := make([]interface{}, len(targets))
+	for i, col := range targets {
+		value, err := domain.CoerceValue(dbType, col, record.Values[i])
+		if err != nil {
+			return domain.ImportRow{}, &domain.ImportRowError{
+				Line:    record.Line,
+				Column:  domain.DisplayIdentifier(dbType, col.Name),
+				Message: err.Error(),
+			}
+		}
+		values[i] = value
+	}
+	return domain.ImportRow{Line: record.Line, Values: values}, nil
+}
+
+// importColumns는 헤더의 이름을 카탈로그 컬럼으로 해석합니다 (헤더 순서).
+// 없는 컬럼, 같은 컬럼이 두 번 나오는 헤더, GENERATED ALWAYS 컬럼은 에러입니다.
+func importColumns(dbType domain.DatabaseType, tableName string, columns []domain.ColumnInfo, header []string) ([]domain.ColumnInfo, error) {
+	names := columnNames(columns)
+	byName := make(map[string]domain.ColumnInfo, len(columns))
+	for _, c := range columns {
+		byName[c.Name] = c
+	}
+
+	seen := make(map[string]bool, len(header))
+	targets := make([]domain.ColumnInfo, len(header))
+	for i, name := range header {
+		match, ok := matchIdentifier(dbType, names, name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s.%s", domain.ErrColumnNotFound, domain.DisplayIdentifier(dbType, tableName), name)
+		}
+		if seen[match] {
+			return nil, fmt.Errorf("%w: column %s appears more than once in the header", domain.ErrInvalidImport, domain.DisplayIdentifier(dbType, match))
+		}
+		seen[match] = true
+
+		col := byName[match]
+		if col.Identity != nil && col.Identity.Generation == domain.IdentityAlways {
+			return nil, fmt.Errorf("%w: column %s is GENERATED ALWAYS and cannot be imported", domain.ErrInvalidImport, domain.DisplayIdentifier(dbType, match))
+		}
+		targets[i] = col
+	}
+	return targets, nil
+}
+
+// importKey는 upsert 키 컬럼을 정합니다 (지정하지 않으면 기본 키).
+// 키 컬럼은 파일에 있어야 같은 row를 찾을 수 있습니다.
+func importKey(dbType domain.DatabaseType, tableName string, columns, targets []domain.ColumnInfo, names []string) ([]domain.ColumnInfo, error) {
+	if len(names) == 0 {
+		for _, k := range domain.PrimaryKeyColumns(columns) {
+			names = append(names, k.Name)
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("%w: %s (set key columns for upsert)", domain.ErrNoPrimaryKey, domain.DisplayIdentifier(dbType, tableName))
+		}
+	}
+
+	targetNames := columnNames(targets)
+	key := make([]domain.ColumnInfo, 0, len(names))
+	for _, name := range names {
+		match, ok := matchIdentifier(dbType, targetNames, name)
+		if !ok {
+			return nil, fmt.Errorf("%w: upsert key column %s is not in the file", domain.ErrInvalidImport, domain.DisplayIdentifier(dbType, name))
+		}
+		if columnIndex(key, match) >= 0 {
+			continue
+		}
+		key = append(key, targets[columnIndex(targets, match)])
+	}
+	return key, nil
+}
+
+// recordImport는 가져오기를 변경 이력에 남깁니다 (After는 건수 요약 JSON).
+// 데이터는 이미 커밋되었으므로 저장이 실패하면 그 사실을 에러에 적어서 반환합니다.
+func (s *databaseService) recordImport(ctx context.Context, db *domain.Database, schema, tableName, author string, result *domain.ImportResult) (*domain.ChangeLogEntry, error) {
+	entry := &domain.ChangeLogEntry{
+		DatabaseID: db.ID,
+		Schema:     result.Schema,
+		Table:      result.Table,
+		Kind:       domain.ChangeRowImport,
+		Author:     author,
+		Statement:  result.Statement,
+		ChangedAt:  time.Now(),
+	}
+	if schema == "" {
+		entry.Schema = s.changeLogSchema(ctx, db, tableName)
+	}
+
+	summary := map[string]interface{}{
+		"format":    result.Format,
+		"read":      result.Read,
+		"loaded":    result.Loaded,
+		"rejected":  result.Rejected,
+		"truncated": result.Truncated,
+	}
+	if len(result.Key) > 0 {
+		summary["upsert_key"] = result.Key
+	}
+
+	var err error
+	if entry.After, err = rowJSON(summary); err != nil {
+		return nil, fmt.Errorf("rows were imported but recording the change log failed: %w", err)
+	}
+
+	if err := s.changes.Append(ctx, entry); err != nil {
+		return nil, fmt.Errorf("rows were imported but recording the change log failed: %w", err)
+	}
+	return entry, nil
+}
+
+// columnIndex는 이름이 name인 컬럼의 위치를 반환합니다 (없으면 -1).
+func columnIndex(columns []domain.ColumnInfo, name string) int {
+	for i, c := range columns {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
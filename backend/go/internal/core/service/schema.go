@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"space/internal/domain"
+)
+
+// GetSchemas는 스키마 브라우저에서 볼 수 있는 스키마 목록을 반환합니다.
+func (s *databaseService) GetSchemas(ctx context.Context, dbID string) ([]string, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := s.schemas(ctx, dbID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schemas: %w", err)
+	}
+
+	for i, name := range schemas {
+		schemas[i] = domain.DisplayIdentifier(db.Type, name)
+	}
+
+	return schemas, nil
+}
+
+// GetTables는 스키마의 테이블과 뷰 목록을 반환합니다.
+// 이름은 DB 종류와 상관없이 같은 모양이 되도록 정규화합니다 (DisplayIdentifier).
+func (s *databaseService) GetTables(ctx context.Context, dbID string, schema string) ([]domain.TableInfo, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.tables(ctx, dbID, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tables: %w", err)
+	}
+
+	for i := range tables {
+		tables[i].Schema = domain.DisplayIdentifier(db.Type, tables[i].Schema)
+		tables[i].Name = domain.DisplayIdentifier(db.Type, tables[i].Name)
+	}
+
+	return tables, nil
+}
+
+// GetColumns는 테이블(또는 뷰)의 컬럼 목록을 반환합니다.
+//
+// 테이블 이름은 먼저 입력 그대로 찾고, 없으면 DB 기본 대소문자로 바꿔서 다시 찾습니다.
+// 그래서 GetTables가 돌려준 이름("students")을 그대로 넘기면
+// Oracle(STUDENTS)에서도 Postgres(students)에서도 찾을 수 있습니다.
+func (s *databaseService) GetColumns(ctx context.Context, dbID string, schema string, tableName string) ([]domain.ColumnInfo, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tableName == "" {
+		return nil, fmt.Errorf("table name is required")
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	_, columns, err := s.lookupColumns(ctx, db, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range columns {
+		columns[i].Name = domain.DisplayIdentifier(db.Type, columns[i].Name)
+	}
+
+	return columns, nil
+}
+
+// GetTableMetadata는 테이블의 인덱스, 제약조건, 나가는/들어오는 외래 키를 반환합니다.
+// 테이블 이름 규칙은 GetColumns와 같습니다.
+func (s *databaseService) GetTableMetadata(ctx context.Context, dbID string, schema string, tableName string) (*domain.TableMetadata, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tableName == "" {
+		return nil, fmt.Errorf("table name is required")
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// 테이블이 있는지 확인하면서 카탈로그에 저장된 이름을 얻습니다.
+	tableName, _, err = s.lookupColumns(ctx, db, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.tableMetadata(ctx, dbID, schema, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+
+	displayTableMetadata(db.Type, metadata)
+
+	return metadata, nil
+}
+
+// displayTableMetadata는 테이블 메타데이터의 이름들을 화면 표시용으로 정규화합니다.
+// 인덱스 컬럼의 " DESC" 표시는 떼고 정규화한 뒤 다시 붙입니다.
+func displayTableMetadata(dbType domain.DatabaseType, metadata *domain.TableMetadata) {
+	display := func(name string) string { return domain.DisplayIdentifier(dbType, name) }
+	displayAll := func(names []string) {
+		for i := range names {
+			names[i] = display(names[i])
+		}
+	}
+
+	metadata.Schema = display(metadata.Schema)
+	metadata.Table = display(metadata.Table)
+	metadata.Owner = display(metadata.Owner)
+
+	for i := range metadata.Indexes {
+		metadata.Indexes[i].Name = display(metadata.Indexes[i].Name)
+		for j, column := range metadata.Indexes[i].Columns {
+			if name, ok := strings.CutSuffix(column, " DESC"); ok {
+				metadata.Indexes[i].Columns[j] = display(name) + " DESC"
+			} else {
+				metadata.Indexes[i].Columns[j] = display(column)
+			}
+		}
+	}
+	for i := range metadata.Constraints {
+		metadata.Constraints[i].Name = display(metadata.Constraints[i].Name)
+		displayAll(metadata.Constraints[i].Columns)
+	}
+	for _, fks := range [][]domain.ForeignKeyInfo{metadata.ForeignKeys, metadata.ReferencedBy} {
+		for i := range fks {
+			fks[i].Name = display(fks[i].Name)
+			fks[i].Schema = display(fks[i].Schema)
+			fks[i].Table = display(fks[i].Table)
+			fks[i].RefSchema = display(fks[i].RefSchema)
+			fks[i].RefTable = display(fks[i].RefTable)
+			displayAll(fks[i].Columns)
+			displayAll(fks[i].RefColumns)
+		}
+	}
+}
+
+// lookupColumns는 테이블을 찾아서 카탈로그에 저장된 이름과 컬럼 목록을 반환합니다.
+//
+// 테이블 이름은 먼저 입력 그대로 찾고, 없으면 DB 기본 대소문자로 바꿔서 다시 찾습니다.
+// 컬럼이 하나도 없으면 테이블이 없는 것으로 봅니다 (domain.ErrTableNotFound).
+func (s *databaseService) lookupColumns(ctx context.Context, db *domain.Database, schema string, tableName string) (string, []domain.ColumnInfo, error) {
+	candidates := []string{tableName}
+	if folded := domain.FoldIdentifier(db.Type, tableName); folded != tableName {
+		candidates = append(candidates, folded)
+	}
+
+	for _, name := range candidates {
+		columns, err := s.columns(ctx, db.ID, schema, name)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to get columns: %w", err)
+		}
+		if len(columns) > 0 {
+			return name, columns, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("%w: %s", domain.ErrTableNotFound, tableName)
+}
+
+// connectedDatabase는 연결된 DB 정보를 찾습니다.
+// 스키마 조회는 DB 종류(대소문자 규칙)를 알아야 하므로 연결 확인과 함께 조회합니다.
+func (s *databaseService) connectedDatabase(ctx context.Context, dbID string) (*domain.Database, error) {
+	if !s.repo.IsConnected(ctx, dbID) {
+		return nil, domain.ErrDatabaseNotConnected
+	}
+
+	return s.findDatabase(ctx, dbID)
+}
+
+// resolveSchema는 요청한 스키마 이름을 카탈로그에 저장된 이름으로 바꿉니다.
+//
+// 우선순위:
+//  1. 요청한 스키마 (없는 스키마면 domain.ErrSchemaNotFound)
+//  2. DB 설정의 Schema (Database.Schema)
+//  3. 빈 문자열 → Adapter가 세션의 현재 스키마를 사용
+//
+// Oracle은 Database.Schema를 접속 서비스 이름(SID)으로도 쓰기 때문에,
+// 설정값이 실제 스키마 이름과 일치할 때만 기본 스키마로 사용합니다.
+func (s *databaseService) resolveSchema(ctx context.Context, db *domain.Database, schema string) (string, error) {
+	if schema == "" && db.Schema == "" {
+		return "", nil
+	}
+
+	schemas, err := s.schemas(ctx, db.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get schemas: %w", err)
+	}
+
+	if schema != "" {
+		if name, ok := matchIdentifier(db.Type, schemas, schema); ok {
+			return name, nil
+		}
+		return "", fmt.Errorf("%w: %s", domain.ErrSchemaNotFound, schema)
+	}
+
+	if name, ok := matchIdentifier(db.Type, schemas, db.Schema); ok {
+		return name, nil
+	}
+
+	return "", nil
+}
+
+// matchIdentifier는 names에서 name을 찾습니다.
+// 정확히 같은 이름을 먼저 찾고, 없으면 DB 기본 대소문자로 바꿔서 찾습니다.
+func matchIdentifier(dbType domain.DatabaseType, names []string, name string) (string, bool) {
+	folded := domain.FoldIdentifier(dbType, name)
+
+	for _, candidate := range names {
+		if candidate == name {
+			return candidate, true
+		}
+	}
+	for _, candidate := range names {
+		if candidate == folded {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"space/internal/domain"
+)
+
+// DiffSchemas는 두 DB(또는 같은 DB의 두 스키마)의 구조를 비교합니다.
+//
+// source가 기준이고, 결과의 Migration은 target을 source와 같게 만드는 DDL입니다.
+// (dev → staging → prod 순서로 변경을 옮길 때 source = dev, target = staging)
+func (s *databaseService) DiffSchemas(ctx context.Context, source, target domain.SchemaRef, options domain.SchemaDiffOptions) (*domain.SchemaDiff, error) {
+	if source.DatabaseID == "" || target.DatabaseID == "" {
+		return nil, fmt.Errorf("source and target database IDs are required")
+	}
+
+	sourceSnapshot, err := s.loadSchemaSnapshot(ctx, source.DatabaseID, source.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("source %s: %w", source.DatabaseID, err)
+	}
+
+	targetSnapshot, err := s.loadSchemaSnapshot(ctx, target.DatabaseID, target.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("target %s: %w", target.DatabaseID, err)
+	}
+
+	return domain.DiffSchemas(sourceSnapshot, targetSnapshot, options), nil
+}
+
+// loadSchemaSnapshot은 스키마의 모든 테이블 구조(컬럼, 인덱스, 제약조건, 외래 키)를 읽습니다.
+// 뷰는 제외합니다. 이름은 DisplayIdentifier로 정규화하므로
+// Oracle과 Postgres 스냅샷도 같은 이름으로 비교할 수 있습니다.
+//
+// 테이블마다 컬럼/메타데이터 조회를 하므로 테이블이 많으면 시간이 걸립니다.
+func (s *databaseService) loadSchemaSnapshot(ctx context.Context, dbID string, schema string) (*domain.SchemaSnapshot, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := s.tables(ctx, dbID, schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tables: %w", err)
+	}
+
+	snapshot := &domain.SchemaSnapshot{
+		DatabaseID:   dbID,
+		DatabaseType: db.Type,
+		Schema:       domain.DisplayIdentifier(db.Type, schema),
+		TakenAt:      time.Now(),
+	}
+
+	for _, table := range tables {
+		if table.Type != domain.TableTypeTable {
+			continue
+		}
+		// 스키마를 지정하지 않았으면 카탈로그가 알려준 현재 스키마를 씁니다.
+		if schema == "" {
+			schema = table.Schema
+			snapshot.Schema = domain.DisplayIdentifier(db.Type, schema)
+		}
+
+		columns, err := s.columns(ctx, dbID, schema, table.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get columns of %s: %w", table.Name, err)
+		}
+
+		metadata, err := s.tableMetadata(ctx, dbID, schema, table.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata of %s: %w", table.Name, err)
+		}
+
+		for i := range columns {
+			columns[i].Name = domain.DisplayIdentifier(db.Type, columns[i].Name)
+		}
+		displayTableMetadata(db.Type, metadata)
+
+		snapshot.Tables = append(snapshot.Tables, domain.TableSnapshot{
+			Name:     metadata.Table,
+			Columns:  columns,
+			Metadata: metadata,
+		})
+	}
+
+	snapshot.SortTables()
+	return snapshot, nil
+}
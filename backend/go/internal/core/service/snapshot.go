@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"space/internal/domain"
+)
+
+// CaptureSnapshot은 스키마 스냅샷을 찍어서 최신 버전과 비교하고, 바뀌었으면 새 버전으로 저장합니다.
+//
+// 구조가 같으면 저장하지 않습니다 (Stored=false).
+// 그래서 저장된 버전 하나하나가 "이 시각에 구조가 바뀐 것을 발견했다"는 기록이 됩니다.
+// 주기적으로 호출하는 쪽은 스케줄러(adapters/input/scheduler)입니다.
+func (s *databaseService) CaptureSnapshot(ctx context.Context, dbID string, schema string) (*domain.SnapshotResult, error) {
+	// 다른 도구로 바꾼 구조도 놓치지 않도록 메타데이터 캐시를 비우고 DB에서 새로 읽습니다.
+	// (새로 읽은 값은 다시 캐시되므로 스냅샷 주기마다 캐시가 새로 고쳐지는 효과도 있습니다.)
+	s.invalidateMetadata(dbID)
+
+	current, err := s.loadSchemaSnapshot(ctx, dbID, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, latest, err := s.snapshots.Latest(ctx, dbID, current.Schema)
+	if err != nil && !errors.Is(err, domain.ErrSnapshotNotFound) {
+		return nil, fmt.Errorf("failed to load previous snapshot: %w", err)
+	}
+
+	var changes []domain.SchemaChange
+	if previous != nil {
+		changes = domain.SchemaChanges(previous, current)
+		if len(changes) == 0 {
+			return &domain.SnapshotResult{Version: *latest}, nil
+		}
+	}
+
+	version, err := s.snapshots.Save(ctx, current, changes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	result := &domain.SnapshotResult{Version: *version, Stored: true}
+	if previous != nil {
+		result.ChangeLog = &domain.SchemaChangeLog{
+			DatabaseID:      dbID,
+			Schema:          current.Schema,
+			Version:         version.Version,
+			PreviousVersion: latest.Version,
+			DetectedAt:      version.TakenAt,
+			Changes:         changes,
+		}
+	}
+
+	return result, nil
+}
+
+// ListSnapshots는 저장된 스냅샷 버전 목록을 반환합니다 (최신순).
+// 이력은 디스크에 남아 있으므로 DB 연결이 끊겨 있어도 조회할 수 있습니다.
+func (s *databaseService) ListSnapshots(ctx context.Context, dbID string, schema string) ([]domain.SnapshotVersion, error) {
+	versions, err := s.snapshots.ListVersions(ctx, dbID, s.snapshotSchema(ctx, dbID, schema))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	return versions, nil
+}
+
+// GetSnapshot은 특정 버전의 스냅샷 전체를 반환합니다.
+//
+// schema를 비우면 그 DB에 스냅샷을 찍은 스키마가 하나일 때만 찾을 수 있습니다.
+// (버전 번호는 스키마마다 따로 매기므로)
+func (s *databaseService) GetSnapshot(ctx context.Context, dbID string, schema string, version int) (*domain.SchemaSnapshot, error) {
+	schema = s.snapshotSchema(ctx, dbID, schema)
+
+	if schema == "" {
+		versions, err := s.snapshots.ListVersions(ctx, dbID, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list snapshots: %w", err)
+		}
+
+		schemas := make(map[string]bool)
+		for _, v := range versions {
+			if v.Version == version {
+				schemas[v.Schema] = true
+				schema = v.Schema
+			}
+		}
+		if len(schemas) > 1 {
+			return nil, fmt.Errorf("schema is required: version %d exists in %d schemas", version, len(schemas))
+		}
+	}
+
+	snapshot, err := s.snapshots.Get(ctx, dbID, schema, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// ListSchemaChanges는 스냅샷 사이에서 발견한 구조 변경 이력을 반환합니다 (최신순).
+// since가 0이 아니면 그 이후에 발견한 변경만 반환합니다.
+func (s *databaseService) ListSchemaChanges(ctx context.Context, dbID string, schema string, since time.Time) ([]domain.SchemaChangeLog, error) {
+	logs, err := s.snapshots.ListChanges(ctx, dbID, s.snapshotSchema(ctx, dbID, schema), since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema changes: %w", err)
+	}
+	return logs, nil
+}
+
+// snapshotSchema는 요청한 스키마 이름을 스냅샷에 저장된 이름(DisplayIdentifier)으로 바꿉니다.
+// DB가 등록되어 있지 않으면 (연결을 끊은 뒤 이력만 보는 경우) 요청한 이름을 그대로 씁니다.
+func (s *databaseService) snapshotSchema(ctx context.Context, dbID string, schema string) string {
+	if schema == "" {
+		return ""
+	}
+
+	db, err := s.findDatabase(ctx, dbID)
+	if err != nil {
+		return schema
+	}
+
+	return domain.DisplayIdentifier(db.Type, schema)
+}
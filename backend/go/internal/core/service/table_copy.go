@@ -0,0 +1,691 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"space/internal/domain"
+)
+
+// StartCopyJob은 등록된 두 DB 사이에서 테이블 하나를 복사하는 작업을 시작합니다.
+//
+// 요청을 검사하고 계획(대상 DDL, 읽는 순서)을 세운 뒤 작업을 저장하고,
+// 실제 복사는 백그라운드에서 실행합니다. 진행 상황은 GetCopyJob으로 확인합니다.
+//
+// 순서 (백그라운드):
+//  1. create: 대상 테이블이 없으면 원본 구조를 대상 방언으로 바꿔서 만듦
+//     (Oracle NUMBER(p) → smallint/integer/bigint/numeric, VARCHAR2 → varchar, DATE → timestamp(0), CLOB → text)
+//  2. copy: 원본을 기본 키(없으면 지정한 유니크 키) 순서로 BatchSize개씩 읽어서 대상에 넣고 묶음마다 커밋
+//     (Postgres: COPY, Oracle: 배열 바인드 - ImportRows와 같은 경로)
+//  3. finish: 만든 테이블의 인덱스/주석, 자동 증가 값 맞추기
+//  4. verify: 원본과 대상의 row 수 비교, 맞으면 변경 이력 저장
+func (s *databaseService) StartCopyJob(ctx context.Context, req domain.CopyRequest) (*domain.CopyJob, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	source, err := s.connectedDatabase(ctx, req.SourceDatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	target, err := s.connectedDatabase(ctx, req.TargetDatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	sourceDialect, targetDialect := domain.DialectOf(source.Type), domain.DialectOf(target.Type)
+	if !sourceDialect.IsValid() || !targetDialect.IsValid() {
+		return nil, fmt.Errorf("%w: %s → %s", domain.ErrUnsupportedDialect, source.Type, target.Type)
+	}
+
+	if err := target.CheckWrite(domain.WriteRows, req.Author); err != nil {
+		return nil, err
+	}
+
+	sourceSchema, err := s.resolveSchema(ctx, source, req.SourceSchema)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	sourceTable, sourceColumns, err := s.lookupColumns(ctx, source, sourceSchema, req.SourceTable)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	key, err := s.copyKey(ctx, source, sourceSchema, sourceTable, sourceColumns, req.KeyColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	targetSchema, err := s.resolveSchema(ctx, target, req.TargetSchema)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	targetName := req.TargetTable
+	if targetName == "" {
+		targetName = domain.DisplayIdentifier(source.Type, sourceTable)
+	}
+
+	now := time.Now()
+	job := &domain.CopyJob{
+		Source:        domain.CopyEndpoint{DatabaseID: source.ID, Type: source.Type, Schema: sourceSchema, Table: sourceTable},
+		Target:        domain.CopyEndpoint{DatabaseID: target.ID, Type: target.Type, Schema: targetSchema, Table: targetName},
+		Truncate:      req.Truncate,
+		BatchSize:     req.BatchSize,
+		Author:        req.Author,
+		Status:        domain.CopyRunning,
+		Phase:         domain.CopyPhaseCopy,
+		SourceColumns: sourceColumns,
+		Key:           key,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	targetTable, targetColumns, err := s.lookupColumns(ctx, target, targetSchema, targetName)
+	switch {
+	case err == nil:
+		job.Target.Table = targetTable
+		if source.ID == target.ID && sourceSchema == targetSchema && sourceTable == targetTable {
+			return nil, fmt.Errorf("%w: source and target are the same table", domain.ErrInvalidCopy)
+		}
+		if _, err := copyTargetColumns(job, targetColumns); err != nil {
+			return nil, err
+		}
+		if err := s.checkCopyTargetEmpty(ctx, job); err != nil {
+			return nil, err
+		}
+
+	case errors.Is(err, domain.ErrTableNotFound):
+		if err := target.CheckWrite(domain.WriteTables, req.Author); err != nil {
+			return nil, err
+		}
+		if err := s.planCopyTable(ctx, source, job); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	sourceRows, err := s.countRows(ctx, job.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count source rows: %w", err)
+	}
+	job.SourceRows = sourceRows
+
+	if err := s.copyJobs.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to save copy job: %w", err)
+	}
+
+	s.startCopy(job)
+	return job, nil
+}
+
+// GetCopyJob은 복사 작업의 현재 상태를 반환합니다.
+//
+// running으로 저장되어 있지만 이 서버에서 실행 중이 아니면 (복사 중에 서버가 멈춤)
+// failed로 바꿔서 보여줍니다. ResumeCopyJob으로 이어서 복사할 수 있습니다.
+func (s *databaseService) GetCopyJob(ctx context.Context, id string) (*domain.CopyJob, error) {
+	job, err := s.copyJobs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.markInterrupted(job)
+	return job, nil
+}
+
+// ListCopyJobs는 모든 복사 작업을 최신순으로 반환합니다.
+func (s *databaseService) ListCopyJobs(ctx context.Context) ([]domain.CopyJob, error) {
+	jobs, err := s.copyJobs.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		s.markInterrupted(&jobs[i])
+	}
+	return jobs, nil
+}
+
+// ResumeCopyJob은 실패한 복사 작업을 마지막으로 커밋한 위치부터 이어서 실행합니다.
+//
+// 마지막 묶음을 커밋한 뒤 위치를 저장하기 전에 멈췄을 수 있으므로,
+// 이어서 넣는 첫 묶음은 대상의 기본 키로 upsert해서 같은 row가 두 번 들어가지 않게 합니다.
+func (s *databaseService) ResumeCopyJob(ctx context.Context, id string, author string) (*domain.CopyJob, error) {
+	job, err := s.copyJobs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.isCopying(id) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCopyJobRunning, id)
+	}
+	if job.Status == domain.CopyCompleted {
+		return nil, fmt.Errorf("%w: job %s is already completed", domain.ErrInvalidCopy, id)
+	}
+
+	if _, err := s.connectedDatabase(ctx, job.Source.DatabaseID); err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	target, err := s.connectedDatabase(ctx, job.Target.DatabaseID)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	if err := target.CheckWrite(domain.WriteRows, author); err != nil {
+		return nil, err
+	}
+	if job.Phase == domain.CopyPhaseCreate {
+		if err := target.CheckWrite(domain.WriteTables, author); err != nil {
+			return nil, err
+		}
+	}
+
+	job.Status = domain.CopyRunning
+	job.Error = ""
+	job.Resumes++
+	job.UpdatedAt = time.Now()
+	if err := s.copyJobs.Save(ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to save copy job: %w", err)
+	}
+
+	if !s.startCopy(job) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCopyJobRunning, id)
+	}
+	return job, nil
+}
+
+// planCopyTable은 원본 구조로 대상 테이블 DDL을 만듭니다 (GenerateDDL의 테이블 변환과 같은 규칙).
+func (s *databaseService) planCopyTable(ctx context.Context, source *domain.Database, job *domain.CopyJob) error {
+	metadata, err := s.tableMetadata(ctx, source.ID, job.Source.Schema, job.Source.Table)
+	if err != nil {
+		return fmt.Errorf("failed to get table metadata: %w", err)
+	}
+
+	// 이름을 정규화하면 "EMPLOYEES"(Oracle) → employees처럼 따옴표 없는 이름이 되어
+	// 대상 DB의 기본 대소문자로 만들어집니다.
+	columns := make([]domain.ColumnInfo, len(job.SourceColumns))
+	for i, c := range job.SourceColumns {
+		columns[i] = c
+		columns[i].Name = domain.DisplayIdentifier(source.Type, c.Name)
+	}
+	displayTableMetadata(source.Type, metadata)
+	metadata.Schema = domain.DisplayIdentifier(job.Target.Type, job.Target.Schema)
+	metadata.Table = job.Target.Table
+
+	job.CreateStatement, job.FinishStatements, job.Warnings = domain.CopyTableDDL(domain.TableDefinition{
+		Source:   domain.DialectOf(source.Type),
+		Metadata: metadata,
+		Columns:  columns,
+	}, domain.DialectOf(job.Target.Type))
+	job.Created = true
+	job.Phase = domain.CopyPhaseCreate
+
+	return nil
+}
+
+// startCopy는 작업을 실행 중으로 표시하고 백그라운드에서 실행합니다.
+// 이미 실행 중이면 false를 반환합니다.
+//
+// 요청의 context는 응답과 함께 취소되므로 쓰지 않습니다.
+// 작업은 깊은 복사본(Clone)으로 실행하므로, 호출한 쪽의 job은 시작할 때의 상태로 남고
+// 백그라운드가 슬라이스를 고치는 동안 응답으로 직렬화해도 안전합니다.
+func (s *databaseService) startCopy(job *domain.CopyJob) bool {
+	s.copyMu.Lock()
+	defer s.copyMu.Unlock()
+
+	if s.copying[job.ID] {
+		return false
+	}
+	s.copying[job.ID] = true
+
+	go s.runCopyJob(job.Clone())
+	return true
+}
+
+// isCopying은 작업이 이 서버에서 실행 중인지 확인합니다.
+func (s *databaseService) isCopying(id string) bool {
+	s.copyMu.Lock()
+	defer s.copyMu.Unlock()
+
+	return s.copying[id]
+}
+
+// markInterrupted는 저장된 상태는 running인데 실행 중이 아닌 작업을 failed로 바꿉니다.
+func (s *databaseService) markInterrupted(job *domain.CopyJob) {
+	if job.Status == domain.CopyRunning && !s.isCopying(job.ID) {
+		job.Status = domain.CopyFailed
+		job.Error = "interrupted: the server stopped while the job was running (resume to continue)"
+	}
+}
+
+// runCopyJob은 작업을 끝까지 실행하고 결과(완료 또는 실패 이유)를 저장합니다.
+func (s *databaseService) runCopyJob(job *domain.CopyJob) {
+	ctx := context.Background()
+	defer func() {
+		s.copyMu.Lock()
+		delete(s.copying, job.ID)
+		s.copyMu.Unlock()
+	}()
+
+	err := s.copyTable(ctx, job)
+
+	job.UpdatedAt = time.Now()
+	if err != nil {
+		job.Status = domain.CopyFailed
+		job.Error = err.Error()
+	} else {
+		job.Status = domain.CopyCompleted
+		job.FinishedAt = job.UpdatedAt
+	}
+
+	if err := s.copyJobs.Save(ctx, job); err != nil {
+		log.Printf("copy job %s: failed to save final state: %v", job.ID, err)
+	}
+}
+
+// copyTable은 저장된 단계부터 남은 단계를 순서대로 실행합니다.
+func (s *databaseService) copyTable(ctx context.Context, job *domain.CopyJob) error {
+	steps := []struct {
+		phase domain.CopyPhase
+		run   func(context.Context, *domain.CopyJob) error
+		next  domain.CopyPhase
+	}{
+		{domain.CopyPhaseCreate, s.createCopyTarget, domain.CopyPhaseCopy},
+		{domain.CopyPhaseCopy, s.copyRows, domain.CopyPhaseFinish},
+		{domain.CopyPhaseFinish, s.finishCopyTarget, domain.CopyPhaseVerify},
+		{domain.CopyPhaseVerify, s.verifyCopy, domain.CopyPhaseDone},
+	}
+
+	for _, step := range steps {
+		if job.Phase != step.phase {
+			continue
+		}
+		if err := step.run(ctx, job); err != nil {
+			return fmt.Errorf("%s: %w", step.phase, err)
+		}
+
+		job.Phase = step.next
+		job.UpdatedAt = time.Now()
+		if err := s.copyJobs.Save(ctx, job); err != nil {
+			return fmt.Errorf("failed to save copy job: %w", err)
+		}
+	}
+	return nil
+}
+
+// createCopyTarget은 대상 테이블을 만듭니다.
+// 이전 실행에서 만든 뒤 저장하기 전에 멈췄으면 이미 있으므로 만들지 않습니다.
+func (s *databaseService) createCopyTarget(ctx context.Context, job *domain.CopyJob) error {
+	target, err := s.connectedDatabase(ctx, job.Target.DatabaseID)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.lookupColumns(ctx, target, job.Target.Schema, job.Target.Table)
+	if errors.Is(err, domain.ErrTableNotFound) {
+		if _, err := s.repo.ExecuteStatement(ctx, target.ID, job.CreateStatement); err != nil {
+			return err
+		}
+		s.invalidateMetadata(target.ID)
+	} else if err != nil {
+		return err
+	}
+
+	tableName, columns, err := s.lookupColumns(ctx, target, job.Target.Schema, job.Target.Table)
+	if err != nil {
+		return err
+	}
+	job.Target.Table = tableName
+
+	// 원본 값을 그대로 넣으므로 복사한 뒤 자동 증가 값을 MAX+1로 맞춥니다.
+	// 이 단계가 다시 실행되어도 (저장 실패 후 재시작) 같은 문장을 두 번 넣지 않습니다.
+	// Finished가 가리키는 위치가 바뀌면 안 되기 때문!
+	for _, c := range columns {
+		if c.Identity == nil {
+			continue
+		}
+		statement := domain.IdentityRestartStatement(target.Type, job.Target.Schema, tableName, c)
+		if !slices.Contains(job.FinishStatements, statement) {
+			job.FinishStatements = append(job.FinishStatements, statement)
+		}
+	}
+	return nil
+}
+
+// copyRows는 원본을 Key 순서로 한 묶음씩 읽어서 대상에 넣습니다.
+// 묶음마다 대상 트랜잭션을 커밋하고 마지막 키(Checkpoint)를 저장합니다.
+func (s *databaseService) copyRows(ctx context.Context, job *domain.CopyJob) error {
+	target, err := s.connectedDatabase(ctx, job.Target.DatabaseID)
+	if err != nil {
+		return err
+	}
+	_, columns, err := s.lookupColumns(ctx, target, job.Target.Schema, job.Target.Table)
+	if err != nil {
+		return err
+	}
+	targets, err := copyTargetColumns(job, columns)
+	if err != nil {
+		return err
+	}
+
+	after, err := domain.CopyKeyArgs(job.Source.Type, job.Key, job.Checkpoint)
+	if err != nil {
+		return err
+	}
+
+	// 이어서 하는 첫 묶음은 이미 커밋됐을 수 있으므로 대상 기본 키로 upsert합니다.
+	// 아직 커밋한 묶음이 없으면 Truncate로 비우고 처음부터 넣습니다.
+	replay := job.Resumes > 0
+	var upsertKey []domain.ColumnInfo
+	if replay {
+		if upsertKey, err = s.copyUpsertKey(ctx, job, columns, targets); err != nil {
+			return err
+		}
+	}
+	truncate := job.Truncate && job.Copied == 0 && len(job.Checkpoint) == 0
+
+	for {
+		query, args, err := domain.CopyPageQuery(job.Source.Type, job.Source.Schema, job.Source.Table,
+			job.SourceColumns, job.Key, after, job.BatchSize)
+		if err != nil {
+			return err
+		}
+		page, err := s.repo.ExecuteQuery(ctx, job.Source.DatabaseID, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to read source rows: %w", err)
+		}
+		if len(page.Rows) == 0 && !truncate {
+			return nil
+		}
+
+		batch := make([]domain.ImportRow, len(page.Rows))
+		for i, row := range page.Rows {
+			line := int(job.Copied) + i + 1
+			values, err := domain.CopyRowValues(job.Target.Type, row, job.SourceColumns, targets)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", line, err)
+			}
+			batch[i] = domain.ImportRow{Line: line, Values: values}
+		}
+
+		var key []domain.ColumnInfo
+		if replay {
+			key = upsertKey
+		}
+		plan, err := domain.NewImportPlan(job.Target.Type, job.Target.Schema, job.Target.Table, targets, key, truncate)
+		if err != nil {
+			return err
+		}
+		if job.LoadStatement == "" || len(key) == 0 {
+			job.LoadStatement = plan.Statement
+		}
+
+		report := &domain.ImportReport{}
+		served := false
+		next := func() ([]domain.ImportRow, error) {
+			if served {
+				return nil, nil
+			}
+			served = true
+			return batch, nil
+		}
+		if err := s.repo.ImportRows(ctx, job.Target.DatabaseID, plan, next, report); err != nil {
+			return fmt.Errorf("batch %d: %w", job.Batches+1, err)
+		}
+		// 묶음마다 커밋되므로 대상 DB의 캐시된 SELECT 결과도 묶음마다 비웁니다.
+		s.invalidateResults(job.Target.DatabaseID)
+
+		job.Copied += report.Loaded
+		job.Batches++
+		if len(page.Rows) > 0 {
+			job.Checkpoint = domain.CopyCheckpoint(page.Rows[len(page.Rows)-1], job.Key)
+			if after, err = domain.CopyKeyArgs(job.Source.Type, job.Key, job.Checkpoint); err != nil {
+				return err
+			}
+		}
+		job.UpdatedAt = time.Now()
+		if err := s.copyJobs.Save(ctx, job); err != nil {
+			return fmt.Errorf("failed to save copy job: %w", err)
+		}
+
+		replay, truncate = false, false
+		if len(page.Rows) < job.BatchSize {
+			return nil
+		}
+	}
+}
+
+// finishCopyTarget은 만든 테이블의 인덱스/주석/자동 증가 값 문장을 실행합니다.
+// 문장마다 저장하므로 다시 시작하면 실행하지 않은 문장부터 이어서 합니다.
+func (s *databaseService) finishCopyTarget(ctx context.Context, job *domain.CopyJob) error {
+	for job.Finished < len(job.FinishStatements) {
+		if _, err := s.repo.ExecuteStatement(ctx, job.Target.DatabaseID, job.FinishStatements[job.Finished]); err != nil {
+			return err
+		}
+		job.Finished++
+		job.UpdatedAt = time.Now()
+		if err := s.copyJobs.Save(ctx, job); err != nil {
+			return fmt.Errorf("failed to save copy job: %w", err)
+		}
+	}
+
+	if len(job.FinishStatements) > 0 {
+		s.invalidateMetadata(job.Target.DatabaseID)
+	}
+	return nil
+}
+
+// verifyCopy는 원본과 대상의 row 수를 비교하고, 맞으면 대상 DB에 변경 이력을 남깁니다.
+// 복사하는 동안 원본이 바뀌면 맞지 않을 수 있으므로, 복사 중에는 원본 쓰기를 멈춰야 합니다.
+func (s *databaseService) verifyCopy(ctx context.Context, job *domain.CopyJob) error {
+	sourceRows, err := s.countRows(ctx, job.Source)
+	if err != nil {
+		return fmt.Errorf("failed to count source rows: %w", err)
+	}
+	targetRows, err := s.countRows(ctx, job.Target)
+	if err != nil {
+		return fmt.Errorf("failed to count target rows: %w", err)
+	}
+
+	job.Verification = &domain.CopyVerification{
+		SourceRows: sourceRows,
+		TargetRows: targetRows,
+		Matched:    sourceRows == targetRows,
+		VerifiedAt: time.Now(),
+	}
+	if !job.Verification.Matched {
+		return fmt.Errorf("%w: source has %d rows, target has %d rows", domain.ErrCopyCountMismatch, sourceRows, targetRows)
+	}
+
+	entry, err := s.recordCopy(ctx, job)
+	if err != nil {
+		return err
+	}
+	job.ChangeLogID = entry.ID
+	return nil
+}
+
+// recordCopy는 복사 작업을 대상 DB의 변경 이력에 남깁니다 (After는 건수 요약 JSON).
+func (s *databaseService) recordCopy(ctx context.Context, job *domain.CopyJob) (*domain.ChangeLogEntry, error) {
+	target, err := s.connectedDatabase(ctx, job.Target.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &domain.ChangeLogEntry{
+		DatabaseID: target.ID,
+		Schema:     domain.DisplayIdentifier(target.Type, job.Target.Schema),
+		Table:      domain.DisplayIdentifier(target.Type, job.Target.Table),
+		Kind:       domain.ChangeTableCopy,
+		Author:     job.Author,
+		Statement:  job.LoadStatement,
+		ChangedAt:  time.Now(),
+	}
+	if job.Target.Schema == "" {
+		entry.Schema = s.changeLogSchema(ctx, target, job.Target.Table)
+	}
+	if job.Created {
+		entry.Statement = job.CreateStatement + ";\n" + job.LoadStatement
+	}
+
+	source := domain.DisplayIdentifier(job.Source.Type, job.Source.Table)
+	if job.Source.Schema != "" {
+		source = domain.DisplayIdentifier(job.Source.Type, job.Source.Schema) + "." + source
+	}
+	summary := map[string]interface{}{
+		"job_id":  job.ID,
+		"source":  job.Source.DatabaseID + ":" + source,
+		"rows":    job.Verification.TargetRows,
+		"batches": job.Batches,
+		"created": job.Created,
+	}
+
+	if entry.After, err = rowJSON(summary); err != nil {
+		return nil, fmt.Errorf("rows were copied but recording the change log failed: %w", err)
+	}
+	if err := s.changes.Append(ctx, entry); err != nil {
+		return nil, fmt.Errorf("rows were copied but recording the change log failed: %w", err)
+	}
+	return entry, nil
+}
+
+// checkCopyTargetEmpty는 이미 있는 대상 테이블에 데이터가 있으면 거부합니다 (Truncate면 통과).
+func (s *databaseService) checkCopyTargetEmpty(ctx context.Context, job *domain.CopyJob) error {
+	if job.Truncate {
+		return nil
+	}
+
+	rows, err := s.countRows(ctx, job.Target)
+	if err != nil {
+		return fmt.Errorf("failed to count target rows: %w", err)
+	}
+	if rows > 0 {
+		return fmt.Errorf("%w: target table %s already has %d rows (set truncate to replace them)",
+			domain.ErrInvalidCopy, domain.DisplayIdentifier(job.Target.Type, job.Target.Table), rows)
+	}
+	return nil
+}
+
+// countRows는 테이블의 전체 row 수를 셉니다.
+func (s *databaseService) countRows(ctx context.Context, table domain.CopyEndpoint) (int64, error) {
+	query, args, err := domain.RowCountQuery(table.Type, domain.RowSelect{Schema: table.Schema, Table: table.Table})
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := s.repo.ExecuteQuery(ctx, table.DatabaseID, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	if len(result.Rows) == 0 {
+		return 0, fmt.Errorf("count query returned no rows")
+	}
+
+	total, ok := domain.RowInt64(result.Rows[0], "total")
+	if !ok {
+		return 0, fmt.Errorf("count query returned an unexpected value")
+	}
+	return total, nil
+}
+
+// copyTargetColumns는 원본 컬럼과 같은 이름의 대상 컬럼을 찾습니다 (SourceColumns 순서).
+// 이름은 DisplayIdentifier로 비교하므로 Oracle EMP_NO와 Postgres emp_no가 같은 컬럼입니다.
+func copyTargetColumns(job *domain.CopyJob, columns []domain.ColumnInfo) ([]domain.ColumnInfo, error) {
+	names := columnNames(columns)
+	table := domain.DisplayIdentifier(job.Target.Type, job.Target.Table)
+
+	targets := make([]domain.ColumnInfo, len(job.SourceColumns))
+	for i, c := range job.SourceColumns {
+		name := domain.DisplayIdentifier(job.Source.Type, c.Name)
+		match, ok := matchIdentifier(job.Target.Type, names, name)
+		if !ok {
+			return nil, fmt.Errorf("%w: target table %s has no column %s", domain.ErrInvalidCopy, table, name)
+		}
+
+		col := columns[columnIndex(columns, match)]
+		if col.Identity != nil && col.Identity.Generation == domain.IdentityAlways {
+			return nil, fmt.Errorf("%w: target column %s.%s is GENERATED ALWAYS and cannot receive copied values",
+				domain.ErrInvalidCopy, table, domain.DisplayIdentifier(job.Target.Type, match))
+		}
+		targets[i] = col
+	}
+	return targets, nil
+}
+
+// copyKey는 원본을 읽는 순서이자 이어서 복사할 위치를 정하는 키를 고릅니다.
+//
+// 지정하지 않으면 기본 키를 씁니다. 기본 키가 없는 테이블은 키를 지정해야 합니다.
+// row 위치(ROWID, ctid)는 UPDATE나 테이블 이동으로 바뀌어서 이어서 복사할 때
+// row를 건너뛰거나 두 번 넣을 수 있기 때문!
+//
+// 지정한 키는 NOT NULL이고 원본의 기본 키나 유니크 제약조건과 컬럼이 같아야 합니다.
+// (값이 겹치거나 NULL이 있으면 "마지막 키보다 큰 row"로 읽을 때 row를 빠뜨림)
+func (s *databaseService) copyKey(ctx context.Context, source *domain.Database, schema, table string, columns []domain.ColumnInfo, names []string) ([]domain.ColumnInfo, error) {
+	display := domain.DisplayIdentifier(source.Type, table)
+	if len(names) == 0 {
+		key := domain.PrimaryKeyColumns(columns)
+		if len(key) == 0 {
+			return nil, fmt.Errorf("%w: %s (set key_columns to a unique NOT NULL key to copy it)", domain.ErrNoPrimaryKey, display)
+		}
+		return key, nil
+	}
+
+	available := columnNames(columns)
+	key := make([]domain.ColumnInfo, 0, len(names))
+	for _, name := range names {
+		match, ok := matchIdentifier(source.Type, available, name)
+		if !ok {
+			return nil, fmt.Errorf("%w: source table %s has no column %s", domain.ErrInvalidCopy, display, name)
+		}
+		if columnIndex(key, match) >= 0 {
+			continue
+		}
+
+		col := columns[columnIndex(columns, match)]
+		if col.Nullable {
+			return nil, fmt.Errorf("%w: key column %s of %s must be NOT NULL", domain.ErrInvalidCopy, domain.DisplayIdentifier(source.Type, match), display)
+		}
+		key = append(key, col)
+	}
+
+	metadata, err := s.tableMetadata(ctx, source.ID, schema, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table metadata: %w", err)
+	}
+	if !metadata.HasUniqueConstraint(columnNames(key)) {
+		return nil, fmt.Errorf("%w: key columns of %s must be its primary key or a unique constraint", domain.ErrInvalidCopy, display)
+	}
+	return key, nil
+}
+
+// copyUpsertKey는 이어서 하는 첫 묶음을 upsert할 대상 키를 고릅니다.
+//
+// 대상의 기본 키를 쓰고, 기본 키가 없거나 복사하는 컬럼이 아니면 원본 Key와 같은 컬럼의
+// 대상 유니크 제약조건을 씁니다 (Postgres ON CONFLICT는 유니크 제약조건이 있어야 함).
+// 둘 다 없으면 nil이므로 그냥 넣고, 겹친 row는 verify에서 row 수로 드러납니다.
+func (s *databaseService) copyUpsertKey(ctx context.Context, job *domain.CopyJob, columns, targets []domain.ColumnInfo) ([]domain.ColumnInfo, error) {
+	primary := domain.PrimaryKeyColumns(columns)
+	covered := len(primary) > 0
+	for _, k := range primary {
+		if columnIndex(targets, k.Name) < 0 {
+			covered = false
+		}
+	}
+	if covered {
+		return primary, nil
+	}
+
+	key := make([]domain.ColumnInfo, len(job.Key))
+	for i, k := range job.Key {
+		key[i] = targets[columnIndex(job.SourceColumns, k.Name)]
+	}
+
+	metadata, err := s.tableMetadata(ctx, job.Target.DatabaseID, job.Target.Schema, job.Target.Table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target table metadata: %w", err)
+	}
+	if metadata.HasUniqueConstraint(columnNames(key)) {
+		return key, nil
+	}
+	return nil, nil
+}
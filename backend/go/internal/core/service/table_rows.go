@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"space/internal/domain"
+)
+
+// BrowseRows는 테이블 데이터 한 페이지를 필터/정렬해서 반환합니다.
+//
+// 사용자가 SQL을 쓰지 않고 테이블을 훑어볼 수 있도록 서버가 SQL을 만듭니다.
+//   - 컬럼 이름은 카탈로그에서 찾아서 따옴표로 감싸고 (SQL 인젝션 불가)
+//   - 필터 값은 컬럼 타입에 맞게 바꿔서 바인드 변수로 넘깁니다
+//
+// 정렬을 지정하지 않으면 기본 키 순서로 정렬해서 페이지가 겹치거나 빠지지 않게 합니다.
+func (s *databaseService) BrowseRows(ctx context.Context, dbID string, query domain.RowQuery) (*domain.RowPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := s.resolveSchema(ctx, db, query.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	tableName, columns, err := s.lookupColumns(ctx, db, schema, query.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	sel, err := rowSelect(db.Type, schema, tableName, columns, query)
+	if err != nil {
+		return nil, err
+	}
+
+	statement, args, err := domain.RowSelectQuery(db.Type, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	result, err := s.repo.ExecuteQuery(ctx, dbID, statement, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query execution failed: %w", err)
+	}
+
+	page := &domain.RowPage{
+		Table:     domain.DisplayIdentifier(db.Type, tableName),
+		Limit:     query.Limit,
+		Offset:    query.Offset,
+		HasMore:   len(result.Rows) > query.Limit,
+		Statement: statement,
+	}
+	if schema != "" {
+		page.Schema = domain.DisplayIdentifier(db.Type, schema)
+	}
+
+	rows := result.Rows
+	if page.HasMore {
+		rows = rows[:query.Limit]
+	}
+	page.Columns, page.ColumnTypes, page.Rows = displayRows(db.Type, sel.Columns, rows)
+
+	if query.CountTotal {
+		countQuery, countArgs, err := domain.RowCountQuery(db.Type, sel)
+		if err != nil {
+			return nil, err
+		}
+		count, err := s.repo.ExecuteQuery(ctx, dbID, countQuery, countArgs...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count rows: %w", err)
+		}
+		if row, err := count.FirstRow(); err == nil {
+			if total, ok := domain.RowInt64(row, "total"); ok {
+				page.Total = &total
+			}
+		}
+	}
+
+	page.ExecutionTime = time.Since(start)
+	return page, nil
+}
+
+// rowSelect는 요청의 컬럼 이름을 카탈로그 컬럼으로 해석해서 domain.RowSelect를 만듭니다.
+func rowSelect(dbType domain.DatabaseType, schema, tableName string, columns []domain.ColumnInfo, query domain.RowQuery) (domain.RowSelect, error) {
+	names := make([]string, len(columns))
+	byName := make(map[string]domain.ColumnInfo, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+		byName[c.Name] = c
+	}
+
+	find := func(name string) (domain.ColumnInfo, error) {
+		match, ok := matchIdentifier(dbType, names, name)
+		if !ok {
+			return domain.ColumnInfo{}, fmt.Errorf("%w: %s.%s", domain.ErrColumnNotFound, domain.DisplayIdentifier(dbType, tableName), name)
+		}
+		return byName[match], nil
+	}
+
+	sel := domain.RowSelect{
+		Schema: schema,
+		Table:  tableName,
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+
+	if len(query.Columns) == 0 {
+		sel.Columns = columns
+	}
+	for _, name := range query.Columns {
+		col, err := find(name)
+		if err != nil {
+			return sel, err
+		}
+		sel.Columns = append(sel.Columns, col)
+	}
+
+	for _, f := range query.Filters {
+		col, err := find(f.Column)
+		if err != nil {
+			return sel, err
+		}
+		sel.Where = append(sel.Where, domain.RowCondition{Column: col, Operator: f.Operator, Values: f.Values})
+	}
+
+	for _, sort := range query.Sort {
+		col, err := find(sort.Column)
+		if err != nil {
+			return sel, err
+		}
+		if domain.ProfileCategoryOf(col.BaseType) == domain.ProfileLOB {
+			return sel, fmt.Errorf("%w: cannot sort by %s column %s", domain.ErrInvalidRowQuery, col.DataType, sort.Column)
+		}
+		sel.OrderBy = append(sel.OrderBy, domain.RowSort{Column: col.Name, Descending: sort.Descending})
+	}
+
+	if len(sel.OrderBy) == 0 {
+		for _, c := range columns {
+			if c.PrimaryKey {
+				sel.OrderBy = append(sel.OrderBy, domain.RowSort{Column: c.Name})
+			}
+		}
+	}
+
+	return sel, nil
+}
+
+// displayRows는 조회 결과의 키를 DisplayIdentifier 이름으로 바꿉니다.
+// SELECT 목록에 카탈로그 이름을 그대로 썼으므로 드라이버가 돌려주는 이름도 카탈로그 이름입니다.
+// lib/pq가 돌려주는 []byte는 JSON에서 base64가 되므로 문자열로 바꿉니다.
+func displayRows(dbType domain.DatabaseType, columns []domain.ColumnInfo, rows []map[string]interface{}) ([]string, []string, []map[string]interface{}) {
+	names := make([]string, len(columns))
+	types := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = domain.DisplayIdentifier(dbType, c.Name)
+		types[i] = c.DataType
+	}
+
+	display := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		values := make(map[string]interface{}, len(columns))
+		for j, c := range columns {
+			v := row[c.Name]
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			values[names[j]] = v
+		}
+		display[i] = values
+	}
+
+	return names, types, display
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"space/internal/domain"
+)
+
+// GetTableStats는 테이블 하나의 크기/활동 통계를 반환합니다.
+// 이름은 GetColumns와 같은 규칙으로 찾습니다 (정확한 이름 → DB 기본 대소문자).
+func (s *databaseService) GetTableStats(ctx context.Context, dbID string, schema string, table string) (*domain.TableStats, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []string{table}
+	if folded := domain.FoldIdentifier(db.Type, table); folded != table {
+		candidates = append(candidates, folded)
+	}
+
+	for _, name := range candidates {
+		stats, err := s.repo.GetTableStats(ctx, dbID, schema, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get table stats: %w", err)
+		}
+		if len(stats) > 0 {
+			displayTableStats(db.Type, stats)
+			return &stats[0], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", domain.ErrTableNotFound, table)
+}
+
+// ListTableStats는 스키마의 모든 테이블 통계를 정렬해서 반환합니다.
+// 정렬은 DB 종류와 관계없이 같게 동작하도록 여기서 합니다.
+func (s *databaseService) ListTableStats(ctx context.Context, dbID string, schema string, options domain.TableStatsOptions) ([]domain.TableStats, error) {
+	db, err := s.connectedDatabase(ctx, dbID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err = s.resolveSchema(ctx, db, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.repo.GetTableStats(ctx, dbID, schema, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table stats: %w", err)
+	}
+
+	displayTableStats(db.Type, stats)
+
+	if options.Sort == "" {
+		options.Sort = domain.SortStatsTotalBytes
+	}
+	domain.SortTableStats(stats, options.Sort, options.Descending)
+
+	if options.Limit > 0 && len(stats) > options.Limit {
+		stats = stats[:options.Limit]
+	}
+
+	return stats, nil
+}
+
+// displayTableStats는 스키마/테이블 이름을 DisplayIdentifier로 정규화합니다.
+func displayTableStats(dbType domain.DatabaseType, stats []domain.TableStats) {
+	for i := range stats {
+		stats[i].Schema = domain.DisplayIdentifier(dbType, stats[i].Schema)
+		stats[i].Table = domain.DisplayIdentifier(dbType, stats[i].Table)
+	}
+}
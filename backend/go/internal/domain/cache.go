@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// CacheInfo는 캐시에서 온(또는 캐시에 저장된) 결과의 메타데이터입니다.
+// HTTP 어댑터는 이 정보로 Cache-Control, ETag 헤더를 만듭니다.
+type CacheInfo struct {
+	Hit       bool      // 캐시에서 읽었으면 true, 방금 실행해서 저장했으면 false
+	ETag      string    // 결과 내용의 해시 (내용이 같으면 같은 값)
+	StoredAt  time.Time // 캐시에 저장된 시각
+	ExpiresAt time.Time // 만료 시각
+}
+
+// MaxAge는 만료까지 남은 시간을 반환합니다 (음수면 0).
+func (ci *CacheInfo) MaxAge(now time.Time) time.Duration {
+	remaining := ci.ExpiresAt.Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// CacheKey는 결과 캐시의 키입니다.
+// 같은 DB, 같은 SQL(공백 정규화 후), 같은 파라미터면 같은 키가 됩니다.
+type CacheKey struct {
+	DatabaseID string
+	Hash       string // 정규화된 SQL + 파라미터의 SHA-256
+}
+
+// NewCacheKey는 DB ID, SQL, 바인딩 파라미터로 캐시 키를 만듭니다.
+func NewCacheKey(dbID string, query string, params ...interface{}) CacheKey {
+	h := sha256.New()
+	h.Write([]byte(NormalizeSQL(query)))
+	for _, p := range params {
+		// %T까지 넣어야 1과 "1"이 다른 키가 됩니다.
+		fmt.Fprintf(h, "\x00%T:%v", p, p)
+	}
+
+	return CacheKey{
+		DatabaseID: dbID,
+		Hash:       hex.EncodeToString(h.Sum(nil)),
+	}
+}
+
+// CacheEntry는 캐시에 저장되는 항목입니다.
+type CacheEntry struct {
+	Key       CacheKey
+	Result    *QueryResult
+	ETag      string
+	Size      int64 // 결과의 대략적인 크기 (JSON 바이트 수)
+	StoredAt  time.Time
+	ExpiresAt time.Time
+}
+
+// NewCacheEntry는 쿼리 결과로 캐시 항목을 만듭니다.
+// 결과를 JSON으로 직렬화해서 크기와 ETag를 함께 계산합니다.
+func NewCacheEntry(key CacheKey, result *QueryResult, ttl time.Duration, now time.Time) (*CacheEntry, error) {
+	data, err := json.Marshal(struct {
+		Columns []string
+		Rows    []map[string]interface{}
+	}{result.Columns, result.Rows})
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize result: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return &CacheEntry{
+		Key:       key,
+		Result:    result,
+		ETag:      `"` + hex.EncodeToString(sum[:16]) + `"`,
+		Size:      int64(len(data)),
+		StoredAt:  now,
+		ExpiresAt: now.Add(ttl),
+	}, nil
+}
+
+// IsExpired는 항목이 만료되었는지 확인합니다.
+func (e *CacheEntry) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// WithCacheInfo는 캐시 정보가 붙은 결과의 얕은 복사본을 반환합니다.
+// 캐시에 저장된 원본은 여러 요청이 공유하므로 직접 수정하면 안 됩니다.
+func (e *CacheEntry) WithCacheInfo(hit bool) *QueryResult {
+	result := *e.Result
+	result.Cache = &CacheInfo{
+		Hit:       hit,
+		ETag:      e.ETag,
+		StoredAt:  e.StoredAt,
+		ExpiresAt: e.ExpiresAt,
+	}
+	return &result
+}
+
+// CacheStats는 결과 캐시의 현재 상태입니다.
+type CacheStats struct {
+	Entries    int
+	SizeBytes  int64
+	MaxEntries int
+	MaxBytes   int64
+	Hits       int64
+	Misses     int64
+	Evictions  int64
+}
+
+// MetadataKind는 메타데이터 캐시 항목의 종류입니다 (어떤 조회 결과인지).
+type MetadataKind string
+
+const (
+	MetadataSchemas       MetadataKind = "schemas"
+	MetadataTables        MetadataKind = "tables"
+	MetadataColumns       MetadataKind = "columns"
+	MetadataTableMetadata MetadataKind = "table_metadata"
+	MetadataObjects       MetadataKind = "objects"
+	MetadataObject        MetadataKind = "object"
+	MetadataDDL           MetadataKind = "ddl"
+	MetadataCatalog       MetadataKind = "catalog"
+)
+
+// MetadataKey는 메타데이터 캐시의 키입니다.
+// Repository 조회 인자를 그대로 담으므로 같은 인자의 조회는 같은 키가 됩니다.
+// (Schema가 빈 문자열이면 "현재 스키마" 조회)
+type MetadataKey struct {
+	DatabaseID string
+	Kind       MetadataKind
+	Schema     string
+	Name       string // 테이블/객체 이름
+	Object     string // 객체 종류 (ListObjects는 쉼표로 이은 목록)
+}
+
+// MetadataRefresh는 메타데이터 캐시를 새로 고친 결과입니다.
+type MetadataRefresh struct {
+	DatabaseID  string
+	Invalidated int // 지운 캐시 항목 수
+
+	// 새로 읽은 메타데이터 인덱스의 크기
+	Tables   int
+	Columns  int
+	LoadedAt time.Time
+}
+
+// NormalizeSQL은 캐시 키를 만들기 위해 SQL을 정규화합니다.
+//   - 따옴표 밖의 연속 공백/줄바꿈을 공백 하나로 합침
+//   - 앞뒤 공백과 끝의 세미콜론 제거
+//
+// 문자열 리터럴 안의 공백은 그대로 둡니다 ('a  b'와 'a b'는 다른 쿼리!).
+// 대소문자는 리터럴 때문에 바꾸지 않습니다.
+func NormalizeSQL(query string) string {
+	var b strings.Builder
+	b.Grow(len(query))
+
+	var quote rune // 현재 열린 따옴표 (' 또는 "), 0이면 따옴표 밖
+	pendingSpace := false
+
+	for _, r := range query {
+		if quote != 0 {
+			b.WriteRune(r)
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		if unicode.IsSpace(r) {
+			pendingSpace = true
+			continue
+		}
+
+		if pendingSpace && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		pendingSpace = false
+
+		if r == '\'' || r == '"' {
+			quote = r
+		}
+		b.WriteRune(r)
+	}
+
+	return strings.TrimRight(b.String(), "; ")
+}
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// 스키마 검색 관련 에러
+var (
+	ErrInvalidSearchPattern = errors.New("invalid search pattern")
+)
+
+// DatabaseCatalog는 DB 하나의 테이블/컬럼 이름 목록입니다 (메타데이터 인덱스).
+//
+// 스키마 검색, SQL 자동완성처럼 "이름만 빨리 찾으면 되는" 기능이
+// 매번 DB 카탈로그를 조회하지 않도록 캐시에 담아 두고 씁니다.
+// 이름은 모두 DisplayIdentifier로 정규화된 값입니다.
+type DatabaseCatalog struct {
+	DatabaseID   string
+	DatabaseType DatabaseType
+	LoadedAt     time.Time
+
+	// CurrentSchema는 카탈로그를 읽은 세션의 현재 스키마입니다.
+	// 스키마 없이 쓴 테이블 이름(FROM student)이 어느 스키마인지 판단할 때 씁니다.
+	CurrentSchema string
+
+	Tables []CatalogTable // 스키마, 이름순
+}
+
+// CatalogTable은 카탈로그의 테이블(또는 뷰) 하나입니다.
+type CatalogTable struct {
+	Schema  string
+	Name    string
+	Type    TableType
+	Comment string
+	Columns []CatalogColumn // 컬럼 순서대로
+}
+
+// CatalogColumn은 카탈로그의 컬럼 하나입니다.
+type CatalogColumn struct {
+	Name     string
+	DataType string
+	Comment  string
+}
+
+// ColumnCount는 카탈로그의 전체 컬럼 수입니다.
+func (c *DatabaseCatalog) ColumnCount() int {
+	count := 0
+	for _, t := range c.Tables {
+		count += len(t.Columns)
+	}
+	return count
+}
+
+// FindSchema는 카탈로그에서 스키마 이름을 찾습니다.
+// 정확히 같은 이름을 먼저 찾고, 없으면 대소문자를 무시하고 찾습니다.
+func (c *DatabaseCatalog) FindSchema(name string) (string, bool) {
+	found := ""
+	for _, t := range c.Tables {
+		if t.Schema == name {
+			return t.Schema, true
+		}
+		if found == "" && strings.EqualFold(t.Schema, name) {
+			found = t.Schema
+		}
+	}
+	return found, found != ""
+}
+
+// FindTable은 스키마의 테이블을 찾습니다 (FindSchema와 같은 규칙).
+func (c *DatabaseCatalog) FindTable(schema string, name string) (*CatalogTable, bool) {
+	var found *CatalogTable
+	for i := range c.Tables {
+		t := &c.Tables[i]
+		if t.Schema != schema {
+			continue
+		}
+		if t.Name == name {
+			return t, true
+		}
+		if found == nil && strings.EqualFold(t.Name, name) {
+			found = t
+		}
+	}
+	return found, found != nil
+}
+
+// SearchField는 검색 대상 항목입니다.
+type SearchField string
+
+const (
+	SearchTableName     SearchField = "table"
+	SearchColumnName    SearchField = "column"
+	SearchTableComment  SearchField = "table_comment"
+	SearchColumnComment SearchField = "column_comment"
+)
+
+// SchemaSearchQuery는 스키마 검색 조건입니다.
+type SchemaSearchQuery struct {
+	Pattern string
+
+	// Regex가 false면 부분 문자열 검색입니다.
+	Regex bool
+
+	// CaseSensitive가 false면 대소문자를 구분하지 않습니다 (기본값).
+	CaseSensitive bool
+
+	// Fields가 비어 있으면 테이블/컬럼 이름과 주석을 모두 검색합니다.
+	Fields []SearchField
+
+	// DatabaseIDs가 비어 있으면 연결된 모든 DB를 검색합니다.
+	DatabaseIDs []string
+
+	// Limit은 최대 결과 수입니다 (0이면 기본값).
+	Limit int
+}
+
+// SchemaSearchMatch는 검색 결과 하나입니다.
+// 테이블 이름/주석이 맞으면 Column이 비어 있습니다.
+type SchemaSearchMatch struct {
+	DatabaseID   string
+	DatabaseType DatabaseType
+	Schema       string
+	Table        string
+	TableType    TableType
+	Column       string
+	DataType     string
+	Comment      string
+	MatchedField SearchField
+}
+
+// SchemaSearchResult는 검색 결과 전체입니다.
+type SchemaSearchResult struct {
+	Matches   []SchemaSearchMatch
+	Truncated bool // Limit에서 잘림
+
+	// Searched는 검색한 DB 수, Errors는 카탈로그를 읽지 못한 DB입니다.
+	// DB 하나가 실패해도 나머지 결과는 돌려줍니다.
+	Searched int
+	Errors   []SearchError
+}
+
+// SearchError는 검색하지 못한 DB와 이유입니다.
+type SearchError struct {
+	DatabaseID string
+	Message    string
+}
+
+// SchemaMatcher는 컴파일된 검색 조건입니다.
+type SchemaMatcher struct {
+	match  func(string) bool
+	fields map[SearchField]bool
+}
+
+// NewSchemaMatcher는 검색 조건을 검사하고 비교 함수를 만듭니다.
+func NewSchemaMatcher(query SchemaSearchQuery) (*SchemaMatcher, error) {
+	if strings.TrimSpace(query.Pattern) == "" {
+		return nil, fmt.Errorf("%w: pattern is required", ErrInvalidSearchPattern)
+	}
+
+	m := &SchemaMatcher{fields: make(map[SearchField]bool)}
+
+	if len(query.Fields) == 0 {
+		query.Fields = []SearchField{SearchTableName, SearchColumnName, SearchTableComment, SearchColumnComment}
+	}
+	for _, f := range query.Fields {
+		switch f {
+		case SearchTableName, SearchColumnName, SearchTableComment, SearchColumnComment:
+			m.fields[f] = true
+		default:
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidSearchPattern, f)
+		}
+	}
+
+	switch {
+	case query.Regex:
+		expr := query.Pattern
+		if !query.CaseSensitive {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSearchPattern, err)
+		}
+		m.match = re.MatchString
+
+	case query.CaseSensitive:
+		m.match = func(s string) bool { return strings.Contains(s, query.Pattern) }
+
+	default:
+		pattern := strings.ToLower(query.Pattern)
+		m.match = func(s string) bool { return strings.Contains(strings.ToLower(s), pattern) }
+	}
+
+	return m, nil
+}
+
+// Search는 카탈로그에서 조건에 맞는 테이블/컬럼을 찾습니다.
+// limit을 넘으면 멈추고 truncated=true를 반환합니다 (limit이 0 이하면 제한 없음).
+func (m *SchemaMatcher) Search(catalog *DatabaseCatalog, limit int) (matches []SchemaSearchMatch, truncated bool) {
+	add := func(match SchemaSearchMatch) bool {
+		if limit > 0 && len(matches) >= limit {
+			truncated = true
+			return false
+		}
+		match.DatabaseID = catalog.DatabaseID
+		match.DatabaseType = catalog.DatabaseType
+		matches = append(matches, match)
+		return true
+	}
+
+	for _, t := range catalog.Tables {
+		table := SchemaSearchMatch{Schema: t.Schema, Table: t.Name, TableType: t.Type, Comment: t.Comment}
+
+		switch {
+		case m.fields[SearchTableName] && m.match(t.Name):
+			table.MatchedField = SearchTableName
+		case m.fields[SearchTableComment] && t.Comment != "" && m.match(t.Comment):
+			table.MatchedField = SearchTableComment
+		}
+		if table.MatchedField != "" && !add(table) {
+			return matches, truncated
+		}
+
+		for _, c := range t.Columns {
+			column := SchemaSearchMatch{
+				Schema:    t.Schema,
+				Table:     t.Name,
+				TableType: t.Type,
+				Column:    c.Name,
+				DataType:  c.DataType,
+				Comment:   c.Comment,
+			}
+
+			switch {
+			case m.fields[SearchColumnName] && m.match(c.Name):
+				column.MatchedField = SearchColumnName
+			case m.fields[SearchColumnComment] && c.Comment != "" && m.match(c.Comment):
+				column.MatchedField = SearchColumnComment
+			default:
+				continue
+			}
+			if !add(column) {
+				return matches, truncated
+			}
+		}
+	}
+
+	return matches, truncated
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// 주석 편집 관련 에러
+var (
+	ErrCommentTooLong = errors.New("comment too long")
+)
+
+// Oracle 주석 최대 길이 (바이트, all_tab_comments.comments가 VARCHAR2(4000))
+const oracleMaxCommentBytes = 4000
+
+// CommentChange는 테이블 또는 컬럼 주석 변경 요청입니다.
+type CommentChange struct {
+	Schema string // 비어 있으면 DB 기본 스키마
+	Table  string
+	Column string // 비어 있으면 테이블 주석
+
+	// Comment는 새 주석입니다 (빈 문자열이면 주석을 지움).
+	Comment string
+
+	// Author는 변경한 사람입니다 (변경 이력에 남고, 쓰기 권한 검사에 씁니다).
+	Author string
+}
+
+// CommentTarget은 주석을 달 DB 객체입니다. 이름은 카탈로그에 저장된 그대로입니다.
+type CommentTarget struct {
+	Schema    string
+	Table     string
+	TableType TableType // Postgres는 뷰/머티리얼라이즈드 뷰에 COMMENT ON TABLE을 쓸 수 없음
+	Column    string    // 비어 있으면 테이블 주석
+}
+
+// CommentStatement는 DB 방언에 맞는 COMMENT ON 문을 만듭니다.
+//
+//	Postgres: COMMENT ON TABLE hr.employees IS '직원'
+//	          COMMENT ON VIEW hr.active_users IS NULL        (지우기)
+//	Oracle:   COMMENT ON COLUMN hr.employees.emp_no IS '사번'
+//	          COMMENT ON TABLE hr.employees IS ''            (지우기, Oracle은 IS NULL 불가)
+//
+// 이름은 QuoteCatalogIdentifier로 감싸므로 대소문자가 섞인 이름, 예약어도 그대로 가리킵니다.
+func CommentStatement(dbType DatabaseType, target CommentTarget, comment string) (string, error) {
+	dialect := DialectOf(dbType)
+	if dialect == "" {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+	if dialect == DialectOracle && len(comment) > oracleMaxCommentBytes {
+		return "", fmt.Errorf("%w: %d bytes (Oracle allows %d)", ErrCommentTooLong, len(comment), oracleMaxCommentBytes)
+	}
+
+	name := QuoteCatalogIdentifier(dbType, target.Table)
+	if target.Schema != "" {
+		name = QuoteCatalogIdentifier(dbType, target.Schema) + "." + name
+	}
+
+	object := "TABLE"
+	if target.Column != "" {
+		object = "COLUMN"
+		name += "." + QuoteCatalogIdentifier(dbType, target.Column)
+	} else {
+		switch target.TableType {
+		case TableTypeMaterializedView:
+			object = "MATERIALIZED VIEW"
+		case TableTypeView:
+			if dialect == DialectPostgres {
+				object = "VIEW"
+			}
+		case TableTypeForeignTable:
+			if dialect == DialectPostgres {
+				object = "FOREIGN TABLE"
+			}
+		}
+	}
+
+	value := QuoteLiteral(comment)
+	if comment == "" && dialect == DialectPostgres {
+		value = "NULL"
+	}
+
+	return fmt.Sprintf("COMMENT ON %s %s IS %s", object, name, value), nil
+}
+
+// ChangeKind는 변경 이력의 종류입니다.
+type ChangeKind string
+
+const (
+	ChangeTableComment  ChangeKind = "table_comment"
+	ChangeColumnComment ChangeKind = "column_comment"
+)
+
+// ChangeLogEntry는 DMS로 바꾼 내용 하나의 이력입니다 (누가, 언제, 무엇을).
+// 이름은 DisplayIdentifier로 정규화된 값입니다.
+type ChangeLogEntry struct {
+	ID         string // 저장소가 붙이는 고유 ID
+	DatabaseID string
+	Schema     string
+	Table      string
+	Column     string // 테이블 주석이면 빈 문자열
+
+	Kind      ChangeKind
+	Author    string
+	ChangedAt time.Time
+
+	Before    string // 바꾸기 전 값 (주석이 없었으면 빈 문자열, row는 JSON)
+	After     string // 바꾼 뒤 값 (지웠으면 빈 문자열, row는 JSON)
+	Statement string // 실행한 SQL
+}
+
+// ChangeLogQuery는 변경 이력 조회 조건입니다.
+type ChangeLogQuery struct {
+	DatabaseID string
+	Schema     string // 비어 있으면 전체
+	Table      string // 비어 있으면 전체
+	Author     string // 비어 있으면 전체
+	Since      time.Time
+	Limit      int // 0 이하면 기본값 (100)
+}
+
+// DefaultChangeLogLimit는 변경 이력 조회의 기본 개수입니다.
+const DefaultChangeLogLimit = 100
+
+// Matches는 이력이 조회 조건에 맞는지 확인합니다 (이름은 대소문자 무시).
+func (q ChangeLogQuery) Matches(entry ChangeLogEntry) bool {
+	switch {
+	case q.DatabaseID != "" && entry.DatabaseID != q.DatabaseID:
+		return false
+	case q.Schema != "" && !strings.EqualFold(entry.Schema, q.Schema):
+		return false
+	case q.Table != "" && !strings.EqualFold(entry.Table, q.Table):
+		return false
+	case q.Author != "" && !strings.EqualFold(entry.Author, q.Author):
+		return false
+	case !q.Since.IsZero() && entry.ChangedAt.Before(q.Since):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,735 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// 자동완성 관련 에러
+var (
+	ErrInvalidCursor = errors.New("invalid cursor position")
+)
+
+// CompletionKind는 자동완성 후보의 종류입니다.
+type CompletionKind string
+
+const (
+	CompletionSchema   CompletionKind = "schema"
+	CompletionTable    CompletionKind = "table"
+	CompletionView     CompletionKind = "view"
+	CompletionAlias    CompletionKind = "alias"
+	CompletionColumn   CompletionKind = "column"
+	CompletionFunction CompletionKind = "function"
+	CompletionKeyword  CompletionKind = "keyword"
+)
+
+// CompletionContext는 커서 위치에서 기대하는 것입니다.
+type CompletionContext string
+
+const (
+	CompletionNone      CompletionContext = "none"      // 문자열/주석 안 (후보 없음)
+	CompletionStatement CompletionContext = "statement" // 문장 시작 (SELECT, INSERT ...)
+	CompletionTableName CompletionContext = "table"     // FROM, JOIN, UPDATE, INTO 뒤
+	CompletionExpr      CompletionContext = "column"    // SELECT 목록, WHERE, ON, ORDER BY ...
+	CompletionQualified CompletionContext = "qualified" // alias. 또는 schema. 뒤
+	CompletionClause    CompletionContext = "keyword"   // 테이블 이름 뒤 (WHERE, JOIN ...)
+)
+
+// defaultCompletionLimit은 자동완성 후보의 기본 최대 개수입니다.
+const defaultCompletionLimit = 100
+
+// CompletionRequest는 자동완성 요청입니다.
+type CompletionRequest struct {
+	SQL string
+
+	// Cursor는 커서 위치입니다 (바이트가 아니라 문자 단위, 0 = 맨 앞).
+	Cursor int
+
+	// Schema는 스키마 없이 쓴 테이블 이름의 기준 스키마입니다 (비어 있으면 현재 스키마).
+	Schema string
+
+	// Limit은 최대 후보 수입니다 (0이면 기본값).
+	Limit int
+}
+
+// CompletionItem은 자동완성 후보 하나입니다.
+type CompletionItem struct {
+	Label string
+	Kind  CompletionKind
+
+	// Detail은 보조 정보입니다 (컬럼: 타입, alias: 테이블 이름, 테이블: 주석).
+	Detail string
+
+	// InsertText는 에디터에 넣을 문자열입니다.
+	// 대소문자가 섞인 이름이나 예약어는 큰따옴표로 감싸져 있습니다.
+	InsertText string
+}
+
+// CompletionResult는 자동완성 결과입니다.
+//
+// 에디터는 [ReplaceStart, ReplaceEnd) 구간(문자 단위)을 고른 후보의 InsertText로 바꾸면 됩니다.
+// 커서가 단어 중간에 있으면 단어 전체가 구간입니다.
+type CompletionResult struct {
+	DatabaseID   string
+	Context      CompletionContext
+	Prefix       string // 커서 앞까지 입력한 단어
+	ReplaceStart int
+	ReplaceEnd   int
+	Items        []CompletionItem
+	Truncated    bool // Limit에서 잘림
+}
+
+// TableReference는 문장에서 찾은 테이블 참조입니다 (FROM student s).
+type TableReference struct {
+	Schema string
+	Name   string
+	Alias  string
+
+	// Derived는 서브쿼리나 CTE처럼 카탈로그에 없는 테이블입니다 (컬럼을 알 수 없음).
+	Derived bool
+}
+
+// CompleteSQL은 카탈로그(메타데이터 인덱스)로 커서 위치의 자동완성 후보를 만듭니다.
+//
+// 동작 순서:
+//  1. TokenizeSQL로 토큰을 나누고, 커서가 있는 문장(; 사이)만 봅니다.
+//  2. 커서 앞의 단어(Prefix)와 "alias." 같은 한정자를 찾습니다.
+//  3. 문장 전체(커서 뒤 포함)에서 FROM/JOIN/UPDATE/INTO 뒤의 테이블과 alias를 모읍니다.
+//     "SELECT | FROM student s"처럼 FROM을 나중에 써도 컬럼을 제안할 수 있습니다.
+//  4. 커서 앞의 마지막 절 키워드로 무엇을 제안할지 정합니다 (괄호 깊이별로 따로 추적).
+//
+// 스키마 없이 쓴 테이블 이름은 defaultSchema에서 찾습니다 (req.Schema는 서비스에서 해석).
+// 구문 분석기가 아니므로 서브쿼리 안팎의 테이블을 구분하지 않고 모두 범위에 넣습니다.
+func CompleteSQL(catalog *DatabaseCatalog, defaultSchema string, req CompletionRequest) (*CompletionResult, error) {
+	pos, ok := byteOffset(req.SQL, req.Cursor)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d (length %d)", ErrInvalidCursor, req.Cursor, utf8.RuneCountInString(req.SQL))
+	}
+
+	result := &CompletionResult{
+		DatabaseID:   catalog.DatabaseID,
+		Context:      CompletionNone,
+		ReplaceStart: req.Cursor,
+		ReplaceEnd:   req.Cursor,
+	}
+
+	// 1. 커서 위치의 토큰 찾기 (주석은 건너뜀)
+	var code []SQLToken
+	cursorAt := -1 // code에서 Prefix 토큰(또는 커서 뒤 첫 토큰)의 위치
+	hasPrefix := false
+
+	for _, t := range TokenizeSQL(req.SQL) {
+		inside := t.Start < pos && (pos < t.End || !t.Closed || t.Kind == TokenLineComment && pos == t.End)
+
+		if t.IsComment() {
+			if inside {
+				return result, nil
+			}
+			continue
+		}
+
+		if cursorAt < 0 {
+			switch {
+			case inside && t.Kind == TokenString:
+				return result, nil
+			case t.Start < pos && pos <= t.End && t.IsIdentifier():
+				cursorAt = len(code)
+				hasPrefix = true
+				result.Prefix = req.SQL[t.Start:pos]
+				result.ReplaceStart = utf8.RuneCountInString(req.SQL[:t.Start])
+				result.ReplaceEnd = utf8.RuneCountInString(req.SQL[:t.End])
+			case t.Start >= pos:
+				cursorAt = len(code)
+			}
+		}
+
+		code = append(code, t)
+	}
+	if cursorAt < 0 {
+		cursorAt = len(code)
+	}
+
+	// 커서가 있는 문장만
+	start, end := 0, len(code)
+	for k := cursorAt - 1; k >= 0; k-- {
+		if code[k].Is(";") {
+			start = k + 1
+			break
+		}
+	}
+	for k := cursorAt; k < len(code); k++ {
+		if code[k].Is(";") {
+			end = k
+			break
+		}
+	}
+	stmt := code[start:end]
+	cursorAt -= start
+
+	// 2. 한정자 (schema.table.| 에서 [schema, table])
+	var qualifier []string
+	for k := cursorAt - 1; k >= 1 && stmt[k].Is(".") && stmt[k-1].IsIdentifier(); k -= 2 {
+		qualifier = append([]string{stmt[k-1].Identifier()}, qualifier...)
+	}
+	if len(qualifier) > 2 {
+		qualifier = qualifier[len(qualifier)-2:]
+	}
+
+	// 3. 테이블 참조 (입력 중인 단어는 빼고)
+	scope := stmt
+	if hasPrefix {
+		scope = append(append([]SQLToken{}, stmt[:cursorAt]...), stmt[cursorAt+1:]...)
+	}
+	refs, ctes := parseTableReferences(scope)
+
+	// 4. 문맥 판단
+	quoted := strings.HasPrefix(result.Prefix, `"`)
+	c := &completer{
+		catalog:       catalog,
+		dialect:       DialectOf(catalog.DatabaseType),
+		defaultSchema: defaultSchema,
+		refs:          refs,
+		prefix:        strings.TrimPrefix(result.Prefix, `"`),
+		quoted:        quoted,
+		seen:          make(map[string]bool),
+	}
+
+	switch {
+	case len(qualifier) > 0:
+		result.Context = CompletionQualified
+		c.qualified(qualifier)
+
+	default:
+		result.Context = completionContext(stmt[:cursorAt])
+		switch result.Context {
+		case CompletionTableName:
+			c.tableNames(ctes)
+		case CompletionExpr:
+			c.expressions()
+		default:
+			c.keywords(result.Context)
+		}
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultCompletionLimit
+	}
+	result.Items = c.items
+	if len(result.Items) > limit {
+		result.Items = result.Items[:limit]
+		result.Truncated = true
+	}
+
+	return result, nil
+}
+
+// byteOffset은 문자 단위 위치를 바이트 위치로 바꿉니다.
+func byteOffset(s string, runes int) (int, bool) {
+	if runes < 0 {
+		return 0, false
+	}
+	for i := range s {
+		if runes == 0 {
+			return i, true
+		}
+		runes--
+	}
+	return len(s), runes == 0
+}
+
+// 절 키워드 → 커서가 그 절 안에 있을 때 기대하는 것
+var clauseContexts = map[string]CompletionContext{
+	"SELECT": CompletionExpr, "WHERE": CompletionExpr, "ON": CompletionExpr, "BY": CompletionExpr,
+	"HAVING": CompletionExpr, "SET": CompletionExpr, "RETURNING": CompletionExpr, "VALUES": CompletionExpr,
+	"WHEN": CompletionExpr, "THEN": CompletionExpr, "ELSE": CompletionExpr,
+	"FROM": CompletionTableName, "JOIN": CompletionTableName, "UPDATE": CompletionTableName,
+	"INTO": CompletionTableName, "USING": CompletionTableName, "TABLE": CompletionTableName,
+}
+
+// completionContext는 커서 앞 토큰으로 문맥을 판단합니다.
+//
+// 괄호마다 절을 따로 추적합니다. 새 괄호는 바깥 절을 이어받되,
+// FROM ( 뒤는 서브쿼리 시작(문장 시작)으로, INSERT INTO t ( 뒤는 컬럼 목록으로 봅니다.
+func completionContext(tokens []SQLToken) CompletionContext {
+	type frame struct {
+		clause  string
+		context CompletionContext
+	}
+	stack := []frame{{context: CompletionStatement}}
+
+	for k, t := range tokens {
+		top := &stack[len(stack)-1]
+
+		switch {
+		case t.Is("("):
+			next := frame{context: top.context}
+			switch top.clause {
+			case "FROM", "JOIN":
+				next.context = CompletionStatement
+			case "INTO", "USING", "TABLE":
+				next.context = CompletionExpr
+			}
+			stack = append(stack, next)
+
+		case t.Is(")"):
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+
+		case t.Kind == TokenWord:
+			word := strings.ToUpper(t.Text)
+			context, ok := clauseContexts[word]
+			if !ok || word == "TABLE" && k > 0 && tokens[k-1].Is("CREATE") {
+				continue
+			}
+			top.clause = word
+			top.context = context
+		}
+	}
+
+	top := stack[len(stack)-1]
+	if top.context != CompletionTableName || len(tokens) == 0 {
+		return top.context
+	}
+
+	// FROM 바로 뒤나 쉼표 뒤면 테이블 이름, 테이블 이름까지 썼으면 다음 키워드
+	last := tokens[len(tokens)-1]
+	if last.Is(top.clause) || last.Is(",") || last.Is("ONLY") || last.Is("LATERAL") {
+		return CompletionTableName
+	}
+	return CompletionClause
+}
+
+// tableTerminators는 테이블 참조 뒤에 올 수 있는 키워드입니다 (alias로 보지 않음).
+var tableTerminators = map[string]bool{
+	"WHERE": true, "JOIN": true, "INNER": true, "LEFT": true, "RIGHT": true, "FULL": true,
+	"CROSS": true, "NATURAL": true, "OUTER": true, "ON": true, "USING": true, "GROUP": true,
+	"ORDER": true, "HAVING": true, "LIMIT": true, "OFFSET": true, "FETCH": true, "UNION": true,
+	"INTERSECT": true, "EXCEPT": true, "MINUS": true, "SET": true, "VALUES": true,
+	"SELECT": true, "WINDOW": true, "FOR": true, "RETURNING": true, "CONNECT": true,
+	"START": true, "WHEN": true, "PARTITION": true, "SAMPLE": true, "TABLESAMPLE": true,
+	"DEFAULT": true, "AS": true, "WITH": true, "LATERAL": true,
+}
+
+// parseTableReferences는 FROM/JOIN/UPDATE/INTO/USING 뒤의 테이블과 WITH의 CTE 이름을 모읍니다.
+func parseTableReferences(tokens []SQLToken) ([]TableReference, []string) {
+	var (
+		refs []TableReference
+		ctes []string
+	)
+
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.Is("WITH"):
+			var names []string
+			names, i = parseCTEs(tokens, i+1)
+			ctes = append(ctes, names...)
+			i--
+		case t.Is("FROM"), t.Is("JOIN"), t.Is("UPDATE"), t.Is("INTO"), t.Is("USING"):
+			var found []TableReference
+			found, i = parseTableList(tokens, i+1, t.Is("FROM"))
+			refs = append(refs, found...)
+			i--
+		}
+	}
+
+	// FROM에 쓴 CTE 이름은 카탈로그에 없는 테이블
+	for k := range refs {
+		for _, cte := range ctes {
+			if refs[k].Schema == "" && strings.EqualFold(refs[k].Name, cte) {
+				refs[k].Derived = true
+			}
+		}
+	}
+
+	return refs, ctes
+}
+
+// parseTableList는 i부터 "name [AS] alias, ..."를 읽고 다음 위치를 반환합니다.
+// list가 false면 (JOIN, UPDATE 등) 하나만 읽습니다.
+func parseTableList(tokens []SQLToken, i int, list bool) ([]TableReference, int) {
+	var refs []TableReference
+
+	for i < len(tokens) {
+		var ref TableReference
+
+		if tokens[i].Is("ONLY") || tokens[i].Is("LATERAL") {
+			i++
+			continue
+		}
+
+		switch t := tokens[i]; {
+		case t.Is("("):
+			// (SELECT ...) alias만 테이블로 봄 (INSERT INTO t (a, b)나 USING (id)는 아님)
+			if i+1 >= len(tokens) || !(tokens[i+1].Is("SELECT") || tokens[i+1].Is("WITH")) {
+				return refs, i
+			}
+			i = skipParens(tokens, i)
+			ref.Derived = true
+
+		case t.IsIdentifier() && !(t.Kind == TokenWord && tableTerminators[strings.ToUpper(t.Text)]):
+			parts := []string{t.Identifier()}
+			i++
+			for i+1 < len(tokens) && tokens[i].Is(".") && tokens[i+1].IsIdentifier() {
+				parts = append(parts, tokens[i+1].Identifier())
+				i += 2
+			}
+			if len(parts) > 1 {
+				ref.Schema = parts[len(parts)-2]
+			}
+			ref.Name = parts[len(parts)-1]
+
+		default:
+			return refs, i
+		}
+
+		if i < len(tokens) && tokens[i].Is("AS") {
+			i++
+		}
+		if i < len(tokens) && tokens[i].IsIdentifier() &&
+			!(tokens[i].Kind == TokenWord && tableTerminators[strings.ToUpper(tokens[i].Text)]) {
+			ref.Alias = tokens[i].Identifier()
+			i++
+		}
+		refs = append(refs, ref)
+
+		if !list || i >= len(tokens) || !tokens[i].Is(",") {
+			break
+		}
+		i++
+	}
+
+	return refs, i
+}
+
+// parseCTEs는 WITH 뒤의 "name [(cols)] AS [NOT] [MATERIALIZED] (...)" 목록을 읽습니다.
+func parseCTEs(tokens []SQLToken, i int) ([]string, int) {
+	var names []string
+
+	if i < len(tokens) && tokens[i].Is("RECURSIVE") {
+		i++
+	}
+
+	for i < len(tokens) && tokens[i].IsIdentifier() {
+		name := tokens[i].Identifier()
+		i++
+		if i < len(tokens) && tokens[i].Is("(") {
+			i = skipParens(tokens, i)
+		}
+		if i >= len(tokens) || !tokens[i].Is("AS") {
+			break
+		}
+		i++
+		for i < len(tokens) && (tokens[i].Is("NOT") || tokens[i].Is("MATERIALIZED")) {
+			i++
+		}
+		names = append(names, name)
+		if i < len(tokens) && tokens[i].Is("(") {
+			i = skipParens(tokens, i)
+		}
+		if i >= len(tokens) || !tokens[i].Is(",") {
+			break
+		}
+		i++
+	}
+
+	return names, i
+}
+
+// skipParens는 tokens[i]의 여는 괄호와 짝이 맞는 닫는 괄호 다음 위치를 반환합니다.
+func skipParens(tokens []SQLToken, i int) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		switch {
+		case tokens[i].Is("("):
+			depth++
+		case tokens[i].Is(")"):
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+// completer는 후보를 모읍니다 (같은 후보는 한 번만).
+type completer struct {
+	catalog       *DatabaseCatalog
+	dialect       SQLDialect
+	defaultSchema string
+	refs          []TableReference
+	prefix        string
+	quoted        bool // "Mixed 처럼 따옴표로 시작
+	seen          map[string]bool
+	items         []CompletionItem
+}
+
+// qualified는 "x." 뒤의 후보입니다.
+// x가 alias나 문장의 테이블이면 그 컬럼, 스키마면 그 스키마의 테이블입니다.
+func (c *completer) qualified(qualifier []string) {
+	if len(qualifier) == 2 {
+		if schema, ok := c.catalog.FindSchema(qualifier[0]); ok {
+			if table, ok := c.catalog.FindTable(schema, qualifier[1]); ok {
+				c.columns(table)
+			}
+		}
+		return
+	}
+
+	// alias가 먼저, 그다음 alias 없이 쓴 테이블 이름
+	// (FROM sales.| 처럼 입력 중인 스키마 이름도 테이블 참조로 읽히므로 카탈로그에 있을 때만)
+	name := qualifier[0]
+	for _, ref := range c.refs {
+		if strings.EqualFold(ref.Alias, name) {
+			if table := c.lookup(ref); table != nil && !ref.Derived {
+				c.columns(table)
+			}
+			return
+		}
+	}
+	for _, ref := range c.refs {
+		if ref.Alias == "" && !ref.Derived && strings.EqualFold(ref.Name, name) {
+			if table := c.lookup(ref); table != nil {
+				c.columns(table)
+				return
+			}
+		}
+	}
+
+	if schema, ok := c.catalog.FindSchema(name); ok {
+		c.tables(schema)
+		return
+	}
+
+	if table := c.lookup(TableReference{Name: name}); table != nil {
+		c.columns(table)
+	}
+}
+
+// tableNames는 FROM/JOIN 뒤의 후보입니다 (CTE, 기본 스키마의 테이블, 스키마).
+func (c *completer) tableNames(ctes []string) {
+	for _, cte := range ctes {
+		c.add(CompletionItem{Label: cte, Kind: CompletionTable, Detail: "cte", InsertText: c.identifier(cte)})
+	}
+	if c.defaultSchema != "" {
+		if schema, ok := c.catalog.FindSchema(c.defaultSchema); ok {
+			c.tables(schema)
+		}
+	}
+	c.schemas()
+}
+
+// expressions는 SELECT 목록, WHERE 등의 후보입니다
+// (범위 안 테이블의 컬럼 → alias/테이블 → 함수 → 키워드).
+func (c *completer) expressions() {
+	var tableItems []CompletionItem
+
+	for _, ref := range c.refs {
+		if ref.Derived {
+			switch {
+			case ref.Alias != "":
+				tableItems = append(tableItems, CompletionItem{Label: ref.Alias, Kind: CompletionAlias, Detail: "subquery"})
+			case ref.Name != "":
+				tableItems = append(tableItems, CompletionItem{Label: ref.Name, Kind: CompletionTable, Detail: "cte"})
+			}
+			continue
+		}
+
+		table := c.lookup(ref)
+		if table != nil {
+			c.columns(table)
+		}
+		if ref.Alias != "" {
+			tableItems = append(tableItems, CompletionItem{Label: ref.Alias, Kind: CompletionAlias, Detail: ref.Name})
+		} else if table != nil {
+			tableItems = append(tableItems, CompletionItem{Label: table.Name, Kind: tableKind(table.Type), Detail: table.Comment})
+		}
+	}
+
+	for _, item := range tableItems {
+		item.InsertText = c.identifier(item.Label)
+		c.add(item)
+	}
+
+	c.words(completionFunctions[c.dialect], CompletionFunction)
+	c.keywords(CompletionExpr)
+}
+
+// keywords는 방언의 키워드 후보입니다.
+func (c *completer) keywords(context CompletionContext) {
+	switch context {
+	case CompletionStatement:
+		c.words(statementKeywords, CompletionKeyword)
+	case CompletionClause:
+		c.words(clauseKeywords, CompletionKeyword)
+		c.words(dialectClauseKeywords[c.catalog.DatabaseType], CompletionKeyword)
+	case CompletionExpr:
+		c.words(expressionKeywords, CompletionKeyword)
+		c.words(clauseKeywords, CompletionKeyword)
+	}
+}
+
+// words는 키워드/함수 목록을 후보로 추가합니다.
+// 소문자로 입력하고 있으면 소문자로 넣습니다.
+func (c *completer) words(words []string, kind CompletionKind) {
+	if c.quoted {
+		return
+	}
+	lower := c.prefix != "" && c.prefix == strings.ToLower(c.prefix)
+
+	sorted := append([]string{}, words...)
+	sort.Strings(sorted)
+	for _, word := range sorted {
+		if lower {
+			word = strings.ToLower(word)
+		}
+		c.add(CompletionItem{Label: word, Kind: kind, InsertText: word})
+	}
+}
+
+// tables는 스키마의 테이블/뷰 후보입니다.
+func (c *completer) tables(schema string) {
+	for _, t := range c.catalog.Tables {
+		if t.Schema == schema {
+			c.add(CompletionItem{Label: t.Name, Kind: tableKind(t.Type), Detail: t.Comment, InsertText: c.identifier(t.Name)})
+		}
+	}
+}
+
+// schemas는 스키마 후보입니다.
+func (c *completer) schemas() {
+	for _, t := range c.catalog.Tables {
+		c.add(CompletionItem{Label: t.Schema, Kind: CompletionSchema, InsertText: c.identifier(t.Schema)})
+	}
+}
+
+// columns는 테이블의 컬럼 후보입니다 (컬럼 순서대로).
+func (c *completer) columns(table *CatalogTable) {
+	for _, col := range table.Columns {
+		c.add(CompletionItem{Label: col.Name, Kind: CompletionColumn, Detail: col.DataType, InsertText: c.identifier(col.Name)})
+	}
+}
+
+// lookup은 테이블 참조를 카탈로그에서 찾습니다.
+// 스키마가 없으면 기본 스키마에서, 거기에도 없으면 이름이 하나뿐인 다른 스키마의 테이블을 씁니다.
+func (c *completer) lookup(ref TableReference) *CatalogTable {
+	schema := ref.Schema
+	if schema == "" {
+		schema = c.defaultSchema
+	}
+	if found, ok := c.catalog.FindSchema(schema); ok {
+		if table, ok := c.catalog.FindTable(found, ref.Name); ok {
+			return table
+		}
+	}
+	if ref.Schema != "" {
+		return nil
+	}
+
+	var match *CatalogTable
+	for i := range c.catalog.Tables {
+		if strings.EqualFold(c.catalog.Tables[i].Name, ref.Name) {
+			if match != nil {
+				return nil
+			}
+			match = &c.catalog.Tables[i]
+		}
+	}
+	return match
+}
+
+// add는 입력 중인 단어로 시작하는 후보만 추가합니다.
+// 따옴표로 시작했으면 저장된 이름 그대로(대소문자 구분), 아니면 대소문자 무시로 비교합니다.
+func (c *completer) add(item CompletionItem) {
+	if c.quoted {
+		if !strings.HasPrefix(c.storedName(item.Label), c.prefix) {
+			return
+		}
+	} else if !strings.HasPrefix(strings.ToLower(item.Label), strings.ToLower(c.prefix)) {
+		return
+	}
+
+	key := string(item.Kind) + "\x00" + item.Label + "\x00" + item.Detail
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+	c.items = append(c.items, item)
+}
+
+// identifier는 에디터에 넣을 식별자입니다.
+// 따옴표를 열고 입력 중이면 저장된 이름 그대로 따옴표로 감쌉니다.
+func (c *completer) identifier(name string) string {
+	if c.quoted {
+		return `"` + strings.ReplaceAll(c.storedName(name), `"`, `""`) + `"`
+	}
+	return QuoteIdentifier(name)
+}
+
+// storedName은 DisplayIdentifier로 정규화한 이름을 카탈로그에 저장된 모양으로 되돌립니다.
+func (c *completer) storedName(name string) string {
+	if IsPlainIdentifier(PostgreSQL, name) {
+		return FoldIdentifier(c.catalog.DatabaseType, name)
+	}
+	return name
+}
+
+func tableKind(t TableType) CompletionKind {
+	if t == TableTypeView || t == TableTypeMaterializedView {
+		return CompletionView
+	}
+	return CompletionTable
+}
+
+// statementKeywords는 문장을 시작하는 키워드입니다.
+var statementKeywords = []string{
+	"SELECT", "INSERT INTO", "UPDATE", "DELETE FROM", "MERGE INTO", "WITH",
+	"CREATE TABLE", "CREATE VIEW", "CREATE INDEX", "ALTER TABLE", "DROP TABLE",
+	"TRUNCATE TABLE", "COMMENT ON", "EXPLAIN",
+}
+
+// clauseKeywords는 테이블 이름 뒤에 오는 절 키워드입니다.
+var clauseKeywords = []string{
+	"WHERE", "JOIN", "INNER JOIN", "LEFT JOIN", "RIGHT JOIN", "FULL JOIN", "CROSS JOIN",
+	"ON", "USING", "GROUP BY", "ORDER BY", "HAVING", "UNION", "UNION ALL", "INTERSECT",
+	"SET", "VALUES", "AS",
+}
+
+// dialectClauseKeywords는 DB마다 다른 절 키워드입니다 (페이징 등).
+var dialectClauseKeywords = map[DatabaseType][]string{
+	PostgreSQL: {"LIMIT", "OFFSET", "EXCEPT", "RETURNING", "ON CONFLICT", "TABLESAMPLE", "FOR UPDATE"},
+	Oracle11g:  {"MINUS", "CONNECT BY", "START WITH", "SAMPLE", "FOR UPDATE"},
+	Oracle19c:  {"MINUS", "CONNECT BY", "START WITH", "SAMPLE", "FOR UPDATE", "OFFSET", "FETCH FIRST"},
+}
+
+// expressionKeywords는 식 안에서 쓰는 키워드입니다.
+var expressionKeywords = []string{
+	"AND", "OR", "NOT", "IN", "EXISTS", "LIKE", "BETWEEN", "IS NULL", "IS NOT NULL", "NULL",
+	"CASE", "WHEN", "THEN", "ELSE", "END", "DISTINCT", "FROM", "ASC", "DESC",
+}
+
+// completionFunctions는 방언별로 자주 쓰는 함수입니다.
+var completionFunctions = map[SQLDialect][]string{
+	DialectPostgres: {
+		"COUNT", "SUM", "AVG", "MIN", "MAX", "COALESCE", "NULLIF", "CAST", "GREATEST", "LEAST",
+		"UPPER", "LOWER", "TRIM", "LENGTH", "SUBSTRING", "REPLACE", "CONCAT", "POSITION",
+		"ROUND", "ABS", "FLOOR", "CEIL", "NOW", "CURRENT_DATE", "CURRENT_TIMESTAMP",
+		"DATE_TRUNC", "EXTRACT", "AGE", "TO_CHAR", "TO_DATE", "TO_TIMESTAMP",
+		"STRING_AGG", "ARRAY_AGG", "JSON_AGG", "JSONB_BUILD_OBJECT", "GENERATE_SERIES",
+		"ROW_NUMBER", "RANK", "DENSE_RANK", "LAG", "LEAD",
+	},
+	DialectOracle: {
+		"COUNT", "SUM", "AVG", "MIN", "MAX", "COALESCE", "NULLIF", "CAST", "GREATEST", "LEAST",
+		"UPPER", "LOWER", "TRIM", "LENGTH", "SUBSTR", "REPLACE", "INSTR", "LPAD", "RPAD",
+		"ROUND", "ABS", "FLOOR", "CEIL", "TRUNC", "MOD", "NVL", "NVL2", "DECODE",
+		"SYSDATE", "SYSTIMESTAMP", "ADD_MONTHS", "MONTHS_BETWEEN", "LAST_DAY", "EXTRACT",
+		"TO_CHAR", "TO_DATE", "TO_NUMBER", "TO_TIMESTAMP", "LISTAGG", "REGEXP_LIKE",
+		"REGEXP_SUBSTR", "REGEXP_REPLACE", "ROW_NUMBER", "RANK", "DENSE_RANK", "LAG", "LEAD",
+	},
+}
@@ -0,0 +1,487 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 데이터 사전 관련 에러
+var (
+	ErrInvalidDictionaryFormat = errors.New("invalid dictionary format")
+)
+
+// DictionaryFormat은 데이터 사전 문서 형식입니다.
+type DictionaryFormat string
+
+const (
+	DictionaryMarkdown DictionaryFormat = "markdown" // 위키, 저장소에 올리는 문서
+	DictionaryHTML     DictionaryFormat = "html"     // 브라우저로 열거나 PDF로 인쇄
+	DictionaryXLSX     DictionaryFormat = "xlsx"     // 감사 제출용 엑셀 (시트: Tables, Columns, Keys, Indexes)
+)
+
+// ParseDictionaryFormat은 문자열을 DictionaryFormat으로 바꿉니다 (비어 있으면 markdown).
+func ParseDictionaryFormat(value string) (DictionaryFormat, error) {
+	switch format := DictionaryFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "", "md":
+		return DictionaryMarkdown, nil
+	case DictionaryMarkdown, DictionaryHTML, DictionaryXLSX:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %s (use markdown, html or xlsx)", ErrInvalidDictionaryFormat, value)
+	}
+}
+
+// ContentType은 HTTP 응답의 Content-Type입니다.
+func (f DictionaryFormat) ContentType() string {
+	switch f {
+	case DictionaryHTML:
+		return "text/html; charset=utf-8"
+	case DictionaryXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "text/markdown; charset=utf-8"
+	}
+}
+
+// Extension은 파일 확장자입니다 (점 제외).
+func (f DictionaryFormat) Extension() string {
+	switch f {
+	case DictionaryHTML:
+		return "html"
+	case DictionaryXLSX:
+		return "xlsx"
+	default:
+		return "md"
+	}
+}
+
+// ParseTableTypes는 "table,view" 같은 쉼표 구분 문자열을 테이블 종류 목록으로 바꿉니다.
+// 빈 문자열이면 nil을 반환합니다.
+func ParseTableTypes(s string) ([]TableType, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var types []TableType
+	for _, part := range strings.Split(s, ",") {
+		switch t := TableType(strings.TrimSpace(part)); t {
+		case TableTypeTable, TableTypeView, TableTypeMaterializedView, TableTypeForeignTable:
+			types = append(types, t)
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidObjectType, t)
+		}
+	}
+	return types, nil
+}
+
+// DataDictionaryOptions는 데이터 사전에 넣을 범위입니다.
+//
+// 이름 패턴은 *, ? 와일드카드를 쓸 수 있고 대소문자를 구분하지 않습니다
+// (예: "stu_*", "*_bak"). 와일드카드가 없으면 그 이름 하나와 같습니다.
+type DataDictionaryOptions struct {
+	// Schemas는 스키마 이름 패턴입니다.
+	// 비어 있으면 DB 기본 스키마, "*"이면 접근할 수 있는 모든 스키마입니다.
+	Schemas []string
+
+	// Tables는 포함할 테이블 이름 패턴입니다 (비어 있으면 전체).
+	Tables []string
+
+	// Exclude는 뺄 테이블 이름 패턴입니다 (Tables보다 우선).
+	Exclude []string
+
+	// Types는 포함할 테이블 종류입니다 (비어 있으면 table만).
+	Types []TableType
+
+	Format DictionaryFormat
+}
+
+// Validate는 이름 패턴이 올바른지 확인합니다.
+func (o DataDictionaryOptions) Validate() error {
+	for _, patterns := range [][]string{o.Schemas, o.Tables, o.Exclude} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(strings.ToLower(pattern), ""); err != nil {
+				return fmt.Errorf("%w: %q: %v", ErrInvalidSearchPattern, pattern, err)
+			}
+		}
+	}
+	return nil
+}
+
+// IncludesTable은 테이블이 사전에 들어가는지 확인합니다.
+func (o DataDictionaryOptions) IncludesTable(table TableInfo) bool {
+	types := o.Types
+	if len(types) == 0 {
+		types = []TableType{TableTypeTable}
+	}
+	if !containsTableType(types, table.Type) {
+		return false
+	}
+
+	for _, pattern := range o.Exclude {
+		if MatchNamePattern(pattern, table.Name) {
+			return false
+		}
+	}
+
+	if len(o.Tables) == 0 {
+		return true
+	}
+	for _, pattern := range o.Tables {
+		if MatchNamePattern(pattern, table.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasWildcard는 이름 패턴에 와일드카드가 있는지 확인합니다.
+func HasWildcard(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// MatchNamePattern은 대소문자를 무시하고 이름이 패턴과 맞는지 확인합니다.
+// 잘못된 패턴은 맞지 않는 것으로 봅니다 (미리 Validate로 확인).
+func MatchNamePattern(pattern, name string) bool {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return err == nil && matched
+}
+
+func containsTableType(types []TableType, t TableType) bool {
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
+// DataDictionary는 감사 제출, 인수인계용 데이터 사전입니다.
+// 이름은 모두 DisplayIdentifier로 정규화된 값입니다.
+type DataDictionary struct {
+	DatabaseID   string
+	DatabaseType DatabaseType
+	Schemas      []string // 사전에 들어간 스키마 (이름순)
+	GeneratedAt  time.Time
+	Tables       []DictionaryTable // 스키마, 이름순
+}
+
+// DictionaryTable은 사전의 테이블 하나입니다.
+type DictionaryTable struct {
+	Schema  string
+	Name    string
+	Type    TableType
+	Comment string
+
+	Columns []ColumnInfo
+
+	// Metadata는 키, 인덱스 정보입니다 (뷰는 비어 있을 수 있음, nil 아님).
+	Metadata *TableMetadata
+}
+
+// ColumnCount는 사전에 들어간 전체 컬럼 수입니다.
+func (d *DataDictionary) ColumnCount() int {
+	count := 0
+	for _, t := range d.Tables {
+		count += len(t.Columns)
+	}
+	return count
+}
+
+// RenderDataDictionary는 데이터 사전을 문서 파일 내용으로 만듭니다.
+func RenderDataDictionary(d *DataDictionary, format DictionaryFormat) ([]byte, error) {
+	switch format {
+	case DictionaryMarkdown, "":
+		return []byte(renderDictionaryMarkdown(d)), nil
+	case DictionaryHTML:
+		return []byte(renderDictionaryHTML(d)), nil
+	case DictionaryXLSX:
+		return renderDictionaryXLSX(d)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDictionaryFormat, format)
+	}
+}
+
+// 세 형식이 같은 표를 쓰도록 머리글과 행을 한곳에서 만듭니다.
+var (
+	dictionaryColumnHeader = []string{"#", "Column", "Type", "Nullable", "Default", "Key", "Comment"}
+	dictionaryKeyHeader    = []string{"Constraint", "Type", "Columns", "Definition"}
+	dictionaryIndexHeader  = []string{"Index", "Columns", "Unique", "Type", "Condition"}
+)
+
+// dictionaryColumnRows는 컬럼 표의 행입니다.
+func dictionaryColumnRows(t DictionaryTable) [][]string {
+	snapshot := TableSnapshot{Name: t.Name, Columns: t.Columns, Metadata: t.Metadata}
+
+	rows := make([][]string, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		nullable := "NO"
+		if c.Nullable {
+			nullable = "YES"
+		}
+		def := ""
+		if c.Default != nil {
+			def = *c.Default
+		}
+		if c.Identity != nil && def == "" {
+			def = "identity"
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(c.Position),
+			c.Name,
+			c.DataType,
+			nullable,
+			def,
+			strings.Join(columnKeys(snapshot, c.Name), ", "),
+			c.Comment,
+		})
+	}
+	return rows
+}
+
+// dictionaryKeyRows는 키(기본 키, 유니크, 외래 키, 체크) 표의 행입니다.
+func dictionaryKeyRows(t DictionaryTable) [][]string {
+	var rows [][]string
+
+	for _, constraintType := range []ConstraintType{ConstraintPrimaryKey, ConstraintUnique} {
+		for _, c := range t.Metadata.Constraints {
+			if c.Type == constraintType {
+				rows = append(rows, []string{c.Name, constraintLabel(c.Type), strings.Join(c.Columns, ", "), ""})
+			}
+		}
+	}
+
+	for _, fk := range t.Metadata.ForeignKeys {
+		definition := fmt.Sprintf("%s.%s(%s)", fk.RefSchema, fk.RefTable, strings.Join(fk.RefColumns, ", "))
+		if fk.OnDelete != "" && fk.OnDelete != "NO ACTION" {
+			definition += " ON DELETE " + fk.OnDelete
+		}
+		if fk.OnUpdate != "" && fk.OnUpdate != "NO ACTION" {
+			definition += " ON UPDATE " + fk.OnUpdate
+		}
+		rows = append(rows, []string{fk.Name, "FOREIGN KEY", strings.Join(fk.Columns, ", "), definition})
+	}
+
+	for _, c := range t.Metadata.Constraints {
+		if c.Type == ConstraintCheck {
+			rows = append(rows, []string{c.Name, constraintLabel(c.Type), strings.Join(c.Columns, ", "), c.Expression})
+		}
+	}
+
+	return rows
+}
+
+// dictionaryIndexRows는 인덱스 표의 행입니다.
+func dictionaryIndexRows(t DictionaryTable) [][]string {
+	rows := make([][]string, 0, len(t.Metadata.Indexes))
+	for _, index := range t.Metadata.Indexes {
+		unique := ""
+		if index.Unique {
+			unique = "YES"
+		}
+		rows = append(rows, []string{index.Name, strings.Join(index.Columns, ", "), unique, index.Type, index.Predicate})
+	}
+	return rows
+}
+
+func constraintLabel(t ConstraintType) string {
+	return strings.ToUpper(strings.ReplaceAll(string(t), "_", " "))
+}
+
+// renderDictionaryMarkdown은 Markdown 문서를 만듭니다.
+//
+//	# Data Dictionary: postgres-prod
+//	## public.users
+//	| # | Column | Type | Nullable | Default | Key | Comment |
+//
+// 표 안의 | 와 줄바꿈은 \| 와 <br>로 바꿔서 표가 깨지지 않게 합니다.
+func renderDictionaryMarkdown(d *DataDictionary) string {
+	cell := func(s string) string {
+		s = strings.ReplaceAll(s, "|", `\|`)
+		s = strings.ReplaceAll(s, "\r\n", "\n")
+		return strings.ReplaceAll(s, "\n", "<br>")
+	}
+	table := func(b *strings.Builder, header []string, rows [][]string) {
+		b.WriteString("| " + strings.Join(header, " | ") + " |\n")
+		b.WriteString(strings.Repeat("|---", len(header)) + "|\n")
+		for _, row := range rows {
+			cells := make([]string, len(row))
+			for i, v := range row {
+				cells[i] = cell(v)
+			}
+			b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+		}
+		b.WriteString("\n")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Data Dictionary: %s\n\n", d.DatabaseID)
+	fmt.Fprintf(&b, "- Database type: %s\n", d.DatabaseType)
+	fmt.Fprintf(&b, "- Schemas: %s\n", strings.Join(d.Schemas, ", "))
+	fmt.Fprintf(&b, "- Tables: %d, Columns: %d\n", len(d.Tables), d.ColumnCount())
+	fmt.Fprintf(&b, "- Generated at: %s\n\n", d.GeneratedAt.Format(time.RFC3339))
+
+	if len(d.Tables) > 0 {
+		b.WriteString("## Contents\n\n")
+		for i, t := range d.Tables {
+			fmt.Fprintf(&b, "%d. [%s.%s](#%s)", i+1, t.Schema, t.Name, markdownAnchor(t.Schema+"."+t.Name))
+			if t.Comment != "" {
+				b.WriteString(" - " + strings.ReplaceAll(t.Comment, "\n", " "))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+	}
+
+	for _, t := range d.Tables {
+		fmt.Fprintf(&b, "## %s.%s\n\n", t.Schema, t.Name)
+		if t.Type != TableTypeTable {
+			fmt.Fprintf(&b, "Type: %s\n\n", t.Type)
+		}
+		if t.Comment != "" {
+			for _, line := range strings.Split(t.Comment, "\n") {
+				b.WriteString("> " + line + "\n")
+			}
+			b.WriteString("\n")
+		}
+
+		table(&b, dictionaryColumnHeader, dictionaryColumnRows(t))
+
+		if rows := dictionaryKeyRows(t); len(rows) > 0 {
+			b.WriteString("**Keys**\n\n")
+			table(&b, dictionaryKeyHeader, rows)
+		}
+		if rows := dictionaryIndexRows(t); len(rows) > 0 {
+			b.WriteString("**Indexes**\n\n")
+			table(&b, dictionaryIndexHeader, rows)
+		}
+	}
+
+	return b.String()
+}
+
+// markdownAnchor는 GitHub 방식의 제목 앵커입니다 (소문자, 공백은 -, 구두점 제거).
+func markdownAnchor(heading string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case r == ' ':
+			b.WriteRune('-')
+		case r == '-' || r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r > 127:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// dictionaryStyle은 HTML 문서의 스타일입니다 (인쇄해서 제출할 수 있게 단순하게).
+const dictionaryStyle = `body{font-family:sans-serif;margin:2em;color:#222}
+table{border-collapse:collapse;margin:0.5em 0 1.5em;width:100%}
+th,td{border:1px solid #bbb;padding:4px 8px;text-align:left;vertical-align:top;font-size:13px}
+th{background:#f0f0f0}
+h2{margin-top:2em;border-bottom:1px solid #ddd}
+.comment{color:#555;white-space:pre-wrap}
+.meta{color:#555}`
+
+// renderDictionaryHTML은 스타일까지 들어 있는 HTML 문서 하나를 만듭니다.
+// 이름과 주석은 모두 이스케이프하고, 목차 앵커는 순번(t1, t2...)으로 붙입니다.
+func renderDictionaryHTML(d *DataDictionary) string {
+	esc := func(s string) string {
+		return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+	}
+	table := func(b *strings.Builder, header []string, rows [][]string) {
+		b.WriteString("<table>\n<tr>")
+		for _, h := range header {
+			b.WriteString("<th>" + esc(h) + "</th>")
+		}
+		b.WriteString("</tr>\n")
+		for _, row := range rows {
+			b.WriteString("<tr>")
+			for _, v := range row {
+				b.WriteString("<td>" + esc(v) + "</td>")
+			}
+			b.WriteString("</tr>\n")
+		}
+		b.WriteString("</table>\n")
+	}
+
+	title := "Data Dictionary: " + d.DatabaseID
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	fmt.Fprintf(&b, "<title>%s</title>\n<style>\n%s\n</style>\n</head>\n<body>\n", esc(title), dictionaryStyle)
+	fmt.Fprintf(&b, "<h1>%s</h1>\n<p class=\"meta\">", esc(title))
+	fmt.Fprintf(&b, "Database type: %s<br>Schemas: %s<br>Tables: %d, Columns: %d<br>Generated at: %s</p>\n",
+		esc(string(d.DatabaseType)), esc(strings.Join(d.Schemas, ", ")),
+		len(d.Tables), d.ColumnCount(), d.GeneratedAt.Format(time.RFC3339))
+
+	if len(d.Tables) > 0 {
+		b.WriteString("<h2>Contents</h2>\n<ol>\n")
+		for i, t := range d.Tables {
+			fmt.Fprintf(&b, "<li><a href=\"#t%d\">%s.%s</a>", i+1, esc(t.Schema), esc(t.Name))
+			if t.Comment != "" {
+				b.WriteString(" - " + esc(strings.ReplaceAll(t.Comment, "\n", " ")))
+			}
+			b.WriteString("</li>\n")
+		}
+		b.WriteString("</ol>\n")
+	}
+
+	for i, t := range d.Tables {
+		fmt.Fprintf(&b, "<h2 id=\"t%d\">%s.%s</h2>\n", i+1, esc(t.Schema), esc(t.Name))
+		if t.Type != TableTypeTable {
+			fmt.Fprintf(&b, "<p class=\"meta\">Type: %s</p>\n", esc(string(t.Type)))
+		}
+		if t.Comment != "" {
+			fmt.Fprintf(&b, "<p class=\"comment\">%s</p>\n", html.EscapeString(t.Comment))
+		}
+
+		table(&b, dictionaryColumnHeader, dictionaryColumnRows(t))
+
+		if rows := dictionaryKeyRows(t); len(rows) > 0 {
+			b.WriteString("<h3>Keys</h3>\n")
+			table(&b, dictionaryKeyHeader, rows)
+		}
+		if rows := dictionaryIndexRows(t); len(rows) > 0 {
+			b.WriteString("<h3>Indexes</h3>\n")
+			table(&b, dictionaryIndexHeader, rows)
+		}
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// renderDictionaryXLSX는 엑셀 통합 문서를 만듭니다.
+//
+// 감사 담당자가 필터/정렬하기 쉽도록 테이블마다 표를 나누지 않고
+// 종류별 시트 하나에 모든 테이블을 평평하게 넣습니다 (앞 두 열이 스키마, 테이블).
+func renderDictionaryXLSX(d *DataDictionary) ([]byte, error) {
+	prefix := func(t DictionaryTable, rows [][]string) [][]string {
+		for i, row := range rows {
+			rows[i] = append([]string{t.Schema, t.Name}, row...)
+		}
+		return rows
+	}
+	withTable := func(header []string) []string {
+		return append([]string{"Schema", "Table"}, header...)
+	}
+
+	tables := xlsxSheet{Name: "Tables", Rows: [][]string{{"Schema", "Table", "Type", "Comment", "Columns"}}}
+	columns := xlsxSheet{Name: "Columns", Rows: [][]string{withTable(dictionaryColumnHeader)}}
+	keys := xlsxSheet{Name: "Keys", Rows: [][]string{withTable(dictionaryKeyHeader)}}
+	indexes := xlsxSheet{Name: "Indexes", Rows: [][]string{withTable(dictionaryIndexHeader)}}
+
+	for _, t := range d.Tables {
+		tables.Rows = append(tables.Rows, []string{t.Schema, t.Name, string(t.Type), t.Comment, strconv.Itoa(len(t.Columns))})
+		columns.Rows = append(columns.Rows, prefix(t, dictionaryColumnRows(t))...)
+		keys.Rows = append(keys.Rows, prefix(t, dictionaryKeyRows(t))...)
+		indexes.Rows = append(indexes.Rows, prefix(t, dictionaryIndexRows(t))...)
+	}
+
+	return writeXLSX([]xlsxSheet{tables, columns, keys, indexes})
+}
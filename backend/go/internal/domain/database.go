@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 )
 
 // DatabaseType은 지원하는 데이터베이스 종류를 나타내는 타입입니다.
@@ -46,6 +47,17 @@ type Database struct {
 	Username string           // 사용자명
 	Password string           // 비밀번호
 	Status   ConnectionStatus // 현재 연결 상태
+
+	// Guard는 실행 전 비용 검사 설정입니다 (nil이면 검사 안 함).
+	// 포인터를 쓰는 이유: "설정 없음"을 nil로 표현하기 위해!
+	Guard *QueryGuard
+
+	// CacheTTL은 SELECT 결과 캐시 유지 시간입니다 (0이면 캐시 안 함, opt-in).
+	// 요청별 TTL(QueryOptions.CacheTTL)이 있으면 그 값이 우선합니다.
+	CacheTTL time.Duration
+
+	// Write는 DMS가 대신 실행하는 쓰기(주석 편집 등)의 권한입니다 (nil이면 읽기 전용).
+	Write WritePolicy
 }
 
 // Validate는 Database 객체의 유효성을 검증합니다.
@@ -93,6 +105,21 @@ func (db *Database) Validate() error {
 		return fmt.Errorf("unsupported database type: %s", db.Type)
 	}
 
+	// Guard는 선택사항이지만, 있으면 유효해야 합니다
+	if db.Guard != nil {
+		if err := db.Guard.Validate(); err != nil {
+			return fmt.Errorf("invalid guard: %w", err)
+		}
+	}
+
+	if db.CacheTTL < 0 {
+		return errors.New("cache TTL must not be negative")
+	}
+
+	if err := db.Write.Validate(); err != nil {
+		return fmt.Errorf("invalid write policy: %w", err)
+	}
+
 	// Go에서 에러가 없으면 nil을 반환합니다
 	// nil은 Java의 null과 비슷합니다
 	return nil
@@ -152,8 +179,8 @@ func (db *Database) ConnectionString() string {
 			sid = db.Schema // Schema 있으면 우선
 		}
 
-		log.Printf(fmt.Sprintf("%s/%s@%s:%d/%s",
-			db.Username, db.Password, db.Host, db.Port, sid))
+		log.Printf("%s/%s@%s:%d/%s",
+			db.Username, db.Password, db.Host, db.Port, sid)
 		return fmt.Sprintf("%s/%s@%s:%d/%s",
 			db.Username, db.Password, db.Host, db.Port, sid)
 
@@ -0,0 +1,427 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DDL 생성 관련 에러
+var (
+	ErrUnsupportedDialect = errors.New("unsupported SQL dialect")
+	ErrDDLNotSupported    = errors.New("DDL conversion is not supported for this object type")
+)
+
+// SQLDialect는 DDL을 만들 대상 SQL 방언입니다.
+// DB 버전(oracle11g, oracle19c)과 달리 문법 계열만 구분합니다.
+type SQLDialect string
+
+const (
+	DialectPostgres SQLDialect = "postgres"
+	DialectOracle   SQLDialect = "oracle"
+)
+
+// IsValid는 지원하는 방언인지 확인합니다.
+func (d SQLDialect) IsValid() bool {
+	return d == DialectPostgres || d == DialectOracle
+}
+
+// DialectOf는 DB 종류의 SQL 방언을 반환합니다 (지원하지 않으면 빈 문자열).
+func DialectOf(dbType DatabaseType) SQLDialect {
+	switch dbType {
+	case PostgreSQL:
+		return DialectPostgres
+	case Oracle11g, Oracle19c:
+		return DialectOracle
+	default:
+		return ""
+	}
+}
+
+// ParseDialect는 "postgres", "oracle" 같은 문자열을 방언으로 바꿉니다.
+// DB 종류 이름(postgres16.3, oracle19c)도 받습니다. 빈 문자열이면 빈 방언을 반환합니다.
+func ParseDialect(s string) (SQLDialect, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return "", nil
+	}
+
+	if d := SQLDialect(s); d.IsValid() {
+		return d, nil
+	}
+	if d := DialectOf(DatabaseType(s)); d != "" {
+		return d, nil
+	}
+	if s == "postgresql" || s == "pg" {
+		return DialectPostgres, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedDialect, s)
+}
+
+// DDLResult는 생성한 DDL입니다.
+type DDLResult struct {
+	Schema  string
+	Name    string
+	Type    ObjectType
+	Dialect SQLDialect // DDL의 방언 (원본과 다르면 변환된 것)
+
+	DDL string
+
+	// Warnings는 변환하면서 정확히 옮기지 못한 부분입니다.
+	// (예: "column tags: type text[] has no Oracle equivalent, mapped to CLOB")
+	Warnings []string
+}
+
+// TableDefinition은 CREATE TABLE 문을 만드는 데 필요한 테이블 정보입니다.
+// 이름은 DisplayIdentifier로 정규화된 값이어야 합니다 (소문자면 따옴표 없이 씀).
+type TableDefinition struct {
+	Source   SQLDialect // 원본 DB의 방언 (타입/기본값 변환 기준)
+	Metadata *TableMetadata
+	Columns  []ColumnInfo
+}
+
+// GenerateTableDDL은 테이블 정보로 target 방언의 DDL을 만듭니다.
+//
+// 순서:
+//  1. CREATE TABLE (컬럼 + 기본 키/유니크/체크 제약조건)
+//  2. 외래 키 (ALTER TABLE ... ADD CONSTRAINT, 참조 테이블이 나중에 만들어져도 되도록)
+//  3. 제약조건이 만든 것이 아닌 인덱스
+//  4. 테이블/컬럼 주석
+//  5. 소유자 (Postgres → Postgres만)
+func GenerateTableDDL(def TableDefinition, target SQLDialect) (string, []string) {
+	g := &ddlGenerator{source: def.Source, target: target}
+	statements := g.tableStatements(def)
+
+	sections := [][]string{
+		{statements.create},
+		statements.foreignKeys,
+		statements.indexes,
+		statements.comments,
+		statements.owner,
+	}
+
+	var parts []string
+	for _, section := range sections {
+		if len(section) > 0 {
+			parts = append(parts, strings.Join(section, "\n")+"\n")
+		}
+	}
+
+	return strings.Join(parts, "\n"), g.warnings
+}
+
+// tableStatements는 테이블 하나의 DDL을 단계별 문장으로 나눈 것입니다.
+// 스키마 비교(마이그레이션)에서는 여러 테이블의 CREATE를 먼저 모두 실행한 뒤
+// 외래 키를 붙여야 하므로 단계별로 따로 갖고 있습니다.
+type tableStatements struct {
+	create      string
+	foreignKeys []string
+	indexes     []string
+	comments    []string
+	owner       []string
+}
+
+// tableStatements는 테이블 정보로 단계별 DDL 문장을 만듭니다.
+func (g *ddlGenerator) tableStatements(def TableDefinition) tableStatements {
+	var statements tableStatements
+	meta := def.Metadata
+	table := g.qualified(meta.Schema, meta.Table)
+
+	// 1. CREATE TABLE
+	var lines []string
+	for _, col := range def.Columns {
+		lines = append(lines, "    "+g.columnDefinition(col))
+	}
+
+	constraintNames := make(map[string]bool)
+	for _, con := range meta.Constraints {
+		constraintNames[con.Name] = true
+		if clause := g.constraintClause(con); clause != "" {
+			lines = append(lines, "    CONSTRAINT "+QuoteIdentifier(con.Name)+" "+clause)
+		}
+	}
+
+	statements.create = fmt.Sprintf("CREATE TABLE %s (\n%s\n);", table, strings.Join(lines, ",\n"))
+
+	// 2. 외래 키
+	for _, fk := range meta.ForeignKeys {
+		statements.foreignKeys = append(statements.foreignKeys, g.addForeignKey(table, fk))
+	}
+
+	// 3. 인덱스 (기본 키/유니크 제약조건이 만든 인덱스는 이미 위에서 만들어짐)
+	for _, idx := range meta.Indexes {
+		if idx.Primary || constraintNames[idx.Name] {
+			continue
+		}
+		statements.indexes = append(statements.indexes, g.indexDefinition(meta, idx))
+	}
+
+	// 4. 주석
+	if meta.Comment != "" {
+		statements.comments = append(statements.comments,
+			fmt.Sprintf("COMMENT ON TABLE %s IS %s;", table, QuoteLiteral(meta.Comment)))
+	}
+	for _, col := range def.Columns {
+		if col.Comment != "" {
+			statements.comments = append(statements.comments, fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s;",
+				table, QuoteIdentifier(col.Name), QuoteLiteral(col.Comment)))
+		}
+	}
+
+	// 5. 소유자 (Oracle은 스키마 = 소유자이므로 따로 없음)
+	if g.source == DialectPostgres && g.target == DialectPostgres && meta.Owner != "" {
+		statements.owner = append(statements.owner,
+			fmt.Sprintf("ALTER TABLE %s OWNER TO %s;", table, QuoteIdentifier(meta.Owner)))
+	}
+
+	return statements
+}
+
+// constraintClause는 "PRIMARY KEY (...)", "CHECK (...)" 같은 제약조건 본문을 만듭니다.
+func (g *ddlGenerator) constraintClause(con ConstraintInfo) string {
+	var clause string
+
+	switch con.Type {
+	case ConstraintPrimaryKey:
+		clause = "PRIMARY KEY (" + g.identifiers(con.Columns) + ")"
+	case ConstraintUnique:
+		clause = "UNIQUE (" + g.identifiers(con.Columns) + ")"
+	case ConstraintCheck:
+		clause = con.Expression
+		if !strings.HasPrefix(strings.ToUpper(clause), "CHECK") {
+			clause = "CHECK (" + clause + ")"
+		}
+		if g.source != g.target {
+			g.warn("constraint %s: check expression copied without translation", con.Name)
+		}
+	default:
+		return ""
+	}
+
+	if con.Deferrable {
+		clause += " DEFERRABLE"
+	}
+	return clause
+}
+
+// addForeignKey는 ALTER TABLE ... ADD CONSTRAINT ... FOREIGN KEY 문을 만듭니다.
+func (g *ddlGenerator) addForeignKey(table string, fk ForeignKeyInfo) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)%s;",
+		table, QuoteIdentifier(fk.Name), g.identifiers(fk.Columns),
+		g.qualified(fk.RefSchema, fk.RefTable), g.identifiers(fk.RefColumns),
+		g.foreignKeyActions(fk))
+}
+
+// ddlGenerator는 DDL을 만들면서 경고를 모읍니다.
+type ddlGenerator struct {
+	source, target SQLDialect
+	warnings       []string
+}
+
+func (g *ddlGenerator) warn(format string, args ...interface{}) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, args...))
+}
+
+// qualified는 "스키마.이름"을 만듭니다 (스키마가 비어 있으면 이름만).
+func (g *ddlGenerator) qualified(schema, name string) string {
+	if schema == "" {
+		return QuoteIdentifier(name)
+	}
+	return QuoteIdentifier(schema) + "." + QuoteIdentifier(name)
+}
+
+func (g *ddlGenerator) identifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = QuoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// columnDefinition은 "이름 타입 [DEFAULT ...] [GENERATED ... AS IDENTITY] [NOT NULL]"을 만듭니다.
+func (g *ddlGenerator) columnDefinition(col ColumnInfo) string {
+	dataType, warning := ConvertColumnType(g.source, g.target, col)
+	if warning != "" {
+		g.warn("column %s: %s", col.Name, warning)
+	}
+
+	parts := []string{QuoteIdentifier(col.Name), dataType}
+
+	switch {
+	case col.Identity != nil && col.Identity.Generation == IdentitySequence && g.target == DialectPostgres && g.source == DialectPostgres:
+		// serial 컬럼은 serial/bigserial로 씁니다 (기본값 nextval은 serial이 만들어 줌)
+		if serial, ok := serialTypes[col.BaseType]; ok {
+			parts[1] = serial
+		} else if col.Default != nil {
+			parts = append(parts, "DEFAULT "+*col.Default)
+		}
+
+	case col.Identity != nil:
+		generation := col.Identity.Generation
+		if generation == IdentitySequence {
+			generation = IdentityByDefault
+		}
+		parts = append(parts, "GENERATED "+string(generation)+" AS IDENTITY")
+		if g.target == DialectOracle && g.source != DialectOracle {
+			g.warn("column %s: identity columns require Oracle 12c or later", col.Name)
+		}
+
+	case col.Default != nil:
+		if expr, ok := ConvertDefault(g.source, g.target, *col.Default); ok {
+			parts = append(parts, "DEFAULT "+expr)
+		} else {
+			g.warn("column %s: default %s has no %s equivalent and was dropped", col.Name, *col.Default, g.target)
+		}
+	}
+
+	if !col.Nullable {
+		parts = append(parts, "NOT NULL")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// serialTypes는 Postgres serial 컬럼의 원래 타입 → serial 타입 이름입니다.
+var serialTypes = map[string]string{
+	"smallint": "smallserial",
+	"integer":  "serial",
+	"bigint":   "bigserial",
+}
+
+// foreignKeyActions는 " ON DELETE ... ON UPDATE ..." 절을 만듭니다.
+// 기본 동작(NO ACTION)은 생략합니다. Oracle은 ON UPDATE와 RESTRICT/SET DEFAULT가 없습니다.
+func (g *ddlGenerator) foreignKeyActions(fk ForeignKeyInfo) string {
+	var clause string
+
+	if fk.OnDelete != "" && fk.OnDelete != "NO ACTION" {
+		if g.target == DialectOracle && fk.OnDelete != "CASCADE" && fk.OnDelete != "SET NULL" {
+			g.warn("foreign key %s: ON DELETE %s is not supported by Oracle and was dropped", fk.Name, fk.OnDelete)
+		} else {
+			clause += " ON DELETE " + fk.OnDelete
+		}
+	}
+
+	if fk.OnUpdate != "" && fk.OnUpdate != "NO ACTION" {
+		if g.target == DialectOracle {
+			g.warn("foreign key %s: ON UPDATE %s is not supported by Oracle and was dropped", fk.Name, fk.OnUpdate)
+		} else {
+			clause += " ON UPDATE " + fk.OnUpdate
+		}
+	}
+
+	return clause
+}
+
+// indexDefinition은 CREATE INDEX 문을 만듭니다.
+// 같은 방언이면 DB가 돌려준 정의(IndexInfo.Definition)를 그대로 씁니다.
+func (g *ddlGenerator) indexDefinition(meta *TableMetadata, idx IndexInfo) string {
+	if g.source == g.target && idx.Definition != "" {
+		return strings.TrimSuffix(idx.Definition, ";") + ";"
+	}
+
+	columns := make([]string, len(idx.Columns))
+	for i, col := range idx.Columns {
+		name, desc := col, ""
+		if strings.HasSuffix(strings.ToUpper(col), " DESC") {
+			name, desc = col[:len(col)-5], " DESC"
+		}
+
+		if strings.HasPrefix(name, `"`) {
+			// 이미 따옴표로 감싼 이름 (Postgres pg_get_indexdef 결과)
+			columns[i] = name + desc
+		} else if isExpression(name) {
+			if g.source != g.target {
+				g.warn("index %s: expression %s copied without translation", idx.Name, name)
+			}
+			columns[i] = name + desc
+		} else {
+			columns[i] = QuoteIdentifier(name) + desc
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if idx.Unique {
+		b.WriteString("UNIQUE ")
+	}
+
+	switch g.target {
+	case DialectOracle:
+		if strings.EqualFold(idx.Type, "BITMAP") {
+			b.WriteString("BITMAP ")
+		}
+		fmt.Fprintf(&b, "INDEX %s ON %s (%s)", g.qualified(meta.Schema, idx.Name),
+			g.qualified(meta.Schema, meta.Table), strings.Join(columns, ", "))
+		if g.source == DialectPostgres && idx.Type != "" && idx.Type != "btree" {
+			g.warn("index %s: %s index created as a regular B-tree index", idx.Name, idx.Type)
+		}
+		if idx.Predicate != "" {
+			g.warn("index %s: partial index condition %s dropped (Oracle has no partial indexes)", idx.Name, idx.Predicate)
+		}
+
+	default:
+		fmt.Fprintf(&b, "INDEX %s ON %s", QuoteIdentifier(idx.Name), g.qualified(meta.Schema, meta.Table))
+		if g.source == DialectPostgres && idx.Type != "" && idx.Type != "btree" {
+			fmt.Fprintf(&b, " USING %s", idx.Type)
+		}
+		if g.source == DialectOracle && strings.EqualFold(idx.Type, "BITMAP") {
+			g.warn("index %s: bitmap index created as a regular B-tree index", idx.Name)
+		}
+		fmt.Fprintf(&b, " (%s)", strings.Join(columns, ", "))
+		if idx.Predicate != "" {
+			fmt.Fprintf(&b, " WHERE %s", idx.Predicate)
+		}
+	}
+
+	b.WriteString(";")
+	return b.String()
+}
+
+// isExpression은 인덱스 키가 컬럼 이름이 아니라 식인지 확인합니다.
+func isExpression(s string) bool {
+	return strings.ContainsAny(s, "()'+-*/|: ")
+}
+
+// reservedWords는 따옴표 없이 컬럼/테이블 이름으로 쓸 수 없는 단어입니다.
+// (Postgres와 Oracle에서 자주 부딪히는 것만)
+var reservedWords = map[string]bool{
+	"access": true, "all": true, "and": true, "as": true, "by": true, "check": true,
+	"column": true, "comment": true, "date": true, "default": true, "desc": true,
+	"file": true, "from": true, "grant": true, "group": true, "having": true,
+	"in": true, "index": true, "level": true, "mode": true, "not": true,
+	"null": true, "number": true, "order": true, "primary": true, "select": true,
+	"session": true, "size": true, "table": true, "to": true, "uid": true,
+	"user": true, "where": true, "with": true,
+}
+
+// QuoteIdentifier는 DDL에 쓸 식별자를 만듭니다.
+//
+// 소문자로만 된 일반 식별자(DisplayIdentifier 결과)는 따옴표 없이 씁니다.
+// 이렇게 하면 Postgres에서는 소문자, Oracle에서는 대문자로 저장되어
+// 두 DB 모두 자연스러운 이름이 됩니다.
+// 대소문자가 섞였거나 특수 문자/예약어인 경우만 큰따옴표로 감쌉니다.
+func QuoteIdentifier(name string) string {
+	if IsPlainIdentifier(PostgreSQL, name) && !reservedWords[name] {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QuoteCatalogIdentifier는 카탈로그에 저장된 이름을 그 DB에서 실행할 SQL에 쓸 식별자로 만듭니다.
+//
+// QuoteIdentifier는 DisplayIdentifier 결과(소문자)를 받아서 두 DB에 같은 DDL을 만들 때 쓰고,
+// 이 함수는 실제 DB 객체를 가리켜야 할 때 씁니다. 예약어를 따옴표로 감쌀 때
+// Oracle은 대문자 그대로 ("USER"), Postgres는 소문자 그대로 ("user") 감싸야
+// 같은 객체를 가리키기 때문입니다.
+func QuoteCatalogIdentifier(dbType DatabaseType, name string) string {
+	if IsPlainIdentifier(dbType, name) && !reservedWords[strings.ToLower(name)] {
+		return strings.ToLower(name)
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QuoteLiteral은 문자열을 SQL 문자열 리터럴로 만듭니다 ('는 ”로).
+func QuoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
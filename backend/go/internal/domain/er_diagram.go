@@ -0,0 +1,257 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// ER 다이어그램 관련 에러
+var (
+	ErrInvalidDiagramFormat = errors.New("invalid diagram format")
+)
+
+// DiagramFormat은 ER 다이어그램 출력 형식입니다.
+type DiagramFormat string
+
+const (
+	DiagramMermaid DiagramFormat = "mermaid" // Mermaid erDiagram (프론트엔드, Markdown 문서)
+	DiagramDOT     DiagramFormat = "dot"     // Graphviz DOT (dot -Tsvg로 렌더링)
+)
+
+// ParseDiagramFormat은 문자열을 DiagramFormat으로 바꿉니다 (비어 있으면 mermaid).
+func ParseDiagramFormat(value string) (DiagramFormat, error) {
+	switch format := DiagramFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "":
+		return DiagramMermaid, nil
+	case DiagramMermaid, DiagramDOT:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %s (use mermaid or dot)", ErrInvalidDiagramFormat, value)
+	}
+}
+
+// ERDiagramOptions는 다이어그램에 넣을 테이블 범위입니다.
+type ERDiagramOptions struct {
+	// Tables가 비어 있으면 스키마의 모든 테이블을 그립니다.
+	Tables []string
+
+	// Hops는 Tables에서 외래 키를 따라 몇 단계 이웃까지 포함할지입니다.
+	// (참조하는 쪽, 참조되는 쪽 모두 따라갑니다. 0이면 Tables만)
+	Hops int
+
+	Format DiagramFormat
+}
+
+// ERDiagram은 만들어진 다이어그램입니다.
+type ERDiagram struct {
+	DatabaseID    string
+	Schema        string
+	Format        DiagramFormat
+	Tables        []string // 다이어그램에 들어간 테이블 (이름순)
+	Relationships int      // 그려진 외래 키 수
+	Diagram       string
+}
+
+// RenderERDiagram은 테이블 구조로 다이어그램 텍스트를 만듭니다.
+//
+// 외래 키는 양쪽 테이블이 모두 tables에 있을 때만 선으로 그립니다.
+// (N단계 밖의 테이블이나 다른 스키마를 참조하는 외래 키는 컬럼의 FK 표시로만 남습니다)
+func RenderERDiagram(tables []TableSnapshot, format DiagramFormat) (string, int) {
+	included := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		included[t.Name] = true
+	}
+
+	var relationships []erRelationship
+	for _, t := range tables {
+		if t.Metadata == nil {
+			continue
+		}
+		for _, fk := range t.Metadata.ForeignKeys {
+			if fk.RefSchema != t.Metadata.Schema || !included[fk.RefTable] {
+				continue
+			}
+			relationships = append(relationships, erRelationship{
+				fk:       fk,
+				optional: hasNullableColumn(t.Columns, fk.Columns),
+			})
+		}
+	}
+
+	if format == DiagramDOT {
+		return renderDOT(tables, relationships), len(relationships)
+	}
+	return renderMermaid(tables, relationships), len(relationships)
+}
+
+// erRelationship은 다이어그램에 그릴 외래 키 하나입니다.
+type erRelationship struct {
+	fk       ForeignKeyInfo
+	optional bool // 외래 키 컬럼이 NULL 허용이면 부모가 없을 수도 있음 (0..1)
+}
+
+// columnKeys는 컬럼의 PK/FK/UK 표시입니다.
+func columnKeys(t TableSnapshot, column string) []string {
+	var keys []string
+	if t.Metadata == nil {
+		return keys
+	}
+
+	has := func(constraintType ConstraintType) bool {
+		for _, c := range t.Metadata.Constraints {
+			if c.Type == constraintType && containsString(c.Columns, column) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if has(ConstraintPrimaryKey) {
+		keys = append(keys, "PK")
+	}
+	for _, fk := range t.Metadata.ForeignKeys {
+		if containsString(fk.Columns, column) {
+			keys = append(keys, "FK")
+			break
+		}
+	}
+	if has(ConstraintUnique) {
+		keys = append(keys, "UK")
+	}
+
+	return keys
+}
+
+// hasNullableColumn은 columns 중 하나라도 NULL 허용인지 확인합니다.
+func hasNullableColumn(columns []ColumnInfo, names []string) bool {
+	for _, c := range columns {
+		if c.Nullable && containsString(names, c.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// mermaidUnsafe는 Mermaid 엔티티 이름/타입에 쓸 수 없는 문자입니다.
+var mermaidUnsafe = regexp.MustCompile(`[^A-Za-z0-9_\-()\[\]]+`)
+
+// renderMermaid는 Mermaid erDiagram을 만듭니다.
+//
+//	erDiagram
+//	    departments ||--o{ users : "users_dept_id_fkey"
+//	    users {
+//	        integer id PK
+//	        character_varying(100) name
+//	        integer dept_id FK
+//	    }
+//
+// Mermaid는 이름과 타입에 공백/쉼표를 허용하지 않아서 밑줄로 바꿉니다.
+func renderMermaid(tables []TableSnapshot, relationships []erRelationship) string {
+	name := func(s string) string { return strings.Trim(mermaidUnsafe.ReplaceAllString(s, "_"), "_") }
+
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+
+	for _, r := range relationships {
+		// 부모 ||--o{ 자식: 자식 여러 개가 부모 하나를 참조
+		// 외래 키 컬럼이 NULL 허용이면 부모 쪽이 0..1 (|o)
+		parent := "||"
+		if r.optional {
+			parent = "|o"
+		}
+		fmt.Fprintf(&b, "    %s %s--o{ %s : %q\n", name(r.fk.RefTable), parent, name(r.fk.Table), r.fk.Name)
+	}
+
+	for _, t := range tables {
+		fmt.Fprintf(&b, "    %s {\n", name(t.Name))
+		for _, c := range t.Columns {
+			line := name(c.DataType) + " " + name(c.Name)
+			if keys := columnKeys(t, c.Name); len(keys) > 0 {
+				line += " " + strings.Join(keys, ", ")
+			}
+			fmt.Fprintf(&b, "        %s\n", line)
+		}
+		b.WriteString("    }\n")
+	}
+
+	return b.String()
+}
+
+// renderDOT은 Graphviz DOT 그래프를 만듭니다.
+//
+// 테이블은 HTML 라벨 표로 그리고, 외래 키 선은 컬럼 칸(port)끼리 잇습니다.
+// port 이름은 c1, c2처럼 컬럼 순서로 붙여서 이름에 특수문자가 있어도 깨지지 않게 합니다.
+func renderDOT(tables []TableSnapshot, relationships []erRelationship) string {
+	ports := make(map[string]map[string]string, len(tables))
+
+	var b strings.Builder
+	b.WriteString("digraph er {\n")
+	b.WriteString("    graph [rankdir=LR];\n")
+	b.WriteString("    node [shape=plaintext, fontname=\"Helvetica\"];\n")
+	b.WriteString("    edge [arrowhead=none, arrowtail=crow, dir=both];\n\n")
+
+	for _, t := range tables {
+		ports[t.Name] = make(map[string]string, len(t.Columns))
+
+		fmt.Fprintf(&b, "    %s [label=<\n", dotID(t.Name))
+		b.WriteString("      <TABLE BORDER=\"0\" CELLBORDER=\"1\" CELLSPACING=\"0\" CELLPADDING=\"4\">\n")
+		fmt.Fprintf(&b, "        <TR><TD BGCOLOR=\"lightgrey\" COLSPAN=\"3\"><B>%s</B></TD></TR>\n", html.EscapeString(t.Name))
+
+		for i, c := range t.Columns {
+			port := fmt.Sprintf("c%d", i+1)
+			ports[t.Name][c.Name] = port
+
+			name := html.EscapeString(c.Name)
+			keys := columnKeys(t, c.Name)
+			if containsString(keys, "PK") {
+				name = "<U>" + name + "</U>"
+			}
+
+			fmt.Fprintf(&b, "        <TR><TD PORT=\"%s\" ALIGN=\"LEFT\">%s</TD><TD ALIGN=\"LEFT\">%s</TD><TD>%s</TD></TR>\n",
+				port, name, html.EscapeString(c.DataType), strings.Join(keys, " "))
+		}
+
+		b.WriteString("      </TABLE>\n    >];\n")
+	}
+
+	if len(relationships) > 0 {
+		b.WriteString("\n")
+	}
+	for _, r := range relationships {
+		from, to := dotID(r.fk.Table), dotID(r.fk.RefTable)
+		if len(r.fk.Columns) > 0 && len(r.fk.RefColumns) > 0 {
+			if port, ok := ports[r.fk.Table][r.fk.Columns[0]]; ok {
+				from += ":" + port
+			}
+			if port, ok := ports[r.fk.RefTable][r.fk.RefColumns[0]]; ok {
+				to += ":" + port
+			}
+		}
+
+		style := ""
+		if r.optional {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "    %s -> %s [label=%s%s];\n", from, to, dotID(r.fk.Name), style)
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotID는 DOT 식별자를 큰따옴표로 감쌉니다.
+func dotID(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 페더레이션 관련 에러
+var (
+	ErrInvalidFederation       = errors.New("invalid federated query")
+	ErrFederationLimitExceeded = errors.New("federation memory limit exceeded")
+)
+
+// federationAliasPattern은 소스 별칭으로 허용하는 형식입니다.
+// 별칭은 최종 SQL에서 테이블 이름으로 쓰이므로 식별자 규칙을 따릅니다.
+var federationAliasPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// FederatedSource는 페더레이션 쿼리의 서브 쿼리 하나입니다.
+// 등록된 DB 하나에 바인딩되며, 결과는 Alias 이름의 임시 테이블로 적재됩니다.
+type FederatedSource struct {
+	Alias      string // 최종 SQL에서 사용할 테이블 이름 (예: "students")
+	DatabaseID string // 서브 쿼리를 실행할 DB ID
+	Query      string // 서브 쿼리 SQL
+}
+
+// FederationLimits는 임베디드 엔진의 메모리 사용 한도입니다.
+// 0은 "제한 없음"이 아니라 "기본값 사용"을 의미합니다.
+type FederationLimits struct {
+	MaxRowsPerSource int   // 소스 하나에서 적재할 수 있는 최대 row 수
+	MaxMemoryBytes   int64 // 임베디드 엔진이 사용할 수 있는 최대 메모리
+}
+
+// DefaultFederationLimits는 설정이 없을 때 사용하는 기본 한도입니다.
+var DefaultFederationLimits = FederationLimits{
+	MaxRowsPerSource: 100000,
+	MaxMemoryBytes:   256 * 1024 * 1024, // 256MB
+}
+
+// Clamp는 요청된 한도를 상한(max) 안으로 맞춥니다.
+// 요청 값이 0이거나 상한보다 크면 상한을 사용합니다.
+func (l FederationLimits) Clamp(max FederationLimits) FederationLimits {
+	clamped := max
+	if l.MaxRowsPerSource > 0 && l.MaxRowsPerSource < max.MaxRowsPerSource {
+		clamped.MaxRowsPerSource = l.MaxRowsPerSource
+	}
+	if l.MaxMemoryBytes > 0 && l.MaxMemoryBytes < max.MaxMemoryBytes {
+		clamped.MaxMemoryBytes = l.MaxMemoryBytes
+	}
+	return clamped
+}
+
+// FederatedQuery는 여러 DB의 결과를 조인하는 페더레이션 쿼리입니다.
+type FederatedQuery struct {
+	Sources []FederatedSource // 2개 이상의 서브 쿼리
+	Query   string            // 적재된 소스 테이블 위에서 실행할 최종 SQL
+	Limits  FederationLimits  // 요청별 한도 (서버 상한을 넘을 수 없음)
+}
+
+// Validate는 페더레이션 쿼리의 유효성을 검증합니다.
+func (fq *FederatedQuery) Validate() error {
+	if len(fq.Sources) < 2 {
+		return fmt.Errorf("%w: at least 2 sources are required", ErrInvalidFederation)
+	}
+
+	if strings.TrimSpace(fq.Query) == "" {
+		return fmt.Errorf("%w: final query is required", ErrInvalidFederation)
+	}
+	// 최종 SQL은 서버의 SQLite에서 실행되므로 SELECT만 허용합니다.
+	// ATTACH DATABASE나 VACUUM INTO는 서버의 파일을 읽고 쓸 수 있기 때문!
+	if ClassifyStatement(fq.Query) != StatementSelect {
+		return fmt.Errorf("%w: final query must be a SELECT", ErrInvalidFederation)
+	}
+
+	// 별칭은 대소문자 구분 없이 중복될 수 없습니다 (SQLite 테이블 이름 규칙)
+	seen := make(map[string]bool, len(fq.Sources))
+	for i, src := range fq.Sources {
+		if !federationAliasPattern.MatchString(src.Alias) {
+			return fmt.Errorf("%w: invalid alias %q at source %d", ErrInvalidFederation, src.Alias, i)
+		}
+
+		key := strings.ToLower(src.Alias)
+		if seen[key] {
+			return fmt.Errorf("%w: duplicate alias %q", ErrInvalidFederation, src.Alias)
+		}
+		seen[key] = true
+
+		if src.DatabaseID == "" {
+			return fmt.Errorf("%w: database ID is required for %q", ErrInvalidFederation, src.Alias)
+		}
+		if strings.TrimSpace(src.Query) == "" {
+			return fmt.Errorf("%w: query is required for %q", ErrInvalidFederation, src.Alias)
+		}
+		// 소스 쿼리는 row 수 제한을 씌우기 위해 서브 쿼리로 감싸므로 SELECT만 허용합니다.
+		if ClassifyStatement(src.Query) != StatementSelect {
+			return fmt.Errorf("%w: query for %q must be a SELECT", ErrInvalidFederation, src.Alias)
+		}
+	}
+
+	return nil
+}
+
+// FederatedSourceQuery는 소스 쿼리를 maxRows+1개까지만 가져오도록 감쌉니다.
+// 전부 메모리에 올린 뒤에 세면 한도를 확인하기 전에 서버가 먼저 죽을 수 있으므로,
+// DB에서 잘라서 가져오고 maxRows를 넘었는지만 확인합니다.
+//
+//	Postgres:   SELECT * FROM (...) dms_src LIMIT 100001
+//	Oracle 19c: SELECT * FROM (...) FETCH FIRST 100001 ROWS ONLY
+//	Oracle 11g: SELECT * FROM (...) WHERE ROWNUM <= 100001
+//
+// 원본 쿼리 끝의 한 줄 주석이 닫는 괄호를 가리지 않도록 줄을 바꿔서 감쌉니다.
+func FederatedSourceQuery(dbType DatabaseType, query string, maxRows int) string {
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	fetch := maxRows + 1
+
+	switch dbType {
+	case Oracle11g:
+		return fmt.Sprintf("SELECT * FROM (\n%s\n) WHERE ROWNUM <= %d", query, fetch)
+	case Oracle19c:
+		return fmt.Sprintf("SELECT * FROM (\n%s\n) FETCH FIRST %d ROWS ONLY", query, fetch)
+	default:
+		return fmt.Sprintf("SELECT * FROM (\n%s\n) dms_src LIMIT %d", query, fetch)
+	}
+}
+
+// FederatedTable은 임베디드 엔진에 적재할 소스 결과입니다.
+type FederatedTable struct {
+	Source FederatedSource
+	Result *QueryResult
+}
+
+// FederatedResult는 페더레이션 쿼리 실행 결과입니다.
+type FederatedResult struct {
+	Result  *QueryResult     // 최종 SQL의 결과
+	Sources []FederatedTable // 소스별 결과 요약 (컬럼 타입 확인용)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFederatedQueryValidate(t *testing.T) {
+	sources := []FederatedSource{
+		{Alias: "students", DatabaseID: "pg", Query: "SELECT id, name FROM students"},
+		{Alias: "scores", DatabaseID: "ora", Query: "SELECT student_id, score FROM scores"},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		sources []FederatedSource
+		wantErr bool
+	}{
+		{"select", "SELECT * FROM students s JOIN scores c ON s.id = c.student_id", sources, false},
+		{"with select", "WITH a AS (SELECT * FROM students) SELECT * FROM a", sources, false},
+		{"empty query", "  ", sources, true},
+		{"attach", "ATTACH DATABASE '/tmp/evil.db' AS evil", sources, true},
+		{"vacuum into", "VACUUM INTO '/tmp/copy.db'", sources, true},
+		{"select then attach", "SELECT 1; ATTACH DATABASE '/tmp/evil.db' AS evil", sources, true},
+		{"pragma", "PRAGMA writable_schema = ON", sources, true},
+		{"one source", "SELECT 1", sources[:1], true},
+		{"non-select source", "SELECT 1", []FederatedSource{
+			sources[0],
+			{Alias: "scores", DatabaseID: "ora", Query: "DELETE FROM scores"},
+		}, true},
+		{"duplicate alias", "SELECT 1", []FederatedSource{
+			sources[0],
+			{Alias: "STUDENTS", DatabaseID: "ora", Query: "SELECT 1 FROM dual"},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fq := FederatedQuery{Sources: tt.sources, Query: tt.query}
+			err := fq.Validate()
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidFederation) {
+					t.Errorf("Validate() error = %v, want %v", err, ErrInvalidFederation)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Validate() error = %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 쿼리 가드 관련 에러
+var (
+	ErrQueryRejected        = errors.New("query rejected by cost guard")
+	ErrConfirmationRequired = errors.New("query requires confirmation")
+)
+
+// GuardAction은 한도를 넘는 쿼리를 어떻게 처리할지 나타냅니다.
+type GuardAction string
+
+const (
+	GuardReject  GuardAction = "reject"  // 무조건 거부
+	GuardConfirm GuardAction = "confirm" // 사용자가 확인(confirm)하면 실행
+)
+
+// IsValid는 지원하는 GuardAction인지 확인합니다.
+func (a GuardAction) IsValid() bool {
+	return a == GuardReject || a == GuardConfirm
+}
+
+// QueryGuard는 DB별 비용 기반 쿼리 가드 설정입니다.
+// 실행 전에 EXPLAIN을 돌려서 추정 비용이나 row 수가 한도를 넘으면 막습니다.
+// (운영 DB에서 조건 없는 카테시안 조인을 실행하는 사고 방지!)
+type QueryGuard struct {
+	MaxCost float64     // 추정 비용 한도 (0이면 검사 안 함)
+	MaxRows float64     // 계획 노드 하나의 추정 row 수 한도 (0이면 검사 안 함)
+	Action  GuardAction // 한도 초과 시 동작
+}
+
+// Validate는 가드 설정의 유효성을 검증합니다.
+func (g *QueryGuard) Validate() error {
+	if g.MaxCost < 0 || g.MaxRows < 0 {
+		return errors.New("guard thresholds must not be negative")
+	}
+	if !g.Action.IsValid() {
+		return fmt.Errorf("unsupported guard action: %s", g.Action)
+	}
+	return nil
+}
+
+// GuardViolation은 한도를 넘은 계획 노드 하나입니다.
+type GuardViolation struct {
+	Node   *PlanNode
+	Metric string  // "cost" 또는 "rows"
+	Value  float64 // 노드의 추정값
+	Limit  float64 // 설정된 한도
+}
+
+// Check는 실행 계획을 검사해서 한도를 넘은 노드 목록을 반환합니다.
+// 빈 슬라이스면 통과입니다.
+//
+// 비용은 하위 노드를 포함한 누적값이라 최상위 노드는 항상 한도를 넘습니다.
+// 그래서 "한도를 넘었지만 자식은 넘지 않은" 가장 깊은 노드를 원인으로 보고합니다.
+// (예: 카테시안 조인이면 Nested Loop 노드가 보고됨)
+//
+// row 수는 누적값이 아닙니다. 집계 결과가 1 row여도 그 아래 조인은 10억 row일 수 있으므로
+// 모든 노드를 보고 한도를 넘은 노드를 전부 보고합니다.
+func (g *QueryGuard) Check(plan *QueryPlan) []GuardViolation {
+	var violations []GuardViolation
+	if plan == nil || plan.Root == nil {
+		return violations
+	}
+
+	if g.MaxCost > 0 && plan.Root.Cost > g.MaxCost {
+		plan.Root.Walk(func(node *PlanNode) bool {
+			if node.Cost <= g.MaxCost {
+				// 이 노드가 한도 이내면 하위도 볼 필요 없음 (누적값이므로)
+				return false
+			}
+
+			for _, child := range node.Children {
+				if child.Cost > g.MaxCost {
+					// 자식 중에 원인이 있으니 더 내려감
+					return true
+				}
+			}
+
+			violations = append(violations, GuardViolation{
+				Node:   node,
+				Metric: "cost",
+				Value:  node.Cost,
+				Limit:  g.MaxCost,
+			})
+			return false
+		})
+	}
+
+	if g.MaxRows > 0 {
+		plan.Root.Walk(func(node *PlanNode) bool {
+			if node.Rows > g.MaxRows {
+				violations = append(violations, GuardViolation{
+					Node:   node,
+					Metric: "rows",
+					Value:  node.Rows,
+					Limit:  g.MaxRows,
+				})
+			}
+			return true
+		})
+	}
+
+	return violations
+}
+
+// QueryGuardError는 가드에 걸린 쿼리의 상세 정보를 담는 에러입니다.
+// HTTP 어댑터는 errors.As()로 꺼내서 문제 노드를 응답에 담습니다.
+type QueryGuardError struct {
+	Action        GuardAction
+	TotalCost     float64
+	EstimatedRows float64
+	Violations    []GuardViolation
+}
+
+// Error는 error 인터페이스를 구현합니다.
+func (e *QueryGuardError) Error() string {
+	return fmt.Sprintf("%v: estimated cost %.0f, rows %.0f (%d offending plan nodes)",
+		e.Unwrap(), e.TotalCost, e.EstimatedRows, len(e.Violations))
+}
+
+// Unwrap은 Action에 따라 ErrQueryRejected 또는 ErrConfirmationRequired를 반환합니다.
+// 덕분에 errors.Is(err, domain.ErrConfirmationRequired)로 확인할 수 있습니다.
+func (e *QueryGuardError) Unwrap() error {
+	if e.Action == GuardConfirm {
+		return ErrConfirmationRequired
+	}
+	return ErrQueryRejected
+}
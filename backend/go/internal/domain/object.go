@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 데이터베이스 객체 조회 관련 에러
+var (
+	ErrObjectNotFound    = errors.New("database object not found")
+	ErrInvalidObjectType = errors.New("invalid database object type")
+)
+
+// ObjectType은 테이블 외의 데이터베이스 객체 종류입니다.
+type ObjectType string
+
+const (
+	ObjectView             ObjectType = "view"
+	ObjectMaterializedView ObjectType = "materialized_view"
+	ObjectFunction         ObjectType = "function"
+	ObjectProcedure        ObjectType = "procedure"
+	ObjectPackage          ObjectType = "package" // Oracle 전용 (명세 + 본문)
+	ObjectTrigger          ObjectType = "trigger"
+	ObjectSequence         ObjectType = "sequence"
+
+	// 아래 두 종류는 객체 목록(ListObjects)에는 나오지 않고 DDL 생성에서만 씁니다.
+	// (테이블은 GetTables, 인덱스는 테이블 메타데이터로 조회)
+	ObjectTable ObjectType = "table"
+	ObjectIndex ObjectType = "index"
+)
+
+// ObjectTypes는 지원하는 모든 객체 종류입니다 (목록 정렬 순서).
+var ObjectTypes = []ObjectType{
+	ObjectView,
+	ObjectMaterializedView,
+	ObjectFunction,
+	ObjectProcedure,
+	ObjectPackage,
+	ObjectTrigger,
+	ObjectSequence,
+}
+
+// IsValid는 지원하는 객체 종류인지 확인합니다.
+func (t ObjectType) IsValid() bool {
+	for _, valid := range ObjectTypes {
+		if t == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDDL은 DDL(CREATE 문)을 만들 수 있는 종류인지 확인합니다.
+func (t ObjectType) HasDDL() bool {
+	return t == ObjectTable || t == ObjectIndex || t.IsValid()
+}
+
+// ParseObjectTypes는 "view,function" 같은 쉼표 구분 문자열을 객체 종류 목록으로 바꿉니다.
+// 빈 문자열이면 nil(= 전체)을 반환합니다.
+func ParseObjectTypes(s string) ([]ObjectType, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	var types []ObjectType
+	for _, part := range strings.Split(s, ",") {
+		t := ObjectType(strings.TrimSpace(part))
+		if !t.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidObjectType, t)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// DatabaseObject는 뷰, 함수, 트리거 같은 데이터베이스 객체 하나입니다.
+// 목록 조회에서는 Definition/Body/Details가 비어 있고, 상세 조회에서만 채워집니다.
+type DatabaseObject struct {
+	Schema string
+	Name   string
+	Type   ObjectType
+
+	// Status는 컴파일 상태입니다 (Oracle: VALID/INVALID, Postgres는 빈 문자열).
+	Status string
+
+	// Table은 트리거가 걸린 테이블입니다 (트리거만).
+	Table string
+
+	// Arguments는 함수/프로시저의 인자 목록입니다 (Postgres 오버로딩 구분용).
+	// 예: "user_id integer, active boolean"
+	// 상세 조회에서 이름을 "add_user(name text)"처럼 쓰면 이 값으로 오버로딩을 고릅니다.
+	Arguments string
+
+	// Definition은 객체의 정의(소스)입니다.
+	//   - 뷰: SELECT 문 (pg_get_viewdef, all_views.text)
+	//   - 함수/프로시저/트리거: 전체 소스 (pg_get_functiondef, all_source)
+	//   - 패키지: 명세 (PACKAGE)
+	//   - 시퀀스: CREATE SEQUENCE 문
+	Definition string
+
+	// Body는 Oracle 패키지 본문(PACKAGE BODY)입니다.
+	Body string
+
+	// Details는 객체 종류별 추가 정보입니다.
+	// (시퀀스: increment_by, min_value, max_value, last_value 등 / 트리거: timing, event)
+	Details map[string]interface{}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 쓰기 권한 관련 에러
+var (
+	ErrAuthorRequired  = errors.New("author is required")
+	ErrWriteNotAllowed = errors.New("write not allowed")
+)
+
+// WriteScope는 DMS가 대신 실행하는 쓰기 작업의 종류입니다.
+// 사용자가 직접 쓴 SQL(ExecuteQuery)이 아니라, DMS가 만들어서 실행하는 문장에 적용합니다.
+type WriteScope string
+
+const (
+	WriteComments WriteScope = "comments" // 테이블/컬럼 주석 (COMMENT ON)
+	WriteRows     WriteScope = "rows"     // 행 추가/수정/삭제
+	WriteTables   WriteScope = "tables"   // 테이블 만들기 (테이블 복사 작업)
+)
+
+// IsValid는 지원하는 WriteScope인지 확인합니다.
+func (s WriteScope) IsValid() bool {
+	return s == WriteComments || s == WriteRows || s == WriteTables
+}
+
+// AnyWriter는 모든 사용자에게 쓰기를 허용하는 사용자 이름입니다.
+const AnyWriter = "*"
+
+// WritePolicy는 DB별 쓰기 권한입니다. 작업 종류마다 허용할 사용자 목록을 둡니다.
+//
+//	comments = ["kim", "lee"]   # 데이터 담당자는 주석만
+//	rows     = ["dba"]          # 데이터 수정은 DBA만
+//
+// 목록에 없는 작업은 아무도 할 수 없습니다. 정책이 없는 DB(nil)는 읽기 전용입니다.
+// 사용자 이름은 대소문자를 구분하지 않습니다.
+type WritePolicy map[WriteScope][]string
+
+// Validate는 쓰기 권한 설정의 유효성을 검증합니다.
+func (p WritePolicy) Validate() error {
+	for scope, users := range p {
+		if !scope.IsValid() {
+			return fmt.Errorf("unsupported write scope: %s (use comments, rows or tables)", scope)
+		}
+		for _, user := range users {
+			if strings.TrimSpace(user) == "" {
+				return fmt.Errorf("empty user name in write scope %s", scope)
+			}
+		}
+	}
+	return nil
+}
+
+// Allows는 author가 scope 작업을 할 수 있는지 확인합니다.
+func (p WritePolicy) Allows(scope WriteScope, author string) bool {
+	for _, user := range p[scope] {
+		if user == AnyWriter || strings.EqualFold(user, author) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckWrite는 DB에서 author가 scope 작업을 할 수 있는지 확인합니다.
+//
+// 반환값:
+//   - ErrAuthorRequired: 누가 바꾸는지 모르면 변경 이력을 남길 수 없으므로 거부
+//   - ErrWriteNotAllowed: 쓰기 권한 설정에 없는 사용자
+func (db *Database) CheckWrite(scope WriteScope, author string) error {
+	if strings.TrimSpace(author) == "" {
+		return ErrAuthorRequired
+	}
+	if !db.Write.Allows(scope, author) {
+		return fmt.Errorf("%w: %s cannot change %s on %s", ErrWriteNotAllowed, author, scope, db.ID)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "errors"
+
+// 실행 계획 관련 에러
+var (
+	ErrExplainNotSupported = errors.New("explain is not supported for this statement")
+)
+
+// PlanNode는 DB 종류와 상관없이 공통으로 쓰는 실행 계획 노드입니다.
+// Postgres의 JSON 계획과 Oracle의 PLAN_TABLE 모두 이 구조로 변환됩니다.
+// 프론트엔드는 이 트리 하나만 알면 모든 DB의 계획을 같은 방식으로 그릴 수 있습니다.
+type PlanNode struct {
+	// Operation은 연산 이름입니다 (예: "Seq Scan", "TABLE ACCESS FULL").
+	Operation string
+
+	// Object는 연산 대상 객체입니다 (테이블, 인덱스 이름). 없으면 빈 문자열.
+	Object string
+
+	// Cost는 옵티마이저가 추정한 누적 비용입니다.
+	Cost float64
+
+	// Rows는 옵티마이저가 추정한 row 수입니다.
+	Rows float64
+
+	// ActualRows, ActualTimeMs는 ANALYZE 모드에서만 채워집니다.
+	// 포인터를 쓰는 이유: 0과 "값 없음"을 구분하기 위해!
+	ActualRows   *float64
+	ActualTimeMs *float64
+
+	// Details는 조건절 등 DB별 부가 정보입니다 (예: "Filter", "access_predicates").
+	Details map[string]interface{}
+
+	// Children은 하위 노드들입니다.
+	Children []*PlanNode
+}
+
+// Walk는 노드와 모든 하위 노드를 깊이 우선으로 방문합니다.
+// fn이 false를 반환하면 해당 노드의 하위는 방문하지 않습니다.
+func (n *PlanNode) Walk(fn func(node *PlanNode) bool) {
+	if n == nil {
+		return
+	}
+	if !fn(n) {
+		return
+	}
+	for _, child := range n.Children {
+		child.Walk(fn)
+	}
+}
+
+// QueryPlan은 쿼리 하나의 실행 계획입니다.
+type QueryPlan struct {
+	// Root는 계획 트리의 최상위 노드입니다.
+	Root *PlanNode
+
+	// Analyzed는 실제로 실행해서 얻은 계획인지 여부입니다.
+	Analyzed bool
+
+	// PlanningTimeMs, ExecutionTimeMs는 DB가 알려주는 경우에만 채워집니다.
+	PlanningTimeMs  *float64
+	ExecutionTimeMs *float64
+
+	// Raw는 DB가 반환한 원본 계획입니다 (Postgres JSON, Oracle DBMS_XPLAN 텍스트).
+	Raw string
+}
+
+// TotalCost는 최상위 노드의 추정 비용을 반환합니다.
+func (p *QueryPlan) TotalCost() float64 {
+	if p.Root == nil {
+		return 0
+	}
+	return p.Root.Cost
+}
+
+// EstimatedRows는 최상위 노드의 추정 row 수를 반환합니다.
+func (p *QueryPlan) EstimatedRows() float64 {
+	if p.Root == nil {
+		return 0
+	}
+	return p.Root.Rows
+}
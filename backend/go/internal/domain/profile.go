@@ -0,0 +1,494 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 프로파일링 관련 에러
+var (
+	ErrInvalidProfile = errors.New("invalid profile request")
+)
+
+// 프로파일링 옵션 기본값/최대값
+const (
+	DefaultProfileTopN          = 10
+	MaxProfileTopN              = 100
+	DefaultProfileLengthBuckets = 10
+	MaxProfileLengthBuckets     = 100
+)
+
+// ProfileRequest는 컬럼 프로파일링 요청입니다.
+// Table과 Query 중 하나만 지정합니다.
+type ProfileRequest struct {
+	Schema string // Table의 스키마 (비어 있으면 DB 기본 스키마)
+	Table  string
+	Query  string // SELECT 쿼리 (결과를 하나의 테이블처럼 프로파일링)
+
+	// Columns는 프로파일링할 컬럼입니다 (비어 있으면 전체).
+	Columns []string
+
+	// SamplePercent는 표본 비율(%)입니다. 0이면 전체 row를 읽습니다.
+	// 큰 테이블에서 블록 단위로 일부만 읽습니다 (Table에만 사용 가능).
+	//   - Postgres: TABLESAMPLE SYSTEM (p) REPEATABLE (seed)
+	//   - Oracle:   SAMPLE BLOCK (p) SEED (seed)
+	SamplePercent float64
+
+	// Seed는 표본 시드입니다. 컬럼마다 여러 쿼리를 실행하므로 모든 쿼리가 같은 표본을
+	// 보도록 고정합니다. 0이면 서비스가 정하고, 결과에 돌려주므로 같은 표본을 다시 볼 수 있습니다.
+	Seed int64
+
+	TopN          int // 컬럼별 최빈값 개수 (0이면 기본값 10)
+	LengthBuckets int // 문자열 길이 분포 구간 수 (0이면 기본값 10)
+
+	// ApproxDistinct는 고유값 개수를 근사치로 셉니다 (Oracle 19c의 APPROX_COUNT_DISTINCT).
+	// 근사 함수가 없는 DB는 정확한 COUNT(DISTINCT)를 씁니다 (ColumnProfile.DistinctApprox로 구분).
+	ApproxDistinct bool
+}
+
+// Validate는 프로파일링 요청의 유효성을 검증하고 비어 있는 옵션을 기본값으로 채웁니다.
+func (r *ProfileRequest) Validate() error {
+	r.Table = strings.TrimSpace(r.Table)
+	r.Query = strings.TrimRight(strings.TrimSpace(r.Query), ";")
+
+	switch {
+	case r.Table == "" && r.Query == "":
+		return fmt.Errorf("%w: table or query is required", ErrInvalidProfile)
+	case r.Table != "" && r.Query != "":
+		return fmt.Errorf("%w: specify either table or query, not both", ErrInvalidProfile)
+	}
+
+	if r.Query != "" {
+		if ClassifyStatement(r.Query) != StatementSelect {
+			return fmt.Errorf("%w: only SELECT queries can be profiled", ErrInvalidProfile)
+		}
+		if r.SamplePercent != 0 {
+			return fmt.Errorf("%w: sampling is only supported for tables", ErrInvalidProfile)
+		}
+	}
+
+	if r.SamplePercent < 0 || r.SamplePercent >= 100 || math.IsNaN(r.SamplePercent) {
+		return fmt.Errorf("%w: sample percent must be at least 0 and less than 100 (0 = no sampling)", ErrInvalidProfile)
+	}
+	if r.Seed < 0 || r.Seed > math.MaxInt32 {
+		return fmt.Errorf("%w: seed must be between 0 and %d", ErrInvalidProfile, math.MaxInt32)
+	}
+
+	if r.TopN < 0 || r.TopN > MaxProfileTopN {
+		return fmt.Errorf("%w: top must be between 1 and %d", ErrInvalidProfile, MaxProfileTopN)
+	}
+	if r.TopN == 0 {
+		r.TopN = DefaultProfileTopN
+	}
+
+	if r.LengthBuckets < 0 || r.LengthBuckets > MaxProfileLengthBuckets {
+		return fmt.Errorf("%w: buckets must be between 1 and %d", ErrInvalidProfile, MaxProfileLengthBuckets)
+	}
+	if r.LengthBuckets == 0 {
+		r.LengthBuckets = DefaultProfileLengthBuckets
+	}
+
+	return nil
+}
+
+// ProfileCategory는 컬럼 타입에 따라 계산할 수 있는 통계의 종류입니다.
+type ProfileCategory string
+
+const (
+	ProfileNumeric  ProfileCategory = "numeric"  // 최소/최대, 평균/표준편차
+	ProfileText     ProfileCategory = "text"     // 최소/최대, 길이 통계와 분포
+	ProfileTemporal ProfileCategory = "temporal" // 최소/최대
+	ProfileOther    ProfileCategory = "other"    // 고유값, 최빈값만 (boolean, uuid 등)
+	ProfileLOB      ProfileCategory = "lob"      // NULL 비율만 (CLOB, BLOB, json 등은 비교/그룹핑 불가)
+)
+
+// ProfileCategoryOf는 타입 이름으로 프로파일링 범주를 정합니다.
+// 카탈로그 타입 이름("character varying", "VARCHAR2")과
+// 드라이버 타입 이름("VARCHAR", "OCIClobLocator", "TimeStampDTY")을 모두 처리합니다.
+func ProfileCategoryOf(typeName string) ProfileCategory {
+	upper := strings.ToUpper(strings.TrimSpace(typeName))
+
+	switch {
+	case strings.Contains(upper, "LOB"), strings.Contains(upper, "XML"), strings.Contains(upper, "FILE"),
+		strings.HasPrefix(upper, "LONG"), upper == "JSON", upper == "BYTEA":
+		return ProfileLOB
+
+	// 배열(_INT4, integer[])과 범위 타입은 숫자 타입 이름으로 시작해도 평균을 낼 수 없음
+	case strings.HasPrefix(upper, "_"), strings.HasSuffix(upper, "[]"), strings.Contains(upper, "RANGE"):
+		return ProfileOther
+
+	case strings.HasPrefix(upper, "DATE"), strings.HasPrefix(upper, "TIME"), strings.HasPrefix(upper, "INTERVAL"):
+		return ProfileTemporal
+
+	case upper == "MONEY":
+		return ProfileOther // Postgres는 AVG(money)가 없음
+
+	case IsNumericType(upper), strings.HasPrefix(upper, "BFLOAT"), strings.HasPrefix(upper, "BDOUBLE"):
+		return ProfileNumeric
+
+	case strings.Contains(upper, "CHAR"), strings.Contains(upper, "TEXT"), upper == "NAME":
+		return ProfileText
+	}
+
+	return ProfileOther
+}
+
+// ProfileColumn은 프로파일링할 컬럼입니다. Name은 카탈로그(또는 쿼리 결과)에 있는 그대로입니다.
+type ProfileColumn struct {
+	Name     string
+	DataType string
+	Category ProfileCategory
+}
+
+// TableProfile은 테이블(또는 쿼리 결과)의 프로파일링 결과입니다.
+type TableProfile struct {
+	DatabaseID string
+	Schema     string
+	Table      string
+	Query      string
+
+	// RowCount는 프로파일링한 row 수입니다 (표본이면 표본의 row 수).
+	RowCount int64
+
+	Sampled       bool
+	SamplePercent float64
+	Seed          int64 // 같은 표본을 다시 보려면 요청에 이 값을 넘김
+
+	Columns []ColumnProfile
+
+	// Queries는 실행한 쿼리 수입니다 (집계 1 + 길이 분포 + 최빈값).
+	Queries       int
+	ExecutionTime time.Duration
+}
+
+// ColumnProfile은 컬럼 하나의 프로파일링 결과입니다.
+// 포인터 필드는 컬럼 범주에서 계산하지 않는 값입니다 (nil = 해당 없음).
+type ColumnProfile struct {
+	Name     string
+	DataType string
+	Category ProfileCategory
+
+	NullCount int64
+	NullRatio float64 // 0~1 (row가 없으면 0)
+
+	DistinctCount  *int64
+	DistinctApprox bool // 근사치로 센 값인지
+
+	Min interface{}
+	Max interface{}
+
+	Mean   *float64 // 숫자만
+	StdDev *float64 // 숫자만 (표본 표준편차, 값이 하나면 nil)
+
+	MinLength *int64 // 문자열만
+	MaxLength *int64
+	AvgLength *float64
+
+	LengthHistogram []LengthBucket
+	TopValues       []ValueFrequency
+}
+
+// LengthBucket은 문자열 길이 분포의 구간 하나입니다 (From ≤ 길이 ≤ To).
+type LengthBucket struct {
+	From  int64
+	To    int64
+	Count int64
+}
+
+// ValueFrequency는 최빈값 하나입니다.
+type ValueFrequency struct {
+	Value interface{}
+	Count int64
+	Ratio float64 // NULL을 포함한 전체 row 대비 비율
+}
+
+// ProfileTableSource는 프로파일링 쿼리의 FROM 절에 쓸 테이블을 만듭니다.
+// 이름은 카탈로그에 저장된 그대로 받습니다 (schema가 비어 있으면 현재 스키마).
+//
+//	Postgres: hr.employees TABLESAMPLE SYSTEM (5) REPEATABLE (42)
+//	Oracle:   hr.employees SAMPLE BLOCK (5) SEED (42)
+//
+// 블록 단위 표본은 읽는 블록 자체가 줄어서 큰 테이블에서 빠르지만,
+// 데이터가 블록에 몰려 있으면 (적재 순서와 값이 관련 있으면) 치우칠 수 있습니다.
+func ProfileTableSource(dbType DatabaseType, schema, table string, samplePercent float64, seed int64) (string, error) {
+	dialect := DialectOf(dbType)
+	if dialect == "" {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	source := QuoteCatalogIdentifier(dbType, table)
+	if schema != "" {
+		source = QuoteCatalogIdentifier(dbType, schema) + "." + source
+	}
+
+	if samplePercent <= 0 {
+		return source, nil
+	}
+
+	percent := strconv.FormatFloat(samplePercent, 'f', -1, 64)
+	if dialect == DialectOracle {
+		return fmt.Sprintf("%s SAMPLE BLOCK (%s) SEED (%d)", source, percent, seed), nil
+	}
+	return fmt.Sprintf("%s TABLESAMPLE SYSTEM (%s) REPEATABLE (%d)", source, percent, seed), nil
+}
+
+// ProfileQuerySource는 SELECT 쿼리를 FROM 절에 쓸 인라인 뷰로 감쌉니다.
+// Oracle은 테이블 별칭에 AS를 쓸 수 없으므로 두 DB 모두 AS 없이 씁니다.
+func ProfileQuerySource(query string) string {
+	return "(" + query + ") src"
+}
+
+// ProfileShapeQuery는 쿼리 결과의 컬럼 이름/타입만 얻는 쿼리입니다 (row는 읽지 않음).
+func ProfileShapeQuery(source string) string {
+	return "SELECT * FROM " + source + " WHERE 1 = 0"
+}
+
+// ProfileAggregateQuery는 모든 컬럼의 기본 통계를 한 번에 세는 집계 쿼리를 만듭니다.
+// 테이블을 한 번만 읽도록 컬럼마다 집계 함수를 나열합니다.
+//
+//	SELECT COUNT(*) AS row_count,
+//	       COUNT(salary) AS n1, COUNT(DISTINCT salary) AS d1,
+//	       MIN(salary) AS mn1, MAX(salary) AS mx1, AVG(salary) AS av1, STDDEV_SAMP(salary) AS sd1,
+//	       ...
+//	FROM hr.employees
+//
+// 별칭은 컬럼 순서 번호로 만들어서 컬럼 이름 길이/문자와 관계없게 합니다.
+// 반환값의 bool은 고유값 개수를 근사치로 세는지 여부입니다.
+func ProfileAggregateQuery(dbType DatabaseType, source string, columns []ProfileColumn, approxDistinct bool) (string, bool) {
+	approx := approxDistinct && dbType == Oracle19c
+
+	items := []string{"COUNT(*) AS row_count"}
+	for i, col := range columns {
+		name := QuoteCatalogIdentifier(dbType, col.Name)
+		n := i + 1
+
+		items = append(items, fmt.Sprintf("COUNT(%s) AS n%d", name, n))
+		if col.Category == ProfileLOB {
+			continue
+		}
+
+		if approx {
+			items = append(items, fmt.Sprintf("APPROX_COUNT_DISTINCT(%s) AS d%d", name, n))
+		} else {
+			items = append(items, fmt.Sprintf("COUNT(DISTINCT %s) AS d%d", name, n))
+		}
+
+		switch col.Category {
+		case ProfileNumeric:
+			items = append(items,
+				fmt.Sprintf("MIN(%s) AS mn%d", name, n),
+				fmt.Sprintf("MAX(%s) AS mx%d", name, n),
+				fmt.Sprintf("AVG(%s) AS av%d", name, n),
+				fmt.Sprintf("STDDEV_SAMP(%s) AS sd%d", name, n))
+		case ProfileText:
+			items = append(items,
+				fmt.Sprintf("MIN(%s) AS mn%d", name, n),
+				fmt.Sprintf("MAX(%s) AS mx%d", name, n),
+				fmt.Sprintf("MIN(LENGTH(%s)) AS ln%d", name, n),
+				fmt.Sprintf("MAX(LENGTH(%s)) AS lx%d", name, n),
+				fmt.Sprintf("AVG(LENGTH(%s)) AS la%d", name, n))
+		case ProfileTemporal:
+			items = append(items,
+				fmt.Sprintf("MIN(%s) AS mn%d", name, n),
+				fmt.Sprintf("MAX(%s) AS mx%d", name, n))
+		}
+	}
+
+	return "SELECT " + strings.Join(items, ", ") + " FROM " + source, approx
+}
+
+// ApplyProfileAggregates는 집계 쿼리 결과 row로 컬럼 프로파일을 만듭니다.
+// Oracle은 별칭을 대문자로 돌려주므로 이름은 대소문자 없이 찾습니다.
+func ApplyProfileAggregates(row map[string]interface{}, columns []ProfileColumn, approx bool) (int64, []ColumnProfile) {
+	rowCount, _ := profileInt(profileValue(row, "row_count"))
+
+	profiles := make([]ColumnProfile, len(columns))
+	for i, col := range columns {
+		n := i + 1
+		p := ColumnProfile{Name: col.Name, DataType: col.DataType, Category: col.Category}
+
+		count, _ := profileInt(profileValue(row, fmt.Sprintf("n%d", n)))
+		p.NullCount = rowCount - count
+		if rowCount > 0 {
+			p.NullRatio = float64(p.NullCount) / float64(rowCount)
+		}
+
+		if col.Category != ProfileLOB {
+			if distinct, ok := profileInt(profileValue(row, fmt.Sprintf("d%d", n))); ok {
+				p.DistinctCount = &distinct
+				p.DistinctApprox = approx
+			}
+		}
+
+		switch col.Category {
+		case ProfileNumeric, ProfileText, ProfileTemporal:
+			p.Min = displayValue(profileValue(row, fmt.Sprintf("mn%d", n)))
+			p.Max = displayValue(profileValue(row, fmt.Sprintf("mx%d", n)))
+		}
+
+		if col.Category == ProfileNumeric {
+			p.Mean = profileFloatPtr(profileValue(row, fmt.Sprintf("av%d", n)))
+			p.StdDev = profileFloatPtr(profileValue(row, fmt.Sprintf("sd%d", n)))
+		}
+
+		if col.Category == ProfileText {
+			if v, ok := profileInt(profileValue(row, fmt.Sprintf("ln%d", n))); ok {
+				p.MinLength = &v
+			}
+			if v, ok := profileInt(profileValue(row, fmt.Sprintf("lx%d", n))); ok {
+				p.MaxLength = &v
+			}
+			p.AvgLength = profileFloatPtr(profileValue(row, fmt.Sprintf("la%d", n)))
+		}
+
+		profiles[i] = p
+	}
+
+	return rowCount, profiles
+}
+
+// LengthBucketWidth는 길이 분포 구간 하나의 너비를 정합니다.
+// 길이는 정수이므로 구간도 정수 너비로 나눕니다 (범위가 구간 수보다 작으면 길이 하나가 한 구간).
+func LengthBucketWidth(minLength, maxLength int64, buckets int) int64 {
+	span := maxLength - minLength + 1
+	width := span / int64(buckets)
+	if span%int64(buckets) != 0 {
+		width++
+	}
+	if width < 1 {
+		width = 1
+	}
+	return width
+}
+
+// ProfileLengthQuery는 문자열 컬럼의 길이 분포를 세는 쿼리를 만듭니다.
+// 구간 번호 = FLOOR((LENGTH(c) - 최소 길이) / 너비) 이며, 0부터 시작합니다.
+//
+//	SELECT FLOOR((LENGTH(email) - 5) / 4) AS bucket, COUNT(*) AS cnt
+//	FROM hr.employees WHERE email IS NOT NULL
+//	GROUP BY FLOOR((LENGTH(email) - 5) / 4)
+func ProfileLengthQuery(dbType DatabaseType, source string, column string, minLength, width int64) string {
+	bucket := fmt.Sprintf("FLOOR((LENGTH(%s) - %d) / %d)", QuoteCatalogIdentifier(dbType, column), minLength, width)
+
+	return fmt.Sprintf("SELECT %s AS bucket, COUNT(*) AS cnt FROM %s WHERE %s IS NOT NULL GROUP BY %s",
+		bucket, source, QuoteCatalogIdentifier(dbType, column), bucket)
+}
+
+// ApplyLengthHistogram은 길이 분포 쿼리 결과로 구간 목록을 만듭니다.
+// row가 없는 구간도 Count 0으로 채워서 최소 길이부터 최대 길이까지 빠짐없이 반환합니다.
+func ApplyLengthHistogram(result *QueryResult, minLength, maxLength, width int64) []LengthBucket {
+	var histogram []LengthBucket
+	for from := minLength; from <= maxLength; from += width {
+		histogram = append(histogram, LengthBucket{From: from, To: min(from+width-1, maxLength)})
+	}
+
+	for _, row := range result.Rows {
+		bucket, ok := profileInt(profileValue(row, "bucket"))
+		if !ok || bucket < 0 || bucket >= int64(len(histogram)) {
+			continue
+		}
+		histogram[bucket].Count, _ = profileInt(profileValue(row, "cnt"))
+	}
+
+	return histogram
+}
+
+// ProfileTopValuesQuery는 컬럼의 최빈값 topN개를 세는 쿼리를 만듭니다 (NULL 제외).
+// 개수가 같으면 값 순서로 정렬해서 매번 같은 결과가 나오게 합니다 (순서가 있는 타입만).
+//
+//	Postgres:   ... ORDER BY COUNT(*) DESC, dept LIMIT 10
+//	Oracle 19c: ... ORDER BY COUNT(*) DESC, dept FETCH FIRST 10 ROWS ONLY
+//	Oracle 11g: SELECT val, cnt FROM (... ORDER BY COUNT(*) DESC, dept) WHERE ROWNUM <= 10
+func ProfileTopValuesQuery(dbType DatabaseType, source string, col ProfileColumn, topN int) string {
+	name := QuoteCatalogIdentifier(dbType, col.Name)
+
+	order := "COUNT(*) DESC"
+	switch col.Category {
+	case ProfileNumeric, ProfileText, ProfileTemporal:
+		order += ", " + name
+	}
+
+	query := fmt.Sprintf("SELECT %s AS val, COUNT(*) AS cnt FROM %s WHERE %s IS NOT NULL GROUP BY %s ORDER BY %s",
+		name, source, name, name, order)
+
+	switch dbType {
+	case Oracle11g:
+		return fmt.Sprintf("SELECT val, cnt FROM (%s) WHERE ROWNUM <= %d", query, topN)
+	case Oracle19c:
+		return fmt.Sprintf("%s FETCH FIRST %d ROWS ONLY", query, topN)
+	default:
+		return fmt.Sprintf("%s LIMIT %d", query, topN)
+	}
+}
+
+// ApplyTopValues는 최빈값 쿼리 결과로 최빈값 목록을 만듭니다.
+func ApplyTopValues(result *QueryResult, rowCount int64) []ValueFrequency {
+	values := make([]ValueFrequency, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		count, _ := profileInt(profileValue(row, "cnt"))
+		v := ValueFrequency{Value: displayValue(profileValue(row, "val")), Count: count}
+		if rowCount > 0 {
+			v.Ratio = float64(count) / float64(rowCount)
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// profileValue는 row에서 이름으로 값을 찾습니다 (대소문자 무시).
+func profileValue(row map[string]interface{}, name string) interface{} {
+	if v, ok := row[name]; ok {
+		return v
+	}
+	for key, v := range row {
+		if strings.EqualFold(key, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// profileFloat는 집계 결과를 float64로 바꿉니다.
+// 드라이버마다 숫자를 int64, float64, 문자열, []byte(lib/pq의 NUMERIC) 등으로 돌려줍니다.
+func profileFloat(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case nil:
+		return 0, false
+	case float64:
+		return val, true
+	case float32:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case int:
+		return float64(val), true
+	case []byte:
+		f, err := strconv.ParseFloat(strings.TrimSpace(string(val)), 64)
+		return f, err == nil
+	default:
+		f, err := strconv.ParseFloat(strings.TrimSpace(fmt.Sprint(val)), 64)
+		return f, err == nil
+	}
+}
+
+// profileInt는 집계 결과(개수, 길이)를 int64로 바꿉니다.
+func profileInt(v interface{}) (int64, bool) {
+	f, ok := profileFloat(v)
+	if !ok {
+		return 0, false
+	}
+	return int64(math.Round(f)), true
+}
+
+// profileFloatPtr는 집계 결과를 *float64로 바꿉니다 (NULL이면 nil).
+func profileFloatPtr(v interface{}) *float64 {
+	f, ok := profileFloat(v)
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+	return &f
+}
@@ -12,6 +12,11 @@ type QueryResult struct {
 	// 슬라이스는 동적 배열로, Java의 ArrayList와 비슷합니다.
 	Columns []string // 컬럼 이름들 (예: ["id", "name", "email"])
 
+	// ColumnTypes는 Columns와 같은 순서의 DB 원본 타입 이름입니다.
+	// 드라이버가 알려주는 이름 그대로 담습니다 (예: "NUMERIC", "VARCHAR2").
+	// 타입 정보를 얻을 수 없으면 비어 있을 수 있습니다.
+	ColumnTypes []string
+
 	// []map[string]interface{}는 복잡해 보이지만,
 	// "각 row는 map이고, 여러 row를 슬라이스로 담는다"는 의미입니다.
 	// interface{}는 Java의 Object와 비슷합니다 (모든 타입 가능)
@@ -22,6 +27,24 @@ type QueryResult struct {
 
 	// time.Duration은 시간 간격을 나타냅니다
 	ExecutionTime time.Duration // 쿼리 실행 시간
+
+	// Cache는 결과 캐시 정보입니다 (캐시를 쓰지 않았으면 nil).
+	Cache *CacheInfo
+}
+
+// QueryOptions는 쿼리 실행 시 요청별 옵션입니다.
+type QueryOptions struct {
+	// Confirmed는 사용자가 가드 경고를 확인했는지 여부입니다.
+	// GuardConfirm 동작인 가드만 통과시킵니다 (GuardReject는 항상 거부).
+	Confirmed bool
+
+	// CacheTTL은 이 요청의 결과 캐시 TTL입니다.
+	// nil이면 DB 설정(Database.CacheTTL)을 따르고, 0이면 캐시를 쓰지 않습니다.
+	CacheTTL *time.Duration
+
+	// RefreshCache가 true면 캐시를 읽지 않고 다시 실행한 뒤 저장합니다.
+	// (HTTP의 Cache-Control: no-cache)
+	RefreshCache bool
 }
 
 // IsEmpty는 결과가 비어있는지 확인합니다.
@@ -87,6 +110,17 @@ func (qr *QueryResult) GetColumn(columnName string) ([]interface{}, error) {
 	return values, nil
 }
 
+// ColumnType은 특정 컬럼의 원본 타입 이름을 반환합니다.
+// 타입 정보가 없으면 빈 문자열을 반환합니다.
+func (qr *QueryResult) ColumnType(columnName string) string {
+	for i, col := range qr.Columns {
+		if col == columnName && i < len(qr.ColumnTypes) {
+			return qr.ColumnTypes[i]
+		}
+	}
+	return ""
+}
+
 // FormatExecutionTime은 실행 시간을 사람이 읽기 쉬운 형태로 반환합니다.
 func (qr *QueryResult) FormatExecutionTime() string {
 	// time.Duration은 자동으로 적절한 단위로 변환됩니다
@@ -0,0 +1,504 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 결과 비교 관련 에러
+var (
+	ErrInvalidDiff       = errors.New("invalid result diff request")
+	ErrDiffLimitExceeded = errors.New("result diff row limit exceeded")
+)
+
+// DiffMode는 결과 비교 방식입니다.
+type DiffMode string
+
+const (
+	// DiffModeFull은 변경된 row의 컬럼별 차이까지 계산합니다.
+	DiffModeFull DiffMode = "full"
+
+	// DiffModeHash는 row마다 해시만 비교합니다.
+	// 큰 결과에서 메모리를 아끼는 대신 컬럼별 차이는 제공하지 않습니다.
+	DiffModeHash DiffMode = "hash"
+)
+
+// DefaultDiffMaxRows는 응답에 담을 최대 차이 row 수의 기본값입니다.
+// 개수(Summary)는 항상 전체를 셉니다.
+const DefaultDiffMaxRows = 1000
+
+// DefaultDiffMaxRowsPerSide는 한쪽 쿼리에서 읽을 최대 row 수입니다 (요청으로 줄일 수만 있음).
+// 양쪽 결과를 모두 메모리에 올려서 비교하므로, 큰 테이블 하나로 서버 메모리가 바닥나지 않게 막습니다.
+const DefaultDiffMaxRowsPerSide = 100000
+
+// DiffSide는 비교할 한쪽(DB + 쿼리)입니다.
+type DiffSide struct {
+	DatabaseID string
+	Query      string
+}
+
+// DiffOptions는 값 정규화 옵션입니다.
+// Oracle과 Postgres는 같은 데이터도 다르게 표현하는 경우가 많습니다.
+type DiffOptions struct {
+	// EmptyAsNull은 빈 문자열을 NULL로 취급합니다.
+	// Oracle은 ''을 NULL로 저장하므로 마이그레이션 비교 시 켜는 것이 좋습니다.
+	EmptyAsNull bool
+
+	// TrimTrailingSpaces는 문자열 끝 공백을 무시합니다 (CHAR 패딩 대응).
+	TrimTrailingSpaces bool
+
+	// IgnoreColumns는 비교에서 제외할 컬럼입니다 (예: updated_at).
+	IgnoreColumns []string
+}
+
+// ResultDiffRequest는 두 쿼리 결과를 비교하는 요청입니다.
+type ResultDiffRequest struct {
+	Source         DiffSide
+	Target         DiffSide
+	KeyColumns     []string // row를 식별하는 키 컬럼 (대소문자 무시)
+	Mode           DiffMode
+	MaxRows        int // 응답에 담을 최대 차이 row 수 (0이면 기본값)
+	MaxRowsPerSide int // 한쪽에서 읽을 최대 row 수 (0이면 기본값, 기본값을 넘을 수 없음)
+	Options        DiffOptions
+	Confirmed      bool // 비용 가드(confirm) 경고를 확인했는지
+}
+
+// Validate는 비교 요청의 유효성을 검증합니다.
+// Target.Query가 비어 있으면 Source.Query를 그대로 사용합니다.
+func (r *ResultDiffRequest) Validate() error {
+	if r.Source.DatabaseID == "" || r.Target.DatabaseID == "" {
+		return fmt.Errorf("%w: source and target database IDs are required", ErrInvalidDiff)
+	}
+	if strings.TrimSpace(r.Source.Query) == "" {
+		return fmt.Errorf("%w: source query is required", ErrInvalidDiff)
+	}
+	if strings.TrimSpace(r.Target.Query) == "" {
+		r.Target.Query = r.Source.Query
+	}
+	// 비교는 읽기 전용이어야 합니다. 양쪽 DB에 쓰기 문장을 실행하면 안 되기 때문!
+	if ClassifyStatement(r.Source.Query) != StatementSelect {
+		return fmt.Errorf("%w: source query must be a SELECT", ErrInvalidDiff)
+	}
+	if ClassifyStatement(r.Target.Query) != StatementSelect {
+		return fmt.Errorf("%w: target query must be a SELECT", ErrInvalidDiff)
+	}
+	if len(r.KeyColumns) == 0 {
+		return fmt.Errorf("%w: at least one key column is required", ErrInvalidDiff)
+	}
+
+	switch r.Mode {
+	case "":
+		r.Mode = DiffModeFull
+	case DiffModeFull, DiffModeHash:
+	default:
+		return fmt.Errorf("%w: unsupported mode %q", ErrInvalidDiff, r.Mode)
+	}
+
+	if r.MaxRows <= 0 {
+		r.MaxRows = DefaultDiffMaxRows
+	}
+	if r.MaxRowsPerSide <= 0 || r.MaxRowsPerSide > DefaultDiffMaxRowsPerSide {
+		r.MaxRowsPerSide = DefaultDiffMaxRowsPerSide
+	}
+
+	return nil
+}
+
+// ColumnDiff는 컬럼 하나의 차이입니다.
+type ColumnDiff struct {
+	Column string
+	Source interface{}
+	Target interface{}
+}
+
+// DiffRow는 추가/삭제된 row입니다.
+// Hash 모드에서는 Values가 비어 있고 Key만 채워집니다.
+type DiffRow struct {
+	Key    map[string]interface{}
+	Values map[string]interface{}
+}
+
+// ChangedRow는 키는 같지만 값이 다른 row입니다.
+// Hash 모드에서는 Differences가 비어 있습니다.
+type ChangedRow struct {
+	Key         map[string]interface{}
+	Differences []ColumnDiff
+}
+
+// DiffSummary는 비교 결과의 개수 요약입니다.
+type DiffSummary struct {
+	SourceRows int
+	TargetRows int
+	Added      int // Target에만 있는 row
+	Removed    int // Source에만 있는 row
+	Changed    int
+	Unchanged  int
+}
+
+// ResultDiff는 두 쿼리 결과의 비교 결과입니다.
+type ResultDiff struct {
+	Mode              DiffMode
+	KeyColumns        []string
+	Columns           []string // 실제로 비교한 컬럼 (Source 기준 이름)
+	SourceOnlyColumns []string
+	TargetOnlyColumns []string
+	Added             []DiffRow
+	Removed           []DiffRow
+	Changed           []ChangedRow
+	Summary           DiffSummary
+	Truncated         bool // MaxRows 때문에 일부 row가 생략되었는지
+}
+
+// IsIdentical은 두 결과가 같은지 확인합니다.
+func (d *ResultDiff) IsIdentical() bool {
+	return d.Summary.Added == 0 && d.Summary.Removed == 0 && d.Summary.Changed == 0 &&
+		len(d.SourceOnlyColumns) == 0 && len(d.TargetOnlyColumns) == 0
+}
+
+// diffColumn은 양쪽 결과에서 같은 컬럼으로 매칭된 쌍입니다.
+type diffColumn struct {
+	source     string
+	target     string
+	sourceType string
+	targetType string
+}
+
+// indexedRow는 키로 색인된 row입니다.
+type indexedRow struct {
+	key        map[string]interface{}
+	row        map[string]interface{}
+	normalized []string // 비교 컬럼 순서의 정규화 값 (Full 모드)
+	hash       string   // 비교 컬럼 전체의 해시 (Hash 모드)
+}
+
+// DiffResults는 두 쿼리 결과를 키 컬럼 기준으로 비교합니다.
+// 컬럼 이름은 대소문자를 무시하고 매칭합니다 (Oracle은 대문자, Postgres는 소문자).
+func DiffResults(source, target *QueryResult, req *ResultDiffRequest) (*ResultDiff, error) {
+	// ==========================================
+	// 1단계: 컬럼 매칭
+	// ==========================================
+
+	ignored := make(map[string]bool, len(req.Options.IgnoreColumns))
+	for _, col := range req.Options.IgnoreColumns {
+		ignored[strings.ToLower(col)] = true
+	}
+
+	targetByName := make(map[string]int, len(target.Columns))
+	for i, col := range target.Columns {
+		targetByName[strings.ToLower(col)] = i
+	}
+
+	diff := &ResultDiff{
+		Mode:       req.Mode,
+		KeyColumns: req.KeyColumns,
+	}
+
+	var columns []diffColumn
+	matched := make(map[string]bool, len(source.Columns))
+	for _, col := range source.Columns {
+		name := strings.ToLower(col)
+		if ignored[name] {
+			continue
+		}
+
+		i, ok := targetByName[name]
+		if !ok {
+			diff.SourceOnlyColumns = append(diff.SourceOnlyColumns, col)
+			continue
+		}
+
+		matched[name] = true
+		columns = append(columns, diffColumn{
+			source:     col,
+			target:     target.Columns[i],
+			sourceType: source.ColumnType(col),
+			targetType: target.ColumnType(target.Columns[i]),
+		})
+		diff.Columns = append(diff.Columns, col)
+	}
+
+	for _, col := range target.Columns {
+		name := strings.ToLower(col)
+		if !ignored[name] && !matched[name] {
+			diff.TargetOnlyColumns = append(diff.TargetOnlyColumns, col)
+		}
+	}
+
+	// 키 컬럼은 양쪽에 모두 있어야 합니다.
+	var keys []diffColumn
+	for _, key := range req.KeyColumns {
+		found := false
+		for _, col := range columns {
+			if strings.EqualFold(col.source, key) {
+				keys = append(keys, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: key column %q must exist in both results", ErrInvalidDiff, key)
+		}
+	}
+
+	// ==========================================
+	// 2단계: 양쪽 결과를 키로 색인
+	// ==========================================
+
+	sourceRows, sourceOrder, err := indexRows(source, keys, columns, true, req)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+
+	targetRows, targetOrder, err := indexRows(target, keys, columns, false, req)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	diff.Summary.SourceRows = source.RowCount()
+	diff.Summary.TargetRows = target.RowCount()
+
+	// ==========================================
+	// 3단계: 비교
+	// ==========================================
+
+	// 결과 순서를 안정적으로 유지하려고 원본 row 순서대로 순회합니다.
+	for _, key := range sourceOrder {
+		src := sourceRows[key]
+		tgt, ok := targetRows[key]
+		if !ok {
+			diff.Summary.Removed++
+			if len(diff.Removed) < req.MaxRows {
+				diff.Removed = append(diff.Removed, newDiffRow(src, req.Mode))
+			} else {
+				diff.Truncated = true
+			}
+			continue
+		}
+
+		if req.Mode == DiffModeHash {
+			if src.hash == tgt.hash {
+				diff.Summary.Unchanged++
+				continue
+			}
+			diff.Summary.Changed++
+			if len(diff.Changed) < req.MaxRows {
+				diff.Changed = append(diff.Changed, ChangedRow{Key: src.key})
+			} else {
+				diff.Truncated = true
+			}
+			continue
+		}
+
+		var differences []ColumnDiff
+		for i, col := range columns {
+			if src.normalized[i] != tgt.normalized[i] {
+				differences = append(differences, ColumnDiff{
+					Column: col.source,
+					Source: displayValue(src.row[col.source]),
+					Target: displayValue(tgt.row[col.target]),
+				})
+			}
+		}
+
+		if len(differences) == 0 {
+			diff.Summary.Unchanged++
+			continue
+		}
+
+		diff.Summary.Changed++
+		if len(diff.Changed) < req.MaxRows {
+			diff.Changed = append(diff.Changed, ChangedRow{Key: src.key, Differences: differences})
+		} else {
+			diff.Truncated = true
+		}
+	}
+
+	for _, key := range targetOrder {
+		if _, ok := sourceRows[key]; ok {
+			continue
+		}
+		diff.Summary.Added++
+		if len(diff.Added) < req.MaxRows {
+			diff.Added = append(diff.Added, newDiffRow(targetRows[key], req.Mode))
+		} else {
+			diff.Truncated = true
+		}
+	}
+
+	return diff, nil
+}
+
+// indexRows는 결과를 정규화된 키 문자열로 색인합니다.
+// 원본 row 순서대로 키 목록도 함께 반환합니다.
+// 키가 중복되면 비교가 불가능하므로 에러를 반환합니다.
+func indexRows(result *QueryResult, keys, columns []diffColumn, isSource bool, req *ResultDiffRequest) (map[string]*indexedRow, []string, error) {
+	index := make(map[string]*indexedRow, result.RowCount())
+	order := make([]string, 0, result.RowCount())
+
+	for _, row := range result.Rows {
+		key, keyValues := rowKey(row, keys, isSource, req.Options)
+		if _, dup := index[key]; dup {
+			return nil, nil, fmt.Errorf("%w: duplicate key %v", ErrInvalidDiff, keyValues)
+		}
+
+		normalized := make([]string, len(columns))
+		for i, col := range columns {
+			name, typeName := col.target, col.targetType
+			if isSource {
+				name, typeName = col.source, col.sourceType
+			}
+			normalized[i] = NormalizeValue(row[name], typeName, req.Options)
+		}
+
+		entry := &indexedRow{key: keyValues}
+		if req.Mode == DiffModeHash {
+			// Hash 모드는 row 원본과 정규화 값을 버려서 메모리를 아낍니다.
+			entry.hash = hashValues(normalized)
+		} else {
+			entry.row = row
+			entry.normalized = normalized
+		}
+		index[key] = entry
+		order = append(order, key)
+	}
+
+	return index, order, nil
+}
+
+// rowKey는 row의 키 값을 정규화해서 하나의 문자열로 만듭니다.
+func rowKey(row map[string]interface{}, keys []diffColumn, isSource bool, opts DiffOptions) (string, map[string]interface{}) {
+	parts := make([]string, len(keys))
+	values := make(map[string]interface{}, len(keys))
+
+	for i, key := range keys {
+		name, typeName := key.target, key.targetType
+		if isSource {
+			name, typeName = key.source, key.sourceType
+		}
+		parts[i] = NormalizeValue(row[name], typeName, opts)
+		values[key.source] = displayValue(row[name])
+	}
+
+	// \x1f(Unit Separator)는 실제 데이터에 거의 나오지 않는 구분자입니다.
+	return strings.Join(parts, "\x1f"), values
+}
+
+// newDiffRow는 추가/삭제 row를 만듭니다.
+func newDiffRow(entry *indexedRow, mode DiffMode) DiffRow {
+	if mode == DiffModeHash {
+		return DiffRow{Key: entry.key}
+	}
+	return DiffRow{Key: entry.key, Values: entry.row}
+}
+
+// displayValue는 응답에 보여줄 값을 만듭니다.
+// lib/pq가 돌려주는 []byte는 JSON에서 base64가 되므로 문자열로 바꿉니다.
+func displayValue(v interface{}) interface{} {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return v
+}
+
+// hashValues는 정규화 값 목록의 SHA-256 해시를 계산합니다.
+func hashValues(values []string) string {
+	h := sha256.New()
+	for _, v := range values {
+		h.Write([]byte(v))
+		h.Write([]byte{0x1f})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// nullValue는 NULL을 나타내는 정규화 값입니다.
+// 실제 문자열 "NULL"과 구분하려고 제어 문자를 사용합니다.
+const nullValue = "\x00NULL"
+
+// NormalizeValue는 DB마다 다른 값 표현을 비교 가능한 문자열로 바꿉니다.
+//
+// 정규화 규칙:
+//   - NULL → 고유한 NULL 표식
+//   - 숫자 (int, float, 숫자 타입 컬럼의 문자열/[]byte) → 정규화된 10진수
+//     (Postgres NUMERIC "12.50"과 Oracle NUMBER 12.5를 같게 취급)
+//   - 시간 → UTC RFC3339
+//   - []byte → 문자열 (lib/pq는 문자열도 []byte로 돌려줌)
+func NormalizeValue(v interface{}, typeName string, opts DiffOptions) string {
+	switch val := v.(type) {
+	case nil:
+		return nullValue
+
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+
+	case bool:
+		// Oracle에는 BOOLEAN이 없어서 보통 NUMBER(1)로 저장합니다.
+		if val {
+			return "1"
+		}
+		return "0"
+
+	case int64:
+		return strconv.FormatInt(val, 10)
+
+	case int:
+		return strconv.Itoa(val)
+
+	case float64:
+		return normalizeNumber(strconv.FormatFloat(val, 'f', -1, 64))
+
+	case float32:
+		return normalizeNumber(strconv.FormatFloat(float64(val), 'f', -1, 32))
+
+	case []byte:
+		return normalizeString(string(val), typeName, opts)
+
+	case string:
+		return normalizeString(val, typeName, opts)
+
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// normalizeString은 문자열 값을 정규화합니다.
+func normalizeString(s, typeName string, opts DiffOptions) string {
+	if opts.TrimTrailingSpaces {
+		s = strings.TrimRight(s, " ")
+	}
+	if opts.EmptyAsNull && s == "" {
+		return nullValue
+	}
+	if IsNumericType(typeName) {
+		return normalizeNumber(s)
+	}
+	return s
+}
+
+// normalizeNumber는 숫자 문자열을 정규화합니다 (예: "12.50" → "25/2").
+// 분수 형태를 쓰면 소수점 자릿수나 지수 표기와 상관없이 같은 값은 같은 문자열이 됩니다.
+// 숫자가 아니면 원본을 그대로 반환합니다.
+func normalizeNumber(s string) string {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return s
+	}
+	return r.RatString()
+}
+
+// IsNumericType은 DB 타입 이름이 숫자 타입인지 확인합니다.
+// Postgres (INT4, NUMERIC, FLOAT8 ...)와 Oracle (NUMBER, BINARY_DOUBLE ...)을 모두 처리합니다.
+func IsNumericType(typeName string) bool {
+	upper := strings.ToUpper(typeName)
+	for _, prefix := range []string{"INT", "NUMBER", "NUMERIC", "DECIMAL", "FLOAT", "DOUBLE", "REAL", "BINARY_", "SMALLINT", "BIGINT", "MONEY"} {
+		if strings.HasPrefix(upper, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,294 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// row 수정 관련 에러
+var (
+	ErrInvalidRowChange   = errors.New("invalid row change")
+	ErrNoPrimaryKey       = errors.New("table has no primary key")
+	ErrRowNotFound        = errors.New("row not found")
+	ErrUnexpectedRowCount = errors.New("unexpected number of affected rows")
+)
+
+// RowOperation은 row 하나에 대한 변경 종류입니다.
+type RowOperation string
+
+const (
+	RowInsert RowOperation = "insert"
+	RowUpdate RowOperation = "update"
+	RowDelete RowOperation = "delete"
+)
+
+// 변경 이력 종류 (ChangeKind)
+const (
+	ChangeRowInsert ChangeKind = "row_insert"
+	ChangeRowUpdate ChangeKind = "row_update"
+	ChangeRowDelete ChangeKind = "row_delete"
+	ChangeRowImport ChangeKind = "row_import" // 파일 가져오기 (Before 없음, After는 건수 요약)
+)
+
+// RowChange는 기본 키로 row 하나를 추가/수정/삭제하는 요청입니다.
+// 컬럼 이름은 GetColumns와 같은 규칙으로 찾습니다 (정확한 이름 → DB 기본 대소문자).
+type RowChange struct {
+	Schema    string // 비어 있으면 DB 기본 스키마
+	Table     string
+	Operation RowOperation
+
+	// Key는 바꿀 row의 기본 키 값입니다 (update, delete).
+	// 기본 키 컬럼을 모두, 기본 키 컬럼만 넣어야 합니다.
+	Key map[string]interface{}
+
+	// Values는 넣을 값(insert) 또는 바꿀 값(update)입니다. nil은 NULL입니다.
+	Values map[string]interface{}
+
+	// Author는 변경한 사람입니다 (쓰기 권한 검사와 변경 이력에 씀).
+	Author string
+}
+
+// Validate는 변경 요청에 필요한 값이 있는지 검증합니다.
+func (c *RowChange) Validate() error {
+	if strings.TrimSpace(c.Table) == "" {
+		return fmt.Errorf("%w: table is required", ErrInvalidRowChange)
+	}
+
+	switch c.Operation {
+	case RowInsert:
+		if len(c.Values) == 0 {
+			return fmt.Errorf("%w: insert needs at least one value", ErrInvalidRowChange)
+		}
+		if len(c.Key) > 0 {
+			return fmt.Errorf("%w: insert takes values only (put key columns in values)", ErrInvalidRowChange)
+		}
+	case RowUpdate:
+		if len(c.Key) == 0 {
+			return fmt.Errorf("%w: update needs the primary key of the row", ErrInvalidRowChange)
+		}
+		if len(c.Values) == 0 {
+			return fmt.Errorf("%w: update needs at least one value to change", ErrInvalidRowChange)
+		}
+	case RowDelete:
+		if len(c.Key) == 0 {
+			return fmt.Errorf("%w: delete needs the primary key of the row", ErrInvalidRowChange)
+		}
+		if len(c.Values) > 0 {
+			return fmt.Errorf("%w: delete takes the key only", ErrInvalidRowChange)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported operation %q", ErrInvalidRowChange, c.Operation)
+	}
+
+	return nil
+}
+
+// ChangeKind는 변경 이력에 남길 종류를 반환합니다.
+func (op RowOperation) ChangeKind() ChangeKind {
+	switch op {
+	case RowInsert:
+		return ChangeRowInsert
+	case RowUpdate:
+		return ChangeRowUpdate
+	default:
+		return ChangeRowDelete
+	}
+}
+
+// RowChangeResult는 row 변경 결과입니다. 이름은 DisplayIdentifier로 정규화된 값입니다.
+type RowChangeResult struct {
+	Schema    string
+	Table     string
+	Operation RowOperation
+
+	// Key는 변경 후 row의 기본 키입니다 (delete는 지운 row의 키).
+	Key map[string]interface{}
+
+	Before map[string]interface{} // 바꾸기 전 row (insert는 nil)
+	After  map[string]interface{} // 바꾼 뒤 row (delete는 nil)
+
+	Statement   string   // 실행한 INSERT/UPDATE/DELETE (값은 바인드 변수)
+	ChangeLogID string   // 변경 이력 ID
+	Warnings    []string // 예: 기본 키를 DB가 만들어서 바꾼 뒤 row를 읽지 못함
+}
+
+// ColumnValue는 카탈로그 컬럼과 그 값입니다.
+type ColumnValue struct {
+	Column ColumnInfo // Name은 카탈로그에 저장된 그대로
+	Value  interface{}
+}
+
+// TxStatement는 트랜잭션 안에서 실행할 문장 하나입니다.
+type TxStatement struct {
+	SQL  string
+	Args []interface{}
+
+	// Query면 결과 row를 읽습니다 (SELECT, INSERT ... RETURNING).
+	Query bool
+
+	// ExpectRows는 영향받은(Query면 읽은) row 수입니다.
+	// 다르면 트랜잭션 전체를 롤백합니다. 음수면 검사하지 않습니다.
+	ExpectRows int64
+}
+
+// TxResult는 TxStatement 하나의 실행 결과입니다.
+type TxResult struct {
+	Rows         []map[string]interface{} // Query일 때만 (키는 드라이버가 돌려준 컬럼 이름)
+	RowsAffected int64                    // Query면 읽은 row 수
+}
+
+// RowCountError는 문장이 예상과 다른 수의 row에 영향을 줘서 트랜잭션을 롤백했다는 에러입니다.
+// errors.Is(err, domain.ErrUnexpectedRowCount)로 확인하고,
+// errors.As()로 꺼내면 몇 번째 문장에서 몇 개였는지 알 수 있습니다.
+type RowCountError struct {
+	Statement int // 0부터 시작하는 문장 순서
+	Expected  int64
+	Actual    int64
+}
+
+// Error는 error 인터페이스를 구현합니다.
+func (e *RowCountError) Error() string {
+	return fmt.Sprintf("%v: statement %d affected %d rows, expected %d (rolled back)",
+		ErrUnexpectedRowCount, e.Statement+1, e.Actual, e.Expected)
+}
+
+// Unwrap은 ErrUnexpectedRowCount를 반환합니다.
+func (e *RowCountError) Unwrap() error {
+	return ErrUnexpectedRowCount
+}
+
+// PrimaryKeyColumns는 기본 키 컬럼을 기본 키 순서대로 반환합니다.
+func PrimaryKeyColumns(columns []ColumnInfo) []ColumnInfo {
+	var keys []ColumnInfo
+	for _, c := range columns {
+		if c.PrimaryKey {
+			keys = append(keys, c)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].PrimaryKeyPosition < keys[j].PrimaryKeyPosition
+	})
+	return keys
+}
+
+// RowImageQuery는 기본 키로 row 하나를 읽는 SELECT를 만듭니다.
+// forUpdate면 FOR UPDATE로 잠가서 읽은 뒤 바꾸기 전까지 다른 세션이 바꾸지 못하게 합니다.
+func RowImageQuery(dbType DatabaseType, schema, table string, columns []ColumnInfo, key []ColumnValue, forUpdate bool) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	where, err := keyWhere(dbType, b, key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "SELECT " + columnList(dbType, columns) + " FROM " + rowTable(dbType, schema, table) + where
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+	return query, b.args, nil
+}
+
+// RowInsertStatement는 INSERT 문을 만듭니다.
+// returning이 있으면 Postgres의 RETURNING으로 넣은 row(기본값, 자동 증가 값 포함)를 돌려받습니다.
+//
+//	INSERT INTO hr.students (student_no, name, status) VALUES ($1, $2, $3) RETURNING student_no, name, status
+func RowInsertStatement(dbType DatabaseType, schema, table string, values []ColumnValue, returning []ColumnInfo) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	names := make([]string, len(values))
+	binds := make([]string, len(values))
+	for i, v := range values {
+		value, err := CoerceValue(dbType, v.Column, v.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		names[i] = QuoteCatalogIdentifier(dbType, v.Column.Name)
+		binds[i] = b.bind(value)
+	}
+
+	statement := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		rowTable(dbType, schema, table), strings.Join(names, ", "), strings.Join(binds, ", "))
+	if len(returning) > 0 && b.dialect == DialectPostgres {
+		statement += " RETURNING " + columnList(dbType, returning)
+	}
+	return statement, b.args, nil
+}
+
+// RowUpdateStatement는 기본 키로 row 하나를 바꾸는 UPDATE 문을 만듭니다.
+//
+//	UPDATE hr.students SET status = :1 WHERE student_no = :2
+func RowUpdateStatement(dbType DatabaseType, schema, table string, values []ColumnValue, key []ColumnValue) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	sets := make([]string, len(values))
+	for i, v := range values {
+		value, err := CoerceValue(dbType, v.Column, v.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		sets[i] = QuoteCatalogIdentifier(dbType, v.Column.Name) + " = " + b.bind(value)
+	}
+
+	where, err := keyWhere(dbType, b, key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "UPDATE " + rowTable(dbType, schema, table) + " SET " + strings.Join(sets, ", ") + where, b.args, nil
+}
+
+// RowDeleteStatement는 기본 키로 row 하나를 지우는 DELETE 문을 만듭니다.
+func RowDeleteStatement(dbType DatabaseType, schema, table string, key []ColumnValue) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	where, err := keyWhere(dbType, b, key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return "DELETE FROM " + rowTable(dbType, schema, table) + where, b.args, nil
+}
+
+// keyWhere는 기본 키 조건 " WHERE a = $1 AND b = $2"를 만듭니다.
+// 기본 키 값은 NULL일 수 없으므로 nil이면 에러입니다 (= NULL은 어떤 row와도 같지 않음).
+func keyWhere(dbType DatabaseType, b *binder, key []ColumnValue) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("%w: empty key", ErrInvalidRowChange)
+	}
+
+	parts := make([]string, len(key))
+	for i, k := range key {
+		if k.Value == nil {
+			return "", fmt.Errorf("%w: key column %s must not be null", ErrInvalidRowChange, DisplayIdentifier(dbType, k.Column.Name))
+		}
+		value, err := CoerceValue(dbType, k.Column, k.Value)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = QuoteCatalogIdentifier(dbType, k.Column.Name) + " = " + b.bind(value)
+	}
+	return " WHERE " + strings.Join(parts, " AND "), nil
+}
+
+// columnList는 SELECT 목록("a, b, c")을 만듭니다.
+func columnList(dbType DatabaseType, columns []ColumnInfo) string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = QuoteCatalogIdentifier(dbType, c.Name)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,580 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 데이터 가져오기 관련 에러
+var (
+	ErrInvalidImport       = errors.New("invalid import")
+	ErrTooManyImportErrors = errors.New("too many import errors")
+)
+
+// ImportFormat은 가져올 파일 형식입니다.
+type ImportFormat string
+
+const (
+	ImportCSV       ImportFormat = "csv"   // 첫 줄이 헤더(컬럼 이름)인 CSV
+	ImportJSONLines ImportFormat = "jsonl" // 한 줄에 JSON 객체 하나 (JSON Lines, NDJSON)
+)
+
+const (
+	DefaultImportBatchSize = 1000
+	MaxImportBatchSize     = 10000
+
+	// MaxImportErrorReport는 결과에 담는 오류 row 수의 상한입니다.
+	// 오류 row 수(Rejected)는 상한과 관계없이 모두 셉니다.
+	MaxImportErrorReport = 1000
+
+	// ImportStageTable은 Postgres upsert에서 COPY로 먼저 넣는 임시 테이블입니다.
+	ImportStageTable = "dms_import_stage"
+
+	importSavepoint = "dms_import"
+)
+
+// ParseImportFormat은 형식 이름을 ImportFormat으로 바꿉니다 (ndjson은 jsonl과 같음).
+func ParseImportFormat(s string) (ImportFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "csv":
+		return ImportCSV, nil
+	case "jsonl", "ndjson":
+		return ImportJSONLines, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported format %q (use csv or jsonl)", ErrInvalidImport, s)
+	}
+}
+
+// ImportRequest는 CSV/JSON Lines 데이터를 기존 테이블에 넣는 요청입니다.
+//
+// 컬럼은 헤더(CSV 첫 줄, JSON Lines는 첫 객체의 키)의 이름으로 찾고,
+// 값은 컬럼 타입에 맞게 바꿔서 (CoerceValue) 묶음 단위로 넣습니다.
+// 전체를 한 트랜잭션으로 실행하므로 중간에 멈추면 아무것도 바뀌지 않습니다.
+type ImportRequest struct {
+	Schema string // 비어 있으면 DB 기본 스키마
+	Table  string
+	Format ImportFormat
+	Data   io.Reader
+
+	Delimiter rune   // CSV 구분자 (0이면 쉼표)
+	Null      string // CSV에서 NULL로 볼 값 (기본값: 빈 문자열)
+
+	// Truncate면 넣기 전에 테이블을 비웁니다.
+	// Postgres는 TRUNCATE, Oracle은 DELETE를 씁니다 (Oracle의 TRUNCATE는 바로 커밋되어 롤백할 수 없음).
+	Truncate bool
+
+	// Upsert면 키가 같은 row는 바꾸고 없으면 넣습니다.
+	// Key가 비어 있으면 기본 키를 씁니다. 키 컬럼은 헤더에 있어야 합니다.
+	Upsert bool
+	Key    []string
+
+	// MaxErrors는 건너뛸 수 있는 오류 row 수입니다.
+	// 0이면 첫 오류에서, 넘으면 그때 전체를 롤백합니다. 음수면 제한이 없습니다.
+	MaxErrors int
+
+	// DryRun이면 끝까지 실행한 뒤 롤백합니다 (DB의 제약조건 검사까지 해보기).
+	DryRun bool
+
+	BatchSize int // 한 번에 넣을 row 수 (기본값 1000, 최대 10000)
+
+	// Author는 가져오기를 실행한 사람입니다 (쓰기 권한 검사와 변경 이력에 씀).
+	Author string
+}
+
+// Validate는 요청을 검증하고 기본값을 채웁니다.
+func (r *ImportRequest) Validate() error {
+	if strings.TrimSpace(r.Table) == "" {
+		return fmt.Errorf("%w: table is required", ErrInvalidImport)
+	}
+	if r.Data == nil {
+		return fmt.Errorf("%w: no data", ErrInvalidImport)
+	}
+
+	if r.Format == "" {
+		r.Format = ImportCSV
+	}
+	if _, err := ParseImportFormat(string(r.Format)); err != nil {
+		return err
+	}
+
+	if r.Delimiter == 0 {
+		r.Delimiter = ','
+	}
+	if r.Delimiter == '"' || r.Delimiter == '\r' || r.Delimiter == '\n' {
+		return fmt.Errorf("%w: invalid delimiter %q", ErrInvalidImport, r.Delimiter)
+	}
+
+	if len(r.Key) > 0 && !r.Upsert {
+		return fmt.Errorf("%w: key is only used with upsert", ErrInvalidImport)
+	}
+
+	if r.BatchSize <= 0 {
+		r.BatchSize = DefaultImportBatchSize
+	}
+	if r.BatchSize > MaxImportBatchSize {
+		return fmt.Errorf("%w: batch size must be at most %d", ErrInvalidImport, MaxImportBatchSize)
+	}
+
+	return nil
+}
+
+// ImportRowError는 넣지 못한 row 하나입니다.
+// 파일을 읽는 중 생긴 row 단위 에러로도 씁니다 (ImportSource.Next).
+type ImportRowError struct {
+	Line    int    // 파일의 줄 번호 (1부터, 헤더 포함)
+	Column  string // 값 변환에 실패한 컬럼 (DB 에러면 빈 문자열)
+	Message string
+}
+
+// Error는 error 인터페이스를 구현합니다.
+func (e *ImportRowError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("line %d, column %s: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// ImportReport는 가져오기 진행 중의 row 수와 오류 목록입니다.
+// 파일을 읽는 쪽(값 변환 오류)과 DB에 넣는 쪽(제약조건 위반 등)이 함께 씁니다.
+type ImportReport struct {
+	MaxErrors int // ImportRequest.MaxErrors
+
+	Read     int64 // 읽은 데이터 row 수 (헤더 제외)
+	Loaded   int64 // 넣은 row 수 (upsert면 넣거나 바꾼 row 수)
+	Rejected int64 // 건너뛴 row 수
+
+	Errors []ImportRowError // 최대 MaxImportErrorReport개
+}
+
+// Reject는 오류 row를 기록합니다.
+// 허용한 오류 수(MaxErrors)를 넘으면 ErrTooManyImportErrors를 반환하며, 이때 가져오기를 중단합니다.
+func (r *ImportReport) Reject(rowErr ImportRowError) error {
+	r.Rejected++
+	if len(r.Errors) < MaxImportErrorReport {
+		r.Errors = append(r.Errors, rowErr)
+	}
+
+	if r.MaxErrors >= 0 && r.Rejected > int64(r.MaxErrors) {
+		return fmt.Errorf("%w: %d rows failed (max_errors %d), last: %s",
+			ErrTooManyImportErrors, r.Rejected, r.MaxErrors, rowErr.Error())
+	}
+	return nil
+}
+
+// ImportRow는 컬럼 타입에 맞게 바꾼 row 하나입니다 (Values는 ImportPlan.Columns 순서).
+type ImportRow struct {
+	Line   int
+	Values []interface{}
+}
+
+// ImportBatchFunc는 다음 row 묶음을 반환합니다. 더 없으면 빈 묶음을 반환합니다.
+// 파일을 다 읽어서 메모리에 올리지 않고 묶음 단위로 읽어서 넣기 위해 함수로 넘깁니다.
+type ImportBatchFunc func() ([]ImportRow, error)
+
+// ImportPlan은 저장소가 실행할 가져오기 계획입니다.
+// SQL은 NewImportPlan에서 모두 만들고, 저장소는 순서대로 실행만 합니다.
+type ImportPlan struct {
+	Schema  string       // 카탈로그 이름 (비어 있으면 현재 스키마)
+	Table   string       // 카탈로그 이름
+	Columns []ColumnInfo // ImportRow.Values 순서
+	Key     []ColumnInfo // upsert 키 (비어 있으면 INSERT만)
+
+	Truncate string // 먼저 실행할 TRUNCATE/DELETE (비어 있으면 실행하지 않음)
+
+	// Stage는 Postgres upsert에서 COPY로 넣을 임시 테이블을 만드는 문장입니다.
+	// (COPY에는 ON CONFLICT가 없으므로 임시 테이블에 넣은 뒤 INSERT ... ON CONFLICT로 옮김)
+	Stage string
+
+	// Load는 row 묶음을 넣는 문장입니다.
+	//   - Oracle: INSERT/MERGE (컬럼마다 값 배열을 바인드)
+	//   - Postgres upsert: 임시 테이블 → 테이블 INSERT ... ON CONFLICT
+	//   - Postgres INSERT: 비어 있음 (COPY FROM STDIN으로 바로 넣음)
+	Load string
+
+	Statement string // 결과와 변경 이력에 남길 SQL
+
+	// 묶음 하나가 실패했을 때 그 묶음만 되돌리기 위한 SAVEPOINT 문장 (Release는 Oracle에 없음)
+	Savepoint         string
+	RollbackSavepoint string
+	ReleaseSavepoint  string
+
+	DryRun bool
+}
+
+// NewImportPlan은 방언에 맞는 가져오기 SQL을 만듭니다.
+//
+// Postgres:
+//
+//	COPY public.users (email, name) FROM STDIN
+//	INSERT INTO public.users (email, name) SELECT email, name FROM dms_import_stage
+//	  ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name
+//
+// Oracle (:1, :2에 값 배열을 바인드):
+//
+//	INSERT INTO hr.students (student_no, name) VALUES (:1, :2)
+//	MERGE INTO hr.students t USING (SELECT :1 AS student_no, :2 AS name FROM dual) s
+//	  ON (t.student_no = s.student_no)
+//	  WHEN MATCHED THEN UPDATE SET t.name = s.name
+//	  WHEN NOT MATCHED THEN INSERT (student_no, name) VALUES (s.student_no, s.name)
+func NewImportPlan(dbType DatabaseType, schema, table string, columns, key []ColumnInfo, truncate bool) (*ImportPlan, error) {
+	dialect := DialectOf(dbType)
+	if dialect == "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("%w: no columns to import", ErrInvalidImport)
+	}
+
+	plan := &ImportPlan{
+		Schema:            schema,
+		Table:             table,
+		Columns:           columns,
+		Key:               key,
+		Savepoint:         "SAVEPOINT " + importSavepoint,
+		RollbackSavepoint: "ROLLBACK TO SAVEPOINT " + importSavepoint,
+	}
+
+	target := rowTable(dbType, schema, table)
+	names := columnList(dbType, columns)
+
+	isKey := make(map[string]bool, len(key))
+	for _, k := range key {
+		isKey[k.Name] = true
+	}
+	var updates []ColumnInfo
+	for _, c := range columns {
+		if !isKey[c.Name] {
+			updates = append(updates, c)
+		}
+	}
+
+	switch dialect {
+	case DialectPostgres:
+		plan.ReleaseSavepoint = "RELEASE SAVEPOINT " + importSavepoint
+		if truncate {
+			plan.Truncate = "TRUNCATE TABLE " + target
+		}
+
+		copyStatement := fmt.Sprintf("COPY %s (%s) FROM STDIN", target, names)
+		if len(key) == 0 {
+			plan.Statement = copyStatement
+			break
+		}
+
+		plan.Stage = fmt.Sprintf("CREATE TEMP TABLE IF NOT EXISTS %s ON COMMIT DROP AS SELECT %s FROM %s WITH NO DATA",
+			ImportStageTable, names, target)
+
+		conflict := "DO NOTHING"
+		if len(updates) > 0 {
+			sets := make([]string, len(updates))
+			for i, c := range updates {
+				name := QuoteCatalogIdentifier(dbType, c.Name)
+				sets[i] = name + " = EXCLUDED." + name
+			}
+			conflict = "DO UPDATE SET " + strings.Join(sets, ", ")
+		}
+		plan.Load = fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s ON CONFLICT (%s) %s",
+			target, names, names, ImportStageTable, columnList(dbType, key), conflict)
+		plan.Statement = fmt.Sprintf("COPY %s (%s) FROM STDIN; %s", ImportStageTable, names, plan.Load)
+
+	case DialectOracle:
+		if truncate {
+			plan.Truncate = "DELETE FROM " + target
+		}
+
+		if len(key) == 0 {
+			binds := make([]string, len(columns))
+			for i := range columns {
+				binds[i] = fmt.Sprintf(":%d", i+1)
+			}
+			plan.Load = fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", target, names, strings.Join(binds, ", "))
+			plan.Statement = plan.Load
+			break
+		}
+
+		selects := make([]string, len(columns))
+		values := make([]string, len(columns))
+		for i, c := range columns {
+			name := QuoteCatalogIdentifier(dbType, c.Name)
+			selects[i] = fmt.Sprintf(":%d AS %s", i+1, name)
+			values[i] = "s." + name
+		}
+		on := make([]string, len(key))
+		for i, k := range key {
+			name := QuoteCatalogIdentifier(dbType, k.Name)
+			on[i] = "t." + name + " = s." + name
+		}
+
+		merge := fmt.Sprintf("MERGE INTO %s t USING (SELECT %s FROM dual) s ON (%s)",
+			target, strings.Join(selects, ", "), strings.Join(on, " AND "))
+		if len(updates) > 0 {
+			sets := make([]string, len(updates))
+			for i, c := range updates {
+				name := QuoteCatalogIdentifier(dbType, c.Name)
+				sets[i] = "t." + name + " = s." + name
+			}
+			merge += " WHEN MATCHED THEN UPDATE SET " + strings.Join(sets, ", ")
+		}
+		merge += fmt.Sprintf(" WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)", names, strings.Join(values, ", "))
+
+		plan.Load = merge
+		plan.Statement = merge
+	}
+
+	return plan, nil
+}
+
+// ImportResult는 가져오기 결과입니다. 이름은 DisplayIdentifier로 정규화된 값입니다.
+type ImportResult struct {
+	Schema  string
+	Table   string
+	Format  ImportFormat
+	Columns []string // 헤더 순서
+	Key     []string // upsert 키 (upsert가 아니면 비어 있음)
+
+	Truncated bool
+	DryRun    bool
+	Committed bool // DryRun이거나 중단되면 false
+
+	// Aborted는 오류가 MaxErrors를 넘어서 롤백한 이유입니다 (끝까지 실행했으면 빈 문자열).
+	Aborted string
+
+	Read     int64
+	Loaded   int64
+	Rejected int64
+	Errors   []ImportRowError // 최대 MaxImportErrorReport개
+
+	Statement     string
+	ChangeLogID   string
+	ExecutionTime time.Duration
+}
+
+// ImportSource는 파일에서 row를 하나씩 읽습니다.
+type ImportSource interface {
+	// Header는 컬럼 이름 목록입니다 (Next의 값 순서).
+	Header() []string
+
+	// Next는 다음 row를 반환합니다. 더 없으면 io.EOF를 반환합니다.
+	// row 하나만 잘못되었으면 *ImportRowError를 반환하며, 그 뒤로 계속 읽을 수 있습니다.
+	Next() (ImportRecord, error)
+}
+
+// ImportRecord는 파일의 row 하나입니다 (값은 아직 컬럼 타입으로 바꾸기 전).
+type ImportRecord struct {
+	Line   int
+	Values []interface{} // Header 순서, nil은 NULL
+}
+
+// NewImportSource는 형식에 맞는 ImportSource를 만들고 헤더를 읽습니다.
+//
+//   - CSV: 첫 줄이 헤더입니다. null과 같은 값은 NULL입니다 (기본값: 빈 값).
+//   - JSON Lines: 첫 객체의 키가 헤더입니다. 뒤의 객체에 없는 키는 NULL이고,
+//     첫 객체에 없던 키가 나오면 그 row는 오류입니다. 숫자는 정밀도를 잃지 않도록 문자열로 읽고,
+//     객체/배열 값은 JSON 문자열로 넣습니다 (json/jsonb 컬럼용).
+func NewImportSource(format ImportFormat, r io.Reader, delimiter rune, null string) (ImportSource, error) {
+	switch format {
+	case ImportCSV:
+		return newCSVSource(r, delimiter, null)
+	case ImportJSONLines:
+		return newJSONLinesSource(r)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidImport, format)
+	}
+}
+
+type csvSource struct {
+	reader *csv.Reader
+	header []string
+	null   string
+}
+
+func newCSVSource(r io.Reader, delimiter rune, null string) (*csvSource, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = delimiter
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read header: %v", ErrInvalidImport, err)
+	}
+
+	// Excel이 저장한 UTF-8 CSV는 BOM으로 시작합니다.
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+	}
+	reader.FieldsPerRecord = len(header)
+
+	return &csvSource{reader: reader, header: header, null: null}, nil
+}
+
+func (s *csvSource) Header() []string {
+	return s.header
+}
+
+func (s *csvSource) Next() (ImportRecord, error) {
+	record, err := s.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return ImportRecord{}, &ImportRowError{Line: parseErr.StartLine, Message: parseErr.Err.Error()}
+		}
+		return ImportRecord{}, err
+	}
+
+	line, _ := s.reader.FieldPos(0)
+	values := make([]interface{}, len(record))
+	for i, v := range record {
+		if v != s.null {
+			values[i] = v
+		}
+	}
+	return ImportRecord{Line: line, Values: values}, nil
+}
+
+type jsonLinesSource struct {
+	reader  *bufio.Reader
+	line    int
+	header  []string
+	index   map[string]int
+	pending *ImportRecord // 헤더를 정하려고 먼저 읽은 첫 row
+}
+
+func newJSONLinesSource(r io.Reader) (*jsonLinesSource, error) {
+	s := &jsonLinesSource{reader: bufio.NewReader(r)}
+
+	data, err := s.readLine()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidImport)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := decodeJSONObject(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidImport, s.line, err)
+	}
+
+	for name := range object {
+		s.header = append(s.header, name)
+	}
+	sort.Strings(s.header)
+	s.index = make(map[string]int, len(s.header))
+	for i, name := range s.header {
+		s.index[name] = i
+	}
+
+	record, rowErr := s.record(object)
+	if rowErr != nil {
+		return nil, rowErr
+	}
+	s.pending = &record
+
+	return s, nil
+}
+
+func (s *jsonLinesSource) Header() []string {
+	return s.header
+}
+
+func (s *jsonLinesSource) Next() (ImportRecord, error) {
+	if s.pending != nil {
+		record := *s.pending
+		s.pending = nil
+		return record, nil
+	}
+
+	data, err := s.readLine()
+	if err != nil {
+		return ImportRecord{}, err
+	}
+
+	object, err := decodeJSONObject(data)
+	if err != nil {
+		return ImportRecord{}, &ImportRowError{Line: s.line, Message: err.Error()}
+	}
+
+	record, rowErr := s.record(object)
+	if rowErr != nil {
+		return ImportRecord{}, rowErr
+	}
+	return record, nil
+}
+
+// readLine은 빈 줄을 건너뛰고 다음 줄을 읽습니다. 더 없으면 io.EOF입니다.
+func (s *jsonLinesSource) readLine() ([]byte, error) {
+	for {
+		data, err := s.reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if len(data) == 0 && err == io.EOF {
+			return nil, io.EOF
+		}
+
+		s.line++
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 {
+			return trimmed, nil
+		}
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+	}
+}
+
+// record는 JSON 객체를 헤더 순서의 값으로 바꿉니다.
+func (s *jsonLinesSource) record(object map[string]interface{}) (ImportRecord, *ImportRowError) {
+	values := make([]interface{}, len(s.header))
+	for name, value := range object {
+		i, ok := s.index[name]
+		if !ok {
+			return ImportRecord{}, &ImportRowError{
+				Line:    s.line,
+				Message: fmt.Sprintf("unknown field %q (fields are taken from the first line)", name),
+			}
+		}
+
+		switch v := value.(type) {
+		case json.Number:
+			values[i] = v.String()
+		case map[string]interface{}, []interface{}:
+			data, err := json.Marshal(v)
+			if err != nil {
+				return ImportRecord{}, &ImportRowError{Line: s.line, Column: name, Message: err.Error()}
+			}
+			values[i] = string(data)
+		default:
+			values[i] = v
+		}
+	}
+	return ImportRecord{Line: s.line, Values: values}, nil
+}
+
+// decodeJSONObject는 한 줄의 JSON 객체를 읽습니다 (숫자는 json.Number).
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, fmt.Errorf("invalid JSON object: %v", err)
+	}
+	if object == nil {
+		return nil, fmt.Errorf("invalid JSON object: null")
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON object: more than one value on a line")
+	}
+	return object, nil
+}
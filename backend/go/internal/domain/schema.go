@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// 스키마 탐색 관련 에러
+var (
+	ErrSchemaNotFound = errors.New("schema not found")
+	ErrTableNotFound  = errors.New("table not found")
+	ErrColumnNotFound = errors.New("column not found")
+)
+
+// TableType은 테이블 목록에 나오는 객체의 종류입니다.
+// DB마다 이름이 다르므로 (Postgres relkind 'm', Oracle "MATERIALIZED VIEW")
+// 공통 값으로 바꿔서 사용합니다.
+type TableType string
+
+const (
+	TableTypeTable            TableType = "table"
+	TableTypeView             TableType = "view"
+	TableTypeMaterializedView TableType = "materialized_view"
+	TableTypeForeignTable     TableType = "foreign_table"
+)
+
+// TableInfo는 스키마 브라우저의 테이블(또는 뷰) 하나입니다.
+type TableInfo struct {
+	Schema string    // 소속 스키마 (Oracle은 소유자)
+	Name   string    // 이름 (DisplayIdentifier로 정규화된 값)
+	Type   TableType // 테이블, 뷰 등
+}
+
+// ColumnInfo는 테이블의 컬럼 하나입니다.
+// 데이터 사전, 그리드 편집기에서 쓰는 메타데이터를 모두 담습니다.
+type ColumnInfo struct {
+	Name     string // 컬럼 이름 (DisplayIdentifier로 정규화된 값)
+	Position int    // 1부터 시작하는 순서
+
+	// DataType은 길이/정밀도까지 포함한 전체 타입입니다.
+	// (예: "character varying(100)", "numeric(10,2)", "VARCHAR2(100 CHAR)", "NUMBER(10,2)")
+	DataType string
+
+	// BaseType은 길이/정밀도를 뺀 타입 이름입니다 (예: "character varying", "NUMBER").
+	BaseType string
+
+	// 포인터인 이유: "해당 없음"(nil)과 0을 구분하기 위해!
+	Length    *int64 // 문자/바이너리 타입의 최대 길이
+	Precision *int   // 숫자 타입의 전체 자릿수
+	Scale     *int   // 숫자 타입의 소수점 이하 자릿수
+
+	Nullable bool
+	Default  *string // 기본값 식 (없으면 nil)
+	Comment  string  // 컬럼 주석
+
+	PrimaryKey         bool // 기본 키에 포함되는지
+	PrimaryKeyPosition int  // 기본 키 안에서의 순서 (1부터, 기본 키가 아니면 0)
+
+	Identity *ColumnIdentity // 자동 증가 정보 (없으면 nil)
+}
+
+// IdentityGeneration은 자동 증가 컬럼의 값 생성 방식입니다.
+type IdentityGeneration string
+
+const (
+	IdentityAlways    IdentityGeneration = "ALWAYS"     // GENERATED ALWAYS AS IDENTITY
+	IdentityByDefault IdentityGeneration = "BY DEFAULT" // GENERATED BY DEFAULT AS IDENTITY
+	IdentitySequence  IdentityGeneration = "SEQUENCE"   // 시퀀스 기본값 (Postgres serial 등)
+)
+
+// ColumnIdentity는 자동 증가(identity/serial) 컬럼 정보입니다.
+type ColumnIdentity struct {
+	Generation IdentityGeneration
+	Sequence   string // 값을 만드는 시퀀스 이름 (알 수 없으면 빈 문자열)
+}
+
+// FoldIdentifier는 따옴표 없는 식별자를 DB가 저장하는 대소문자로 바꿉니다.
+//   - Postgres: 소문자 (CREATE TABLE Users → users)
+//   - Oracle: 대문자 (CREATE TABLE users → USERS)
+func FoldIdentifier(dbType DatabaseType, name string) string {
+	switch dbType {
+	case Oracle11g, Oracle19c:
+		return strings.ToUpper(name)
+	default:
+		return strings.ToLower(name)
+	}
+}
+
+// DisplayIdentifier는 카탈로그에 저장된 식별자를 API 응답용으로 정규화합니다.
+//
+// Postgres는 users, Oracle은 USERS로 저장하므로 그대로 보여주면
+// 같은 테이블도 DB마다 대소문자가 달라 보입니다.
+// 그래서 따옴표 없이 만든 식별자(= DB 기본 대소문자인 식별자)는 소문자로 통일하고,
+// "MixedCase"처럼 따옴표가 필요한 식별자만 원래 모양 그대로 둡니다.
+func DisplayIdentifier(dbType DatabaseType, name string) string {
+	if IsPlainIdentifier(dbType, name) {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// IsPlainIdentifier는 따옴표 없이 쓸 수 있는 식별자인지 확인합니다.
+// (영문자/밑줄로 시작하고, DB 기본 대소문자로만 이루어진 경우)
+func IsPlainIdentifier(dbType DatabaseType, name string) bool {
+	if name == "" || name != FoldIdentifier(dbType, name) {
+		return false
+	}
+
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '$'):
+		default:
+			return false
+		}
+	}
+	return true
+}
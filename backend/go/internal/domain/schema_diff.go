@@ -0,0 +1,651 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SchemaSnapshot은 한 시점의 스키마 구조(테이블, 컬럼, 인덱스, 제약조건)입니다.
+// 이름은 모두 DisplayIdentifier로 정규화된 값입니다.
+type SchemaSnapshot struct {
+	DatabaseID   string
+	DatabaseType DatabaseType
+	Schema       string
+	TakenAt      time.Time
+	Tables       []TableSnapshot // 이름순
+}
+
+// TableSnapshot은 테이블 하나의 구조입니다.
+type TableSnapshot struct {
+	Name     string
+	Columns  []ColumnInfo
+	Metadata *TableMetadata
+}
+
+// Table은 이름으로 테이블을 찾습니다 (없으면 nil).
+func (s *SchemaSnapshot) Table(name string) *TableSnapshot {
+	for i := range s.Tables {
+		if s.Tables[i].Name == name {
+			return &s.Tables[i]
+		}
+	}
+	return nil
+}
+
+// SchemaDiff는 두 스키마의 차이입니다.
+//
+// 방향: Source(기준) → Target(맞출 대상)
+//   - Missing: Source에는 있는데 Target에는 없음 (Target에 만들어야 함)
+//   - Extra: Target에만 있음 (Target에서 지워야 Source와 같아짐)
+type SchemaDiff struct {
+	Source SchemaRef
+	Target SchemaRef
+
+	MissingTables []string
+	ExtraTables   []string
+	ChangedTables []TableDiff // 양쪽에 있지만 다른 테이블
+
+	// Migration은 Target을 Source와 같게 만드는 DDL 문장입니다 (실행 순서대로).
+	// 같은 종류의 DB끼리만 만듭니다.
+	Migration []string
+
+	Warnings []string
+}
+
+// SchemaRef는 비교 대상 스키마입니다.
+type SchemaRef struct {
+	DatabaseID   string
+	DatabaseType DatabaseType
+	Schema       string
+}
+
+// HasDifferences는 차이가 하나라도 있는지 확인합니다.
+func (d *SchemaDiff) HasDifferences() bool {
+	return len(d.MissingTables) > 0 || len(d.ExtraTables) > 0 || len(d.ChangedTables) > 0
+}
+
+// TableDiff는 양쪽에 있는 테이블 하나의 차이입니다.
+type TableDiff struct {
+	Table string
+
+	MissingColumns []string
+	ExtraColumns   []string
+	ColumnChanges  []ColumnChange
+
+	MissingIndexes []string
+	ExtraIndexes   []string
+	ChangedIndexes []DefinitionChange
+
+	// 제약조건은 기본 키, 유니크, 체크, 외래 키를 모두 포함합니다.
+	MissingConstraints []string
+	ExtraConstraints   []string
+	ChangedConstraints []DefinitionChange
+}
+
+// IsEmpty는 차이가 없는지 확인합니다.
+func (d *TableDiff) IsEmpty() bool {
+	return len(d.MissingColumns) == 0 && len(d.ExtraColumns) == 0 && len(d.ColumnChanges) == 0 &&
+		len(d.MissingIndexes) == 0 && len(d.ExtraIndexes) == 0 && len(d.ChangedIndexes) == 0 &&
+		len(d.MissingConstraints) == 0 && len(d.ExtraConstraints) == 0 && len(d.ChangedConstraints) == 0
+}
+
+// ColumnProperty는 비교하는 컬럼 속성입니다.
+type ColumnProperty string
+
+const (
+	ColumnPropertyType     ColumnProperty = "type"
+	ColumnPropertyNullable ColumnProperty = "nullable"
+	ColumnPropertyDefault  ColumnProperty = "default"
+)
+
+// ColumnChange는 양쪽에 있는 컬럼의 속성 차이입니다.
+type ColumnChange struct {
+	Column   string
+	Property ColumnProperty
+	Source   string
+	Target   string
+}
+
+// DefinitionChange는 이름은 같은데 정의가 다른 인덱스/제약조건입니다.
+type DefinitionChange struct {
+	Name   string
+	Source string
+	Target string
+}
+
+// SchemaDiffOptions는 비교 옵션입니다.
+type SchemaDiffOptions struct {
+	// AllowDrop이 true면 Target에만 있는 테이블/컬럼/인덱스/제약조건을 지우는 DDL도 만듭니다.
+	// 기본값은 false (데이터가 사라지는 문장은 경고로만 알려줌)
+	AllowDrop bool
+}
+
+// DiffSchemas는 두 스냅샷을 비교합니다.
+//
+// 인덱스와 제약조건은 이름이 아니라 정의(컬럼, 유니크, 조건)로 먼저 맞춥니다.
+// Oracle은 SYS_C0012345처럼 환경마다 이름이 다른 자동 생성 이름이 많아서,
+// 이름만 비교하면 정의가 같은데도 전부 다르다고 나오기 때문입니다.
+func DiffSchemas(source, target *SchemaSnapshot, options SchemaDiffOptions) *SchemaDiff {
+	diff := &SchemaDiff{
+		Source: SchemaRef{DatabaseID: source.DatabaseID, DatabaseType: source.DatabaseType, Schema: source.Schema},
+		Target: SchemaRef{DatabaseID: target.DatabaseID, DatabaseType: target.DatabaseType, Schema: target.Schema},
+	}
+
+	sourceDialect := DialectOf(source.DatabaseType)
+	targetDialect := DialectOf(target.DatabaseType)
+	if sourceDialect != targetDialect {
+		diff.Warnings = append(diff.Warnings,
+			fmt.Sprintf("comparing %s with %s: column types are compared after type mapping and may be approximate; migration DDL is not generated",
+				source.DatabaseType, target.DatabaseType))
+	}
+
+	var tableDiffs []TableDiff
+	for _, table := range source.Tables {
+		other := target.Table(table.Name)
+		if other == nil {
+			diff.MissingTables = append(diff.MissingTables, table.Name)
+			continue
+		}
+
+		tableDiff := diffTable(source, target, &table, other)
+		if !tableDiff.IsEmpty() {
+			tableDiffs = append(tableDiffs, tableDiff)
+		}
+	}
+	for _, table := range target.Tables {
+		if source.Table(table.Name) == nil {
+			diff.ExtraTables = append(diff.ExtraTables, table.Name)
+		}
+	}
+	diff.ChangedTables = tableDiffs
+
+	if sourceDialect == targetDialect && sourceDialect.IsValid() {
+		m := &migration{
+			g:       &ddlGenerator{source: sourceDialect, target: targetDialect},
+			source:  source,
+			target:  target,
+			options: options,
+		}
+		diff.Migration = m.build(diff)
+		diff.Warnings = append(diff.Warnings, m.g.warnings...)
+	}
+
+	return diff
+}
+
+// diffTable은 양쪽에 있는 테이블 하나를 비교합니다.
+func diffTable(sourceSchema, targetSchema *SchemaSnapshot, source, target *TableSnapshot) TableDiff {
+	diff := TableDiff{Table: source.Name}
+
+	sourceDialect := DialectOf(sourceSchema.DatabaseType)
+	targetDialect := DialectOf(targetSchema.DatabaseType)
+
+	// 컬럼
+	targetColumns := make(map[string]ColumnInfo)
+	for _, col := range target.Columns {
+		targetColumns[col.Name] = col
+	}
+	sourceColumns := make(map[string]bool)
+
+	for _, col := range source.Columns {
+		sourceColumns[col.Name] = true
+
+		other, ok := targetColumns[col.Name]
+		if !ok {
+			diff.MissingColumns = append(diff.MissingColumns, col.Name)
+			continue
+		}
+		diff.ColumnChanges = append(diff.ColumnChanges, compareColumn(sourceDialect, targetDialect, col, other)...)
+	}
+	for _, col := range target.Columns {
+		if !sourceColumns[col.Name] {
+			diff.ExtraColumns = append(diff.ExtraColumns, col.Name)
+		}
+	}
+
+	// 인덱스와 제약조건
+	diff.MissingIndexes, diff.ExtraIndexes, diff.ChangedIndexes = diffDefinitions(
+		indexDefinitions(source.Metadata), indexDefinitions(target.Metadata))
+
+	diff.MissingConstraints, diff.ExtraConstraints, diff.ChangedConstraints = diffDefinitions(
+		constraintDefinitions(sourceSchema.Schema, source.Metadata),
+		constraintDefinitions(targetSchema.Schema, target.Metadata))
+
+	return diff
+}
+
+// compareColumn은 양쪽에 있는 컬럼의 타입, NULL 허용, 기본값을 비교합니다.
+// 다른 종류의 DB끼리는 Source 타입/기본값을 Target 방언으로 바꾼 뒤 비교합니다.
+func compareColumn(sourceDialect, targetDialect SQLDialect, source, target ColumnInfo) []ColumnChange {
+	var changes []ColumnChange
+
+	sourceType, _ := ConvertColumnType(sourceDialect, targetDialect, source)
+	if normalizeType(targetDialect, sourceType) != normalizeType(targetDialect, target.DataType) {
+		changes = append(changes, ColumnChange{
+			Column: source.Name, Property: ColumnPropertyType,
+			Source: source.DataType, Target: target.DataType,
+		})
+	}
+
+	if source.Nullable != target.Nullable {
+		changes = append(changes, ColumnChange{
+			Column: source.Name, Property: ColumnPropertyNullable,
+			Source: fmt.Sprint(source.Nullable), Target: fmt.Sprint(target.Nullable),
+		})
+	}
+
+	// identity 컬럼의 기본값은 시퀀스 이름이 환경마다 달라서 비교하지 않습니다.
+	if source.Identity == nil && target.Identity == nil {
+		sourceDefault, targetDefault := "", ""
+		if source.Default != nil {
+			if converted, ok := ConvertDefault(sourceDialect, targetDialect, *source.Default); ok {
+				sourceDefault = converted
+			} else {
+				sourceDefault = *source.Default
+			}
+		}
+		if target.Default != nil {
+			targetDefault = strings.TrimSpace(*target.Default)
+		}
+
+		if !strings.EqualFold(sourceDefault, targetDefault) {
+			changes = append(changes, ColumnChange{
+				Column: source.Name, Property: ColumnPropertyDefault,
+				Source: derefString(source.Default), Target: derefString(target.Default),
+			})
+		}
+	}
+
+	return changes
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.TrimSpace(*s)
+}
+
+// postgresTypeAliases는 같은 타입의 다른 이름을 format_type() 이름으로 맞추는 표입니다.
+var postgresTypeAliases = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`^varchar\b`), "character varying"},
+	{regexp.MustCompile(`^char\b`), "character"},
+	{regexp.MustCompile(`^timestamptz(\(\d+\))?$`), "timestamp$1 with time zone"},
+	{regexp.MustCompile(`^timestamp(\(\d+\))?$`), "timestamp$1 without time zone"},
+}
+
+// normalizeType은 타입 이름 비교를 위해 대소문자와 별칭을 맞춥니다.
+func normalizeType(dialect SQLDialect, dataType string) string {
+	if dialect == DialectOracle {
+		return strings.ToUpper(strings.TrimSpace(dataType))
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(dataType))
+	for _, alias := range postgresTypeAliases {
+		normalized = alias.pattern.ReplaceAllString(normalized, alias.replacement)
+	}
+	return normalized
+}
+
+// namedDefinition은 비교용으로 정의를 한 줄 문자열로 만든 인덱스/제약조건입니다.
+type namedDefinition struct {
+	name       string
+	definition string
+}
+
+// indexDefinitions는 비교할 인덱스 목록을 만듭니다.
+// 기본 키/유니크 제약조건이 만든 인덱스는 제약조건 쪽에서 비교하므로 뺍니다.
+// 인덱스 종류는 DB마다 이름이 다르므로(btree, NORMAL) 기본 종류가 아닐 때만 넣습니다.
+func indexDefinitions(meta *TableMetadata) []namedDefinition {
+	constraintNames := make(map[string]bool)
+	for _, con := range meta.Constraints {
+		constraintNames[con.Name] = true
+	}
+
+	var definitions []namedDefinition
+	for _, idx := range meta.Indexes {
+		if idx.Primary || constraintNames[idx.Name] {
+			continue
+		}
+
+		var b strings.Builder
+		if idx.Unique {
+			b.WriteString("UNIQUE ")
+		}
+		if t := strings.ToLower(idx.Type); t != "" && t != "btree" && t != "normal" {
+			b.WriteString(strings.ToUpper(idx.Type) + " ")
+		}
+		b.WriteString("(" + strings.Join(idx.Columns, ", ") + ")")
+		if idx.Predicate != "" {
+			b.WriteString(" WHERE " + idx.Predicate)
+		}
+
+		definitions = append(definitions, namedDefinition{name: idx.Name, definition: b.String()})
+	}
+	return definitions
+}
+
+// checkPrefix는 Postgres 체크 조건의 "CHECK (...)" 껍데기입니다.
+var checkPrefix = regexp.MustCompile(`(?i)^check\s*`)
+
+// constraintDefinitions는 비교할 제약조건 목록을 만듭니다 (외래 키 포함).
+// 외래 키가 같은 스키마의 테이블을 참조하면 스키마 이름을 빼서
+// dev/prod 스키마 이름이 달라도 같은 정의로 봅니다.
+func constraintDefinitions(schema string, meta *TableMetadata) []namedDefinition {
+	var definitions []namedDefinition
+
+	for _, con := range meta.Constraints {
+		var definition string
+		switch con.Type {
+		case ConstraintPrimaryKey:
+			definition = "PRIMARY KEY (" + strings.Join(con.Columns, ", ") + ")"
+		case ConstraintUnique:
+			definition = "UNIQUE (" + strings.Join(con.Columns, ", ") + ")"
+		case ConstraintCheck:
+			// 공백, 괄호, 따옴표, 대소문자 차이는 무시합니다.
+			expr := checkPrefix.ReplaceAllString(strings.TrimSpace(con.Expression), "")
+			expr = strings.Map(func(r rune) rune {
+				switch r {
+				case ' ', '\t', '\n', '(', ')', '"':
+					return -1
+				}
+				return r
+			}, strings.ToLower(expr))
+			definition = "CHECK " + expr
+		}
+		definitions = append(definitions, namedDefinition{name: con.Name, definition: definition})
+	}
+
+	for _, fk := range meta.ForeignKeys {
+		ref := fk.RefTable
+		if fk.RefSchema != schema {
+			ref = fk.RefSchema + "." + fk.RefTable
+		}
+		definition := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+			strings.Join(fk.Columns, ", "), ref, strings.Join(fk.RefColumns, ", "))
+		if fk.OnDelete != "" && fk.OnDelete != "NO ACTION" {
+			definition += " ON DELETE " + fk.OnDelete
+		}
+		if fk.OnUpdate != "" && fk.OnUpdate != "NO ACTION" {
+			definition += " ON UPDATE " + fk.OnUpdate
+		}
+		definitions = append(definitions, namedDefinition{name: fk.Name, definition: definition})
+	}
+
+	return definitions
+}
+
+// diffDefinitions는 정의 목록 두 개를 비교합니다.
+//
+//  1. 정의가 같은 것이 Target에 있으면 (이름이 달라도) 같은 것으로 봅니다.
+//  2. 정의는 다르지만 이름이 같은 것이 있으면 "변경"입니다.
+//  3. 둘 다 아니면 Source 쪽은 "없음", Target 쪽은 "남음"입니다.
+func diffDefinitions(source, target []namedDefinition) (missing, extra []string, changed []DefinitionChange) {
+	matchedTarget := make(map[int]bool)
+
+	findTarget := func(match func(namedDefinition) bool) int {
+		for i, t := range target {
+			if !matchedTarget[i] && match(t) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var unmatched []namedDefinition
+	for _, s := range source {
+		if i := findTarget(func(t namedDefinition) bool { return t.definition == s.definition }); i >= 0 {
+			matchedTarget[i] = true
+			continue
+		}
+		unmatched = append(unmatched, s)
+	}
+
+	for _, s := range unmatched {
+		if i := findTarget(func(t namedDefinition) bool { return t.name == s.name }); i >= 0 {
+			matchedTarget[i] = true
+			changed = append(changed, DefinitionChange{Name: s.name, Source: s.definition, Target: target[i].definition})
+			continue
+		}
+		missing = append(missing, s.name)
+	}
+
+	for i, t := range target {
+		if !matchedTarget[i] {
+			extra = append(extra, t.name)
+		}
+	}
+
+	return missing, extra, changed
+}
+
+// migration은 Target을 Source와 같게 만드는 DDL 문장을 만듭니다.
+type migration struct {
+	g       *ddlGenerator
+	source  *SchemaSnapshot
+	target  *SchemaSnapshot
+	options SchemaDiffOptions
+}
+
+// build는 DDL 문장을 실행 순서대로 만듭니다.
+//
+//  1. 없는 테이블 CREATE (외래 키 없이)
+//  2. 테이블별 변경: 지울 제약조건/인덱스 → 컬럼 추가/변경/삭제 → 제약조건/인덱스 추가
+//  3. 외래 키 (새 테이블 것 + 기존 테이블에 추가할 것)
+//  4. 남는 테이블 DROP (AllowDrop일 때만)
+func (m *migration) build(diff *SchemaDiff) []string {
+	var statements, foreignKeys []string
+
+	for _, name := range diff.MissingTables {
+		table := m.source.Table(name)
+		def := TableDefinition{Source: m.g.source, Metadata: m.retarget(table.Metadata), Columns: table.Columns}
+
+		parts := m.g.tableStatements(def)
+		statements = append(statements, parts.create)
+		statements = append(statements, parts.indexes...)
+		statements = append(statements, parts.comments...)
+		foreignKeys = append(foreignKeys, parts.foreignKeys...)
+	}
+
+	for _, tableDiff := range diff.ChangedTables {
+		tableStatements, tableForeignKeys := m.alterTable(tableDiff)
+		statements = append(statements, tableStatements...)
+		foreignKeys = append(foreignKeys, tableForeignKeys...)
+	}
+
+	statements = append(statements, foreignKeys...)
+
+	for _, name := range diff.ExtraTables {
+		drop := fmt.Sprintf("DROP TABLE %s;", m.g.qualified(m.target.Schema, name))
+		if !m.options.AllowDrop {
+			m.g.warn("table %s exists only in the target and was not dropped (set allow_drop to include %q)", name, drop)
+			continue
+		}
+		statements = append(statements, drop)
+	}
+
+	return statements
+}
+
+// retarget은 Source 테이블 메타데이터를 Target 스키마 기준으로 바꾼 복사본을 만듭니다.
+// (Source 스키마를 가리키는 외래 키도 Target 스키마로 바꾸고,
+// 스키마 이름이 들어 있는 DB 원본 인덱스 정의와 소유자는 쓰지 않습니다)
+func (m *migration) retarget(meta *TableMetadata) *TableMetadata {
+	copied := *meta
+	copied.Schema = m.target.Schema
+	copied.Owner = ""
+
+	copied.Indexes = make([]IndexInfo, len(meta.Indexes))
+	for i, idx := range meta.Indexes {
+		if m.source.Schema != m.target.Schema {
+			idx.Definition = ""
+		}
+		copied.Indexes[i] = idx
+	}
+
+	copied.ForeignKeys = make([]ForeignKeyInfo, len(meta.ForeignKeys))
+	for i, fk := range meta.ForeignKeys {
+		if fk.RefSchema == m.source.Schema {
+			fk.RefSchema = m.target.Schema
+		}
+		copied.ForeignKeys[i] = fk
+	}
+
+	return &copied
+}
+
+// alterTable은 양쪽에 있는 테이블 하나의 변경 DDL을 만듭니다.
+// 외래 키 추가는 다른 테이블이 만들어진 뒤에 해야 하므로 따로 돌려줍니다.
+func (m *migration) alterTable(diff TableDiff) (statements, foreignKeys []string) {
+	source := m.source.Table(diff.Table)
+	meta := m.retarget(source.Metadata)
+	table := m.g.qualified(m.target.Schema, diff.Table)
+
+	columns := make(map[string]ColumnInfo)
+	for _, col := range source.Columns {
+		columns[col.Name] = col
+	}
+
+	dropConstraint := func(name string) string {
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", table, QuoteIdentifier(name))
+	}
+	dropIndex := func(name string) string {
+		return fmt.Sprintf("DROP INDEX %s;", m.g.qualified(m.target.Schema, name))
+	}
+
+	// 1. 바뀐 제약조건/인덱스는 먼저 지우고 나중에 다시 만듭니다.
+	for _, change := range diff.ChangedConstraints {
+		statements = append(statements, dropConstraint(change.Name))
+	}
+	for _, change := range diff.ChangedIndexes {
+		statements = append(statements, dropIndex(change.Name))
+	}
+	for _, name := range diff.ExtraConstraints {
+		m.dropOrWarn(&statements, dropConstraint(name), "constraint %s on %s", name, diff.Table)
+	}
+	for _, name := range diff.ExtraIndexes {
+		m.dropOrWarn(&statements, dropIndex(name), "index %s on %s", name, diff.Table)
+	}
+
+	// 2. 컬럼
+	for _, name := range diff.MissingColumns {
+		definition := m.g.columnDefinition(columns[name])
+		if m.g.target == DialectOracle {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD (%s);", table, definition))
+		} else {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", table, definition))
+		}
+		if col := columns[name]; !col.Nullable && col.Default == nil && col.Identity == nil {
+			m.g.warn("column %s.%s is NOT NULL without a default; adding it fails if the table has rows", diff.Table, name)
+		}
+	}
+	for _, change := range diff.ColumnChanges {
+		statements = append(statements, m.alterColumn(table, columns[change.Column], change))
+	}
+	for _, name := range diff.ExtraColumns {
+		drop := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", table, QuoteIdentifier(name))
+		m.dropOrWarn(&statements, drop, "column %s.%s", diff.Table, name)
+	}
+
+	// 3. 없는(또는 바뀐) 제약조건/인덱스 추가
+	add := make(map[string]bool)
+	for _, name := range diff.MissingConstraints {
+		add[name] = true
+	}
+	for _, change := range diff.ChangedConstraints {
+		add[change.Name] = true
+	}
+	for _, con := range meta.Constraints {
+		if add[con.Name] {
+			if clause := m.g.constraintClause(con); clause != "" {
+				statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s;",
+					table, QuoteIdentifier(con.Name), clause))
+			}
+		}
+	}
+	for _, fk := range meta.ForeignKeys {
+		if add[fk.Name] {
+			foreignKeys = append(foreignKeys, m.g.addForeignKey(table, fk))
+		}
+	}
+
+	addIndex := make(map[string]bool)
+	for _, name := range diff.MissingIndexes {
+		addIndex[name] = true
+	}
+	for _, change := range diff.ChangedIndexes {
+		addIndex[change.Name] = true
+	}
+	for _, idx := range meta.Indexes {
+		if addIndex[idx.Name] {
+			statements = append(statements, m.g.indexDefinition(meta, idx))
+		}
+	}
+
+	return statements, foreignKeys
+}
+
+// alterColumn은 컬럼 속성 하나를 바꾸는 DDL을 만듭니다.
+//   - Postgres: ALTER TABLE t ALTER COLUMN c TYPE / SET NOT NULL / SET DEFAULT
+//   - Oracle: ALTER TABLE t MODIFY (c 타입 / NOT NULL / DEFAULT)
+func (m *migration) alterColumn(table string, col ColumnInfo, change ColumnChange) string {
+	column := QuoteIdentifier(col.Name)
+
+	var clause string
+	switch change.Property {
+	case ColumnPropertyType:
+		if m.g.target == DialectOracle {
+			clause = col.DataType
+		} else {
+			clause = "TYPE " + col.DataType
+		}
+	case ColumnPropertyNullable:
+		switch {
+		case m.g.target == DialectOracle && col.Nullable:
+			clause = "NULL"
+		case m.g.target == DialectOracle:
+			clause = "NOT NULL"
+		case col.Nullable:
+			clause = "DROP NOT NULL"
+		default:
+			clause = "SET NOT NULL"
+		}
+	case ColumnPropertyDefault:
+		switch {
+		case m.g.target == DialectOracle && col.Default == nil:
+			clause = "DEFAULT NULL"
+		case m.g.target == DialectOracle:
+			clause = "DEFAULT " + *col.Default
+		case col.Default == nil:
+			clause = "DROP DEFAULT"
+		default:
+			clause = "SET DEFAULT " + *col.Default
+		}
+	}
+
+	if m.g.target == DialectOracle {
+		return fmt.Sprintf("ALTER TABLE %s MODIFY (%s %s);", table, column, clause)
+	}
+	return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s;", table, column, clause)
+}
+
+// dropOrWarn은 AllowDrop이면 DROP 문을 넣고, 아니면 경고만 남깁니다.
+func (m *migration) dropOrWarn(statements *[]string, drop string, format string, args ...interface{}) {
+	if m.options.AllowDrop {
+		*statements = append(*statements, drop)
+		return
+	}
+	m.g.warn("%s exists only in the target and was not dropped (set allow_drop to include %q)",
+		fmt.Sprintf(format, args...), drop)
+}
+
+// SortTables는 스냅샷의 테이블을 이름순으로 정렬합니다.
+func (s *SchemaSnapshot) SortTables() {
+	sort.Slice(s.Tables, func(i, j int) bool { return s.Tables[i].Name < s.Tables[j].Name })
+}
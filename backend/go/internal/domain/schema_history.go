@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// 스냅샷 이력 관련 에러
+var (
+	ErrSnapshotNotFound = errors.New("schema snapshot not found")
+)
+
+// SnapshotVersion은 저장된 스냅샷 하나의 요약입니다.
+//
+// 버전은 (DB, 스키마)마다 1부터 증가합니다.
+// 구조가 바뀌었을 때만 새 버전을 저장하므로, 버전의 TakenAt이 곧 "변경을 발견한 시각"입니다.
+type SnapshotVersion struct {
+	DatabaseID  string
+	Schema      string
+	Version     int
+	TakenAt     time.Time
+	TableCount  int
+	ChangeCount int // 이전 버전과 비교한 변경 수 (첫 버전은 0)
+}
+
+// SchemaChangeType은 변경 종류입니다.
+type SchemaChangeType string
+
+const (
+	ChangeTableAdded        SchemaChangeType = "table_added"
+	ChangeTableDropped      SchemaChangeType = "table_dropped"
+	ChangeColumnAdded       SchemaChangeType = "column_added"
+	ChangeColumnDropped     SchemaChangeType = "column_dropped"
+	ChangeColumnTypeChanged SchemaChangeType = "column_type_changed"
+	ChangeColumnNullable    SchemaChangeType = "column_nullable_changed"
+	ChangeColumnDefault     SchemaChangeType = "column_default_changed"
+	ChangeIndexAdded        SchemaChangeType = "index_added"
+	ChangeIndexDropped      SchemaChangeType = "index_dropped"
+	ChangeIndexChanged      SchemaChangeType = "index_changed"
+	ChangeConstraintAdded   SchemaChangeType = "constraint_added"
+	ChangeConstraintDropped SchemaChangeType = "constraint_dropped"
+	ChangeConstraintChanged SchemaChangeType = "constraint_changed"
+)
+
+// SchemaChange는 두 버전 사이의 변경 하나입니다.
+//
+// Name은 컬럼/인덱스/제약조건 이름입니다 (테이블 변경이면 비어 있음).
+// Before/After는 바뀐 값입니다 (타입, NULL 허용, 기본값, 인덱스 정의 등).
+type SchemaChange struct {
+	Type   SchemaChangeType
+	Table  string
+	Name   string
+	Before string
+	After  string
+}
+
+// SchemaChangeLog는 한 버전이 이전 버전과 어떻게 다른지 기록한 것입니다.
+type SchemaChangeLog struct {
+	DatabaseID      string
+	Schema          string
+	Version         int
+	PreviousVersion int
+	DetectedAt      time.Time
+	Changes         []SchemaChange
+}
+
+// SnapshotResult는 스냅샷을 한 번 찍은 결과입니다.
+//
+// 구조가 이전 버전과 같으면 Stored=false이고 Version은 기존 최신 버전입니다.
+// ChangeLog는 새 버전이 저장되었고 이전 버전이 있을 때만 채워집니다.
+type SnapshotResult struct {
+	Version   SnapshotVersion
+	Stored    bool
+	ChangeLog *SchemaChangeLog
+}
+
+// SchemaChanges는 이전 스냅샷(previous)에서 현재 스냅샷(current)으로의 변경 목록을 만듭니다.
+//
+// DiffSchemas(current, previous)의 결과를 "변경 로그" 관점으로 바꿔 읽습니다.
+//   - Missing(현재에만 있음) → 추가됨
+//   - Extra(이전에만 있음) → 삭제됨
+//   - ColumnChange의 Source는 현재 값(After), Target은 이전 값(Before)
+func SchemaChanges(previous, current *SchemaSnapshot) []SchemaChange {
+	diff := DiffSchemas(current, previous, SchemaDiffOptions{})
+
+	var changes []SchemaChange
+	add := func(changeType SchemaChangeType, table, name, before, after string) {
+		changes = append(changes, SchemaChange{Type: changeType, Table: table, Name: name, Before: before, After: after})
+	}
+
+	for _, table := range diff.MissingTables {
+		add(ChangeTableAdded, table, "", "", "")
+	}
+	for _, table := range diff.ExtraTables {
+		add(ChangeTableDropped, table, "", "", "")
+	}
+
+	for _, t := range diff.ChangedTables {
+		for _, column := range t.MissingColumns {
+			add(ChangeColumnAdded, t.Table, column, "", columnType(current.Table(t.Table), column))
+		}
+		for _, column := range t.ExtraColumns {
+			add(ChangeColumnDropped, t.Table, column, columnType(previous.Table(t.Table), column), "")
+		}
+		for _, c := range t.ColumnChanges {
+			add(columnChangeTypes[c.Property], t.Table, c.Column, c.Target, c.Source)
+		}
+
+		for _, index := range t.MissingIndexes {
+			add(ChangeIndexAdded, t.Table, index, "", "")
+		}
+		for _, index := range t.ExtraIndexes {
+			add(ChangeIndexDropped, t.Table, index, "", "")
+		}
+		for _, c := range t.ChangedIndexes {
+			add(ChangeIndexChanged, t.Table, c.Name, c.Target, c.Source)
+		}
+
+		for _, constraint := range t.MissingConstraints {
+			add(ChangeConstraintAdded, t.Table, constraint, "", "")
+		}
+		for _, constraint := range t.ExtraConstraints {
+			add(ChangeConstraintDropped, t.Table, constraint, "", "")
+		}
+		for _, c := range t.ChangedConstraints {
+			add(ChangeConstraintChanged, t.Table, c.Name, c.Target, c.Source)
+		}
+	}
+
+	return changes
+}
+
+// columnChangeTypes는 ColumnProperty를 변경 종류로 바꾸는 표입니다.
+var columnChangeTypes = map[ColumnProperty]SchemaChangeType{
+	ColumnPropertyType:     ChangeColumnTypeChanged,
+	ColumnPropertyNullable: ChangeColumnNullable,
+	ColumnPropertyDefault:  ChangeColumnDefault,
+}
+
+// columnType은 테이블 스냅샷에서 컬럼 타입을 찾습니다 (없으면 빈 문자열).
+func columnType(table *TableSnapshot, column string) string {
+	if table == nil {
+		return ""
+	}
+	for _, c := range table.Columns {
+		if c.Name == column {
+			return c.DataType
+		}
+	}
+	return ""
+}
@@ -0,0 +1,287 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SQLTokenKind는 토큰 종류입니다.
+type SQLTokenKind string
+
+const (
+	TokenWord         SQLTokenKind = "word"          // 키워드, 따옴표 없는 식별자
+	TokenQuotedIdent  SQLTokenKind = "quoted_ident"  // "MixedCase"
+	TokenString       SQLTokenKind = "string"        // 'text', $$text$$
+	TokenNumber       SQLTokenKind = "number"        // 123, 1.5
+	TokenParameter    SQLTokenKind = "parameter"     // $1, :name, ?
+	TokenLineComment  SQLTokenKind = "line_comment"  // -- ...
+	TokenBlockComment SQLTokenKind = "block_comment" // /* ... */
+	TokenPunct        SQLTokenKind = "punct"         // . , ( ) ; 연산자
+)
+
+// SQLToken은 SQL 문자열의 토큰 하나입니다.
+// Start/End는 바이트 위치이며 sql[Start:End]가 토큰 원문입니다.
+type SQLToken struct {
+	Kind  SQLTokenKind
+	Text  string
+	Start int
+	End   int
+
+	// Closed는 문자열/따옴표 식별자/블록 주석이 닫혔는지입니다.
+	// 편집 중인 SQL은 끝이 열려 있는 경우가 많습니다 (SELECT 'abc).
+	Closed bool
+}
+
+// IsComment는 주석 토큰인지 확인합니다.
+func (t SQLToken) IsComment() bool {
+	return t.Kind == TokenLineComment || t.Kind == TokenBlockComment
+}
+
+// IsIdentifier는 식별자로 쓸 수 있는 토큰인지 확인합니다 (키워드 포함).
+func (t SQLToken) IsIdentifier() bool {
+	return t.Kind == TokenWord || t.Kind == TokenQuotedIdent
+}
+
+// Is는 구두점이거나 (대소문자 무시) 같은 단어인지 확인합니다.
+func (t SQLToken) Is(text string) bool {
+	return (t.Kind == TokenWord || t.Kind == TokenPunct) && strings.EqualFold(t.Text, text)
+}
+
+// Identifier는 식별자의 이름을 반환합니다 ("a""b" → a"b).
+func (t SQLToken) Identifier() string {
+	if t.Kind != TokenQuotedIdent {
+		return t.Text
+	}
+	name := strings.TrimPrefix(t.Text, `"`)
+	if t.Closed {
+		name = strings.TrimSuffix(name, `"`)
+	}
+	return strings.ReplaceAll(name, `""`, `"`)
+}
+
+// TokenizeSQL은 SQL을 토큰으로 나눕니다 (공백은 버림).
+//
+// 구문 분석기가 아니라 자동완성처럼 "커서 주변이 무엇인지"만 알면 되는 곳에서 쓰는
+// 가벼운 토크나이저입니다. 잘못된 SQL이나 끝나지 않은 문자열에서도 멈추지 않고
+// 끝까지 토큰을 만듭니다.
+//
+// 지원하는 문법:
+//   - 주석: -- 한 줄, /* 블록 */
+//   - 문자열: '...' (작은따옴표 두 개로 이스케이프), Postgres $tag$...$tag$,
+//     Postgres E'...' (백슬래시 이스케이프), Oracle q'[...]' (대체 인용, N 접두사 포함)
+//   - 따옴표 식별자: "..." (큰따옴표 두 개로 이스케이프)
+//   - 바인드 변수: $1, :name, ?
+func TokenizeSQL(sql string) []SQLToken {
+	var tokens []SQLToken
+
+	i := 0
+	for i < len(sql) {
+		r, size := utf8.DecodeRuneInString(sql[i:])
+		start := i
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+
+		case strings.HasPrefix(sql[i:], "--"):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			i += end
+			tokens = append(tokens, SQLToken{Kind: TokenLineComment, Start: start, End: i, Closed: true})
+
+		case strings.HasPrefix(sql[i:], "/*"):
+			closed := false
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += 2 + end + 2
+				closed = true
+			} else {
+				i = len(sql)
+			}
+			tokens = append(tokens, SQLToken{Kind: TokenBlockComment, Start: start, End: i, Closed: closed})
+
+		case r == '\'':
+			var closed bool
+			i, closed = scanQuoted(sql, i, '\'')
+			tokens = append(tokens, SQLToken{Kind: TokenString, Start: start, End: i, Closed: closed})
+
+		case r == '"':
+			var closed bool
+			i, closed = scanQuoted(sql, i, '"')
+			tokens = append(tokens, SQLToken{Kind: TokenQuotedIdent, Start: start, End: i, Closed: closed})
+
+		case r == '$':
+			if tag, ok := dollarTag(sql[i:]); ok {
+				// $tag$ ... $tag$ (Postgres 함수 본문 등)
+				closed := false
+				if end := strings.Index(sql[i+len(tag):], tag); end >= 0 {
+					i += len(tag) + end + len(tag)
+					closed = true
+				} else {
+					i = len(sql)
+				}
+				tokens = append(tokens, SQLToken{Kind: TokenString, Start: start, End: i, Closed: closed})
+				break
+			}
+			i += size
+			for i < len(sql) && sql[i] >= '0' && sql[i] <= '9' {
+				i++
+			}
+			tokens = append(tokens, SQLToken{Kind: TokenParameter, Start: start, End: i})
+
+		case r == ':' && i+1 < len(sql) && isIdentStart(rune(sql[i+1])) && (i == 0 || sql[i-1] != ':'):
+			// :name (Oracle 바인드 변수), ::cast는 제외
+			i = scanWord(sql, i+1)
+			tokens = append(tokens, SQLToken{Kind: TokenParameter, Start: start, End: i})
+
+		case r == '?':
+			i += size
+			tokens = append(tokens, SQLToken{Kind: TokenParameter, Start: start, End: i})
+
+		case isIdentStart(r) && alternativeQuoteStart(sql[i:]) > 0:
+			// q'[it's]' (Oracle): 구분자 안의 작은따옴표는 문자열의 일부
+			var closed bool
+			i, closed = scanAlternativeQuote(sql, i+alternativeQuoteStart(sql[i:]))
+			tokens = append(tokens, SQLToken{Kind: TokenString, Start: start, End: i, Closed: closed})
+
+		case (r == 'E' || r == 'e') && strings.HasPrefix(sql[i+1:], "'"):
+			// E'it\'s' (Postgres): 백슬래시가 다음 문자를 이스케이프
+			var closed bool
+			i, closed = scanEscapedString(sql, i+1)
+			tokens = append(tokens, SQLToken{Kind: TokenString, Start: start, End: i, Closed: closed})
+
+		case isIdentStart(r):
+			i = scanWord(sql, i)
+			tokens = append(tokens, SQLToken{Kind: TokenWord, Start: start, End: i})
+
+		case r >= '0' && r <= '9' || r == '.' && i+1 < len(sql) && sql[i+1] >= '0' && sql[i+1] <= '9':
+			i++
+			for i < len(sql) && (sql[i] >= '0' && sql[i] <= '9' || sql[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, SQLToken{Kind: TokenNumber, Start: start, End: i})
+
+		default:
+			i += size
+			tokens = append(tokens, SQLToken{Kind: TokenPunct, Start: start, End: i})
+		}
+	}
+
+	for n := range tokens {
+		tokens[n].Text = sql[tokens[n].Start:tokens[n].End]
+		if tokens[n].Kind != TokenString && tokens[n].Kind != TokenQuotedIdent && tokens[n].Kind != TokenBlockComment {
+			tokens[n].Closed = true
+		}
+	}
+
+	return tokens
+}
+
+// scanQuoted는 quote로 시작하는 문자열의 끝 위치를 찾습니다 (quote 두 개는 이스케이프).
+func scanQuoted(sql string, i int, quote byte) (int, bool) {
+	i++
+	for i < len(sql) {
+		if sql[i] == quote {
+			if i+1 < len(sql) && sql[i+1] == quote {
+				i += 2
+				continue
+			}
+			return i + 1, true
+		}
+		i++
+	}
+	return i, false
+}
+
+// alternativeQuoteStart는 s가 Oracle 대체 인용(q'X, nq'X)으로 시작하면
+// 여는 작은따옴표의 위치를, 아니면 0을 반환합니다.
+func alternativeQuoteStart(s string) int {
+	prefix := 1
+	if len(s) > 0 && (s[0] == 'n' || s[0] == 'N') {
+		prefix = 2
+	}
+	if len(s) < prefix+2 || (s[prefix-1] != 'q' && s[prefix-1] != 'Q') || s[prefix] != '\'' {
+		return 0
+	}
+	if d := s[prefix+1]; d == ' ' || d == '\t' || d == '\n' || d == '\r' {
+		return 0
+	}
+	return prefix
+}
+
+// scanAlternativeQuote는 i의 작은따옴표로 시작하는 q'X...X' 문자열의 끝 위치를 찾습니다.
+// 여는 구분자가 ( [ { < 이면 닫는 구분자는 ) ] } > 이고, 그 외에는 같은 문자입니다.
+func scanAlternativeQuote(sql string, i int) (int, bool) {
+	open := sql[i+1]
+	closing := open
+	switch open {
+	case '(':
+		closing = ')'
+	case '[':
+		closing = ']'
+	case '{':
+		closing = '}'
+	case '<':
+		closing = '>'
+	}
+
+	if end := strings.Index(sql[i+2:], string(closing)+"'"); end >= 0 {
+		return i + 2 + end + 2, true
+	}
+	return len(sql), false
+}
+
+// scanEscapedString은 i의 작은따옴표로 시작하는 E'...' 문자열의 끝 위치를 찾습니다.
+// 백슬래시는 다음 문자를 이스케이프하고, 작은따옴표 두 개도 이스케이프입니다.
+func scanEscapedString(sql string, i int) (int, bool) {
+	i++
+	for i < len(sql) {
+		switch {
+		case sql[i] == '\\':
+			i += 2
+			continue
+		case sql[i] == '\'':
+			if i+1 < len(sql) && sql[i+1] == '\'' {
+				i += 2
+				continue
+			}
+			return i + 1, true
+		}
+		i++
+	}
+	return len(sql), false
+}
+
+// scanWord는 식별자 문자(문자, 숫자, _, $, #)가 끝나는 위치를 찾습니다.
+func scanWord(sql string, i int) int {
+	for i < len(sql) {
+		r, size := utf8.DecodeRuneInString(sql[i:])
+		if !isIdentStart(r) && !unicode.IsDigit(r) && r != '$' && r != '#' {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// dollarTag는 s가 $tag$ 또는 $$로 시작하면 태그를 반환합니다.
+func dollarTag(s string) (string, bool) {
+	end := strings.IndexByte(s[1:], '$')
+	if end < 0 {
+		return "", false
+	}
+	tag := s[1 : 1+end]
+	for i, r := range tag {
+		if !isIdentStart(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return "", false
+		}
+	}
+	return s[:end+2], true
+}
+
+func isIdentStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_'
+}
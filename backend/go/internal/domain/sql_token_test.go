@@ -0,0 +1,36 @@
+package domain
+
+import "testing"
+
+func TestTokenizeSQLStrings(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string // 첫 토큰의 원문
+		kind SQLTokenKind
+	}{
+		{"standard string", "'it''s' x", "'it''s'", TokenString},
+		{"postgres escape string", `E'it\'s' x`, `E'it\'s'`, TokenString},
+		{"lowercase escape string", `e'a\\' x`, `e'a\\'`, TokenString},
+		{"oracle q-quote brackets", "q'[it's]' x", "q'[it's]'", TokenString},
+		{"oracle q-quote braces", "Q'{a}b}' x", "Q'{a}b}'", TokenString},
+		{"oracle q-quote custom", "q'!it's!' x", "q'!it's!'", TokenString},
+		{"oracle national q-quote", "nq'<a>' x", "nq'<a>'", TokenString},
+		{"quoted identifier", `"a""b" x`, `"a""b"`, TokenQuotedIdent},
+		{"dollar quote", "$tag$a;b$tag$ x", "$tag$a;b$tag$", TokenString},
+		{"word named e", "e x", "e", TokenWord},
+		{"word named q", "q x", "q", TokenWord},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := TokenizeSQL(tt.sql)
+			if len(tokens) == 0 {
+				t.Fatalf("TokenizeSQL(%q) returned no tokens", tt.sql)
+			}
+			if tokens[0].Text != tt.want || tokens[0].Kind != tt.kind {
+				t.Errorf("TokenizeSQL(%q)[0] = %q (%s), want %q (%s)", tt.sql, tokens[0].Text, tokens[0].Kind, tt.want, tt.kind)
+			}
+		})
+	}
+}
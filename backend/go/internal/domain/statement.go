@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+// StatementKind는 SQL 문장의 종류입니다.
+// 실행 전에 "읽기인지, 쓰기인지, 스키마 변경인지"를 판단할 때 사용합니다.
+type StatementKind string
+
+const (
+	StatementSelect StatementKind = "select" // SELECT, WITH (CTE 안에 쓰기가 없는 경우)
+	StatementInsert StatementKind = "insert"
+	StatementUpdate StatementKind = "update"
+	StatementDelete StatementKind = "delete"
+	StatementMerge  StatementKind = "merge"
+	StatementDDL    StatementKind = "ddl"   // CREATE, ALTER, DROP, TRUNCATE, COMMENT, RENAME
+	StatementOther  StatementKind = "other" // 그 외 (BEGIN, GRANT, CALL 등)
+)
+
+// ClassifyStatement는 SQL의 첫 키워드로 문장 종류를 판단합니다.
+// 주석과 괄호는 건너뜁니다.
+//
+// WITH 문장은 첫 키워드가 아니라 본문과 CTE 안의 문장으로 판단합니다.
+// "WITH d AS (DELETE ... RETURNING *) SELECT ..."는 SELECT처럼 보이지만 데이터를 지우기 때문!
+//
+// 세미콜론으로 이어진 여러 문장은 SELECT가 아닌 첫 문장의 종류입니다.
+// ("SELECT 1; DELETE FROM t"는 delete, Postgres는 바인드 값이 없으면 여러 문장을 모두 실행함)
+// 문자열, 따옴표 식별자, 주석 안의 세미콜론은 문장 구분자가 아닙니다.
+func ClassifyStatement(query string) StatementKind {
+	var kind StatementKind
+	for _, statement := range splitStatements(query) {
+		if kind == "" || kind == StatementSelect {
+			kind = classifySingle(statement)
+		}
+	}
+	if kind == "" {
+		return StatementOther
+	}
+	return kind
+}
+
+// classifySingle은 문장 하나의 종류를 판단합니다.
+func classifySingle(query string) StatementKind {
+	keyword := FirstKeyword(query)
+	if keyword == "WITH" {
+		return classifyWith(query)
+	}
+	return keywordKind(keyword)
+}
+
+// splitStatements는 SQL을 괄호 밖의 세미콜론으로 나눕니다. 주석만 있는 부분은 버립니다.
+func splitStatements(query string) []string {
+	var statements []string
+
+	start, depth, empty := 0, 0, true
+	for _, tok := range TokenizeSQL(query) {
+		switch {
+		case tok.IsComment():
+			continue
+		case tok.Is("("):
+			depth++
+		case tok.Is(")"):
+			depth--
+		case tok.Is(";") && depth <= 0:
+			if !empty {
+				statements = append(statements, query[start:tok.Start])
+			}
+			start, empty = tok.End, true
+			continue
+		}
+		empty = false
+	}
+	if !empty {
+		statements = append(statements, query[start:])
+	}
+	return statements
+}
+
+// keywordKind는 문장을 시작하는 키워드의 종류입니다.
+func keywordKind(keyword string) StatementKind {
+	switch keyword {
+	case "SELECT", "WITH", "VALUES", "TABLE":
+		return StatementSelect
+	case "INSERT":
+		return StatementInsert
+	case "UPDATE":
+		return StatementUpdate
+	case "DELETE":
+		return StatementDelete
+	case "MERGE":
+		return StatementMerge
+	case "CREATE", "ALTER", "DROP", "TRUNCATE", "COMMENT", "RENAME":
+		return StatementDDL
+	default:
+		return StatementOther
+	}
+}
+
+// classifyWith는 WITH 문장의 종류를 판단합니다.
+//
+//   - CTE 목록 뒤의 본문(괄호 밖의 첫 SELECT/INSERT/UPDATE/DELETE/MERGE)이 쓰기면 그 종류
+//   - 본문이 SELECT여도 CTE 안에 INSERT/UPDATE/DELETE/MERGE가 있으면 그 종류 (Postgres)
+//   - 둘 다 아니면 SELECT
+//
+// 문자열과 주석 안의 단어는 토크나이저가 걸러내고,
+// SELECT ... FOR UPDATE (FOR NO KEY UPDATE 포함)의 UPDATE는 쓰기로 보지 않습니다.
+func classifyWith(query string) StatementKind {
+	main := StatementKind("")
+	nested := StatementKind("")
+
+	depth, base := 0, -1
+	var prev SQLToken
+	for _, tok := range TokenizeSQL(query) {
+		if tok.IsComment() {
+			continue
+		}
+
+		switch {
+		case tok.Is("("):
+			depth++
+		case tok.Is(")"):
+			depth--
+		case tok.Kind == TokenWord:
+			word := strings.ToUpper(tok.Text)
+			if base < 0 {
+				// 첫 단어(WITH)의 괄호 깊이가 본문의 깊이입니다.
+				base = depth
+				break
+			}
+
+			kind := keywordKind(word)
+			switch kind {
+			case StatementSelect:
+				if depth == base && main == "" && word != "WITH" {
+					main = kind
+				}
+			case StatementInsert, StatementDelete, StatementMerge, StatementUpdate:
+				if kind == StatementUpdate && (prev.Is("FOR") || prev.Is("KEY")) {
+					break
+				}
+				if depth == base && main == "" {
+					main = kind
+				} else if nested == "" {
+					nested = kind
+				}
+			}
+		}
+		prev = tok
+	}
+
+	if main != "" && main != StatementSelect {
+		return main
+	}
+	if nested != "" {
+		return nested
+	}
+	return StatementSelect
+}
+
+// IsWrite는 데이터를 바꿀 수 있는 문장인지 확인합니다 (DML + DDL + 그 외).
+// CALL, DO, EXEC, BEGIN 블록은 안에서 무엇을 하는지 알 수 없으므로 쓰기로 봅니다.
+func (k StatementKind) IsWrite() bool {
+	switch k {
+	case StatementInsert, StatementUpdate, StatementDelete, StatementMerge, StatementDDL, StatementOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsExplainable은 실행 계획을 볼 수 있는 문장인지 확인합니다.
+func (k StatementKind) IsExplainable() bool {
+	switch k {
+	case StatementSelect, StatementInsert, StatementUpdate, StatementDelete, StatementMerge:
+		return true
+	default:
+		return false
+	}
+}
+
+// FirstKeyword는 주석과 여는 괄호를 건너뛴 첫 단어를 대문자로 반환합니다.
+func FirstKeyword(query string) string {
+	s := query
+	for {
+		s = strings.TrimLeftFunc(s, func(r rune) bool {
+			return unicode.IsSpace(r) || r == '('
+		})
+
+		switch {
+		case strings.HasPrefix(s, "--"):
+			// 한 줄 주석: 줄 끝까지 건너뜀
+			if i := strings.IndexByte(s, '\n'); i >= 0 {
+				s = s[i+1:]
+				continue
+			}
+			return ""
+
+		case strings.HasPrefix(s, "/*"):
+			// 블록 주석: */까지 건너뜀
+			if i := strings.Index(s, "*/"); i >= 0 {
+				s = s[i+2:]
+				continue
+			}
+			return ""
+		}
+
+		break
+	}
+
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '_'
+	})
+	if end < 0 {
+		end = len(s)
+	}
+
+	return strings.ToUpper(s[:end])
+}
@@ -0,0 +1,77 @@
+package domain
+
+import "testing"
+
+func TestClassifyStatement(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  StatementKind
+	}{
+		{"select", "SELECT * FROM users", StatementSelect},
+		{"lowercase select", "select 1", StatementSelect},
+		{"parenthesized select", "(SELECT 1) UNION (SELECT 2)", StatementSelect},
+		{"values", "VALUES (1), (2)", StatementSelect},
+		{"leading comments", "-- note\n/* block */ SELECT 1", StatementSelect},
+		{"insert", "INSERT INTO users (id) VALUES (1)", StatementInsert},
+		{"update", "UPDATE users SET name = 'a'", StatementUpdate},
+		{"delete", "DELETE FROM users", StatementDelete},
+		{"merge", "MERGE INTO users u USING src s ON (u.id = s.id)", StatementMerge},
+		{"create", "CREATE TABLE t (id int)", StatementDDL},
+		{"truncate", "TRUNCATE TABLE users", StatementDDL},
+		{"call", "CALL refresh_stats()", StatementOther},
+		{"do block", "DO $$ BEGIN DELETE FROM users; END $$", StatementOther},
+		{"grant", "GRANT SELECT ON users TO reader", StatementOther},
+		{"empty", "", StatementOther},
+		{"comment only", "-- nothing", StatementOther},
+
+		{"with select", "WITH a AS (SELECT 1) SELECT * FROM a", StatementSelect},
+		{"with delete in cte", "WITH d AS (DELETE FROM users RETURNING *) SELECT * FROM d", StatementDelete},
+		{"with main insert", "WITH a AS (SELECT 1 AS id) INSERT INTO users SELECT id FROM a", StatementInsert},
+		{"with for update", "WITH a AS (SELECT * FROM users FOR UPDATE) SELECT * FROM a", StatementSelect},
+		{"with for no key update", "WITH a AS (SELECT * FROM users FOR NO KEY UPDATE) SELECT * FROM a", StatementSelect},
+		{"with keyword in string", "WITH a AS (SELECT 'delete' AS s) SELECT * FROM a", StatementSelect},
+		{"with keyword in comment", "WITH a AS (SELECT 1 /* update */) SELECT * FROM a", StatementSelect},
+		{"with keyword in quoted identifier", `WITH a AS (SELECT 1 AS "insert") SELECT * FROM a`, StatementSelect},
+		{"with oracle q-quote", "WITH a AS (SELECT q'[it's delete]' AS s FROM dual) SELECT * FROM a", StatementSelect},
+		{"with postgres escape string", `WITH a AS (SELECT E'it\'s delete' AS s) SELECT * FROM a`, StatementSelect},
+
+		{"trailing semicolon", "SELECT 1;", StatementSelect},
+		{"two selects", "SELECT 1; SELECT 2", StatementSelect},
+		{"select then delete", "SELECT 1; DELETE FROM users", StatementDelete},
+		{"select then drop", "SELECT 1;\n-- cleanup\nDROP TABLE users;", StatementDDL},
+		{"semicolon in string", "SELECT ';DELETE FROM users'", StatementSelect},
+		{"semicolon in q-quote", "SELECT q'{;DELETE FROM users}' FROM dual", StatementSelect},
+		{"semicolon in escape string", `SELECT E'\';DELETE FROM users'`, StatementSelect},
+		{"semicolon in comment", "SELECT 1 /* ; DELETE FROM users */", StatementSelect},
+		{"semicolon in dollar quote", "SELECT $$;DELETE FROM users$$", StatementSelect},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyStatement(tt.query); got != tt.want {
+				t.Errorf("ClassifyStatement(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstKeyword(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"select 1", "SELECT"},
+		{"  ((SELECT 1))", "SELECT"},
+		{"-- c\nINSERT INTO t VALUES (1)", "INSERT"},
+		{"/* c */ with a as (select 1) select 1", "WITH"},
+		{"/* unterminated", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := FirstKeyword(tt.query); got != tt.want {
+			t.Errorf("FirstKeyword(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
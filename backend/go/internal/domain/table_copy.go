@@ -0,0 +1,397 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 테이블 복사 작업 관련 에러
+var (
+	ErrInvalidCopy       = errors.New("invalid copy job")
+	ErrCopyJobNotFound   = errors.New("copy job not found")
+	ErrCopyJobRunning    = errors.New("copy job is running")
+	ErrCopyCountMismatch = errors.New("row count mismatch")
+)
+
+// ChangeTableCopy는 테이블 복사 작업의 변경 이력입니다 (대상 DB에 남김, After는 건수 요약).
+const ChangeTableCopy ChangeKind = "table_copy"
+
+// CopyStatus는 복사 작업의 상태입니다.
+type CopyStatus string
+
+const (
+	CopyRunning   CopyStatus = "running"
+	CopyCompleted CopyStatus = "completed" // 복사하고 row 수까지 맞음
+	CopyFailed    CopyStatus = "failed"    // Error에 이유, Resume으로 이어서 할 수 있음
+)
+
+// CopyPhase는 복사 작업의 단계입니다. 작업은 이 순서대로 진행하고,
+// 단계가 끝날 때마다 저장하므로 다시 시작하면 마지막 단계부터 이어서 합니다.
+type CopyPhase string
+
+const (
+	CopyPhaseCreate CopyPhase = "create" // 대상 테이블 만들기 (CREATE TABLE + 기본 키/제약조건)
+	CopyPhaseCopy   CopyPhase = "copy"   // 데이터를 묶음 단위로 복사
+	CopyPhaseFinish CopyPhase = "finish" // 인덱스, 주석, 자동 증가 값 맞추기 (만든 테이블만)
+	CopyPhaseVerify CopyPhase = "verify" // 원본과 대상의 row 수 비교
+	CopyPhaseDone   CopyPhase = "done"
+)
+
+// CopyRequest는 등록된 DB 사이에서 테이블 하나를 복사하는 요청입니다.
+//
+// 대상 테이블이 없으면 원본 구조를 읽어서 대상 방언으로 만들고 (ConvertColumnType),
+// 원본을 키 순서로 BatchSize개씩 읽어서 대상에 넣습니다.
+// 묶음마다 커밋하고 마지막으로 넣은 키를 저장하므로, 실패하면 그 다음부터 이어서 복사합니다.
+type CopyRequest struct {
+	SourceDatabaseID string
+	SourceSchema     string // 비어 있으면 DB 기본 스키마
+	SourceTable      string
+
+	TargetDatabaseID string
+	TargetSchema     string // 비어 있으면 DB 기본 스키마
+	TargetTable      string // 비어 있으면 원본과 같은 이름
+
+	// Truncate면 대상 테이블이 이미 있을 때 첫 묶음을 넣기 전에 비웁니다.
+	// 데이터가 있는 대상 테이블에 Truncate 없이 복사하면 row 수가 맞지 않으므로 거부합니다.
+	Truncate bool
+
+	BatchSize int // 한 번에 읽고 넣을 row 수 (기본값 1000, 최대 10000)
+
+	// KeyColumns는 원본을 읽는 순서로 쓸 키입니다 (비어 있으면 기본 키).
+	// 기본 키가 없는 테이블은 NOT NULL 유니크 제약조건의 컬럼을 지정해야 복사할 수 있습니다.
+	KeyColumns []string
+
+	// Author는 작업을 시작한 사람입니다 (대상 DB 쓰기 권한 검사와 변경 이력에 씀).
+	Author string
+}
+
+// Validate는 요청을 검증하고 기본값을 채웁니다.
+func (r *CopyRequest) Validate() error {
+	if strings.TrimSpace(r.SourceDatabaseID) == "" || strings.TrimSpace(r.TargetDatabaseID) == "" {
+		return fmt.Errorf("%w: source and target databases are required", ErrInvalidCopy)
+	}
+	if strings.TrimSpace(r.SourceTable) == "" {
+		return fmt.Errorf("%w: source table is required", ErrInvalidCopy)
+	}
+
+	if r.BatchSize <= 0 {
+		r.BatchSize = DefaultImportBatchSize
+	}
+	if r.BatchSize > MaxImportBatchSize {
+		return fmt.Errorf("%w: batch size must be at most %d", ErrInvalidCopy, MaxImportBatchSize)
+	}
+
+	return nil
+}
+
+// CopyEndpoint는 복사의 한쪽(DB + 테이블)입니다. Schema와 Table은 카탈로그 이름입니다.
+type CopyEndpoint struct {
+	DatabaseID string
+	Type       DatabaseType
+	Schema     string // 비어 있으면 세션의 현재 스키마
+	Table      string
+}
+
+// CopyJob은 테이블 복사 작업입니다.
+// 진행 상황(Copied, Checkpoint)은 묶음을 커밋할 때마다 저장소에 저장합니다.
+type CopyJob struct {
+	ID string
+
+	Source    CopyEndpoint
+	Target    CopyEndpoint
+	Truncate  bool
+	BatchSize int
+	Author    string
+
+	Status CopyStatus
+	Phase  CopyPhase
+	Error  string // 마지막 실패 이유
+
+	// SourceColumns는 복사할 원본 컬럼입니다 (카탈로그 이름, 이 순서로 읽고 넣음).
+	SourceColumns []ColumnInfo
+
+	// Key는 원본을 읽는 순서이자 이어서 복사할 위치를 정하는 컬럼입니다
+	// (기본 키, 없으면 요청에서 지정한 유니크 키).
+	Key []ColumnInfo
+
+	// Created면 작업이 대상 테이블을 만든 것입니다 (인덱스/주석도 작업이 만듦).
+	Created bool
+
+	// CreateStatement와 FinishStatements는 대상 테이블을 만드는 DDL입니다.
+	// 인덱스는 데이터를 넣은 뒤에 만들어야 빠르므로 FinishStatements로 나눕니다.
+	CreateStatement  string
+	FinishStatements []string
+	Finished         int // 실행을 마친 FinishStatements 수
+
+	Warnings []string // 타입 변환 등에서 정확히 옮기지 못한 부분
+
+	LoadStatement string // 대상에 넣는 SQL (COPY, INSERT 등)
+
+	SourceRows int64 // 시작할 때 센 원본 row 수 (진행률 계산용)
+	Copied     int64 // 대상에 커밋한 row 수
+	Batches    int64 // 커밋한 묶음 수
+	Resumes    int   // 이어서 실행한 횟수
+
+	// Checkpoint는 마지막으로 커밋한 row의 Key 값입니다 (문자열로 저장, 비어 있으면 처음부터).
+	Checkpoint []string
+
+	Verification *CopyVerification
+
+	ChangeLogID string
+
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt time.Time // 끝나지 않았으면 0
+}
+
+// CopyVerification은 복사가 끝난 뒤 원본과 대상의 row 수를 비교한 결과입니다.
+type CopyVerification struct {
+	SourceRows int64
+	TargetRows int64
+	Matched    bool
+	VerifiedAt time.Time
+}
+
+// Clone은 슬라이스와 검증 결과까지 복사한 작업을 반환합니다.
+// 백그라운드에서 실행하는 작업과 응답으로 직렬화하는 작업이 메모리를 공유하지 않게 할 때 씁니다.
+func (j *CopyJob) Clone() *CopyJob {
+	clone := *j
+	clone.SourceColumns = append([]ColumnInfo(nil), j.SourceColumns...)
+	clone.Key = append([]ColumnInfo(nil), j.Key...)
+	clone.FinishStatements = append([]string(nil), j.FinishStatements...)
+	clone.Warnings = append([]string(nil), j.Warnings...)
+	clone.Checkpoint = append([]string(nil), j.Checkpoint...)
+	if j.Verification != nil {
+		verification := *j.Verification
+		clone.Verification = &verification
+	}
+	return &clone
+}
+
+// Progress는 진행률(0~100)입니다. 원본 row 수를 모르면 0입니다.
+// 복사 중에 원본에 row가 추가되면 100을 넘을 수 있으므로 100에서 자릅니다.
+func (j *CopyJob) Progress() float64 {
+	if j.Status == CopyCompleted {
+		return 100
+	}
+	if j.SourceRows <= 0 {
+		return 0
+	}
+
+	percent := float64(j.Copied) * 100 / float64(j.SourceRows)
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// CopyTableDDL은 원본 테이블 정보로 대상 방언의 DDL을 만듭니다 (GenerateTableDDL과 같은 규칙).
+//
+// 반환값:
+//   - create: CREATE TABLE (컬럼 + 기본 키/유니크/체크 제약조건)
+//   - finish: 데이터를 넣은 뒤 실행할 인덱스와 주석
+//   - warnings: 변환 경고
+//
+// 외래 키는 참조하는 테이블이 아직 복사되지 않았을 수 있으므로 만들지 않고 경고로 남깁니다.
+// GENERATED ALWAYS 자동 증가 컬럼은 원본 값을 그대로 넣을 수 있도록 BY DEFAULT로 만듭니다.
+// 문장 끝의 세미콜론은 붙이지 않습니다 (Oracle 드라이버는 세미콜론이 있으면 실패).
+func CopyTableDDL(def TableDefinition, target SQLDialect) (string, []string, []string) {
+	g := &ddlGenerator{source: def.Source, target: target}
+
+	columns := make([]ColumnInfo, len(def.Columns))
+	copy(columns, def.Columns)
+	for i, col := range columns {
+		if col.Identity != nil && col.Identity.Generation == IdentityAlways {
+			columns[i].Identity = &ColumnIdentity{Generation: IdentityByDefault, Sequence: col.Identity.Sequence}
+			g.warn("column %s: GENERATED ALWAYS created as GENERATED BY DEFAULT so that source values can be copied", col.Name)
+		}
+	}
+	def.Columns = columns
+
+	statements := g.tableStatements(def)
+
+	for _, fk := range def.Metadata.ForeignKeys {
+		g.warn("foreign key %s was not created; add it after %s is copied", fk.Name, fk.RefTable)
+	}
+
+	var finish []string
+	for _, stmt := range append(statements.indexes, statements.comments...) {
+		finish = append(finish, strings.TrimSuffix(stmt, ";"))
+	}
+
+	return strings.TrimSuffix(statements.create, ";"), finish, g.warnings
+}
+
+// IdentityRestartStatement는 복사한 뒤 자동 증가 컬럼이 다음에 만들 값을 MAX+1로 맞추는 문장입니다.
+// 원본 값을 그대로 넣었으므로, 그대로 두면 새 row가 이미 있는 값과 겹칩니다.
+//
+//	Postgres: SELECT setval(pg_get_serial_sequence('public.users', 'id'), COALESCE(MAX(id), 0) + 1, false) FROM public.users
+//	Oracle:   ALTER TABLE hr.students MODIFY (student_no GENERATED BY DEFAULT AS IDENTITY (START WITH LIMIT VALUE))
+func IdentityRestartStatement(dbType DatabaseType, schema, table string, col ColumnInfo) string {
+	target := rowTable(dbType, schema, table)
+	name := QuoteCatalogIdentifier(dbType, col.Name)
+
+	if DialectOf(dbType) == DialectOracle {
+		return fmt.Sprintf("ALTER TABLE %s MODIFY (%s GENERATED BY DEFAULT AS IDENTITY (START WITH LIMIT VALUE))", target, name)
+	}
+	return fmt.Sprintf("SELECT setval(pg_get_serial_sequence(%s, %s), COALESCE(MAX(%s), 0) + 1, false) FROM %s",
+		QuoteLiteral(target), QuoteLiteral(col.Name), name, target)
+}
+
+// CopyPageQuery는 원본에서 after 다음 row를 limit개 읽는 SQL과 바인드 값을 만듭니다.
+// OFFSET 대신 마지막 키보다 큰 row를 읽으므로 (keyset), 뒤쪽 페이지도 빠르고
+// 작업을 다시 시작해도 같은 위치부터 읽습니다.
+//
+//	Postgres:   SELECT ... FROM t WHERE (a, b) > ($1, $2) ORDER BY a, b LIMIT 1000
+//	Oracle 19c: SELECT ... FROM t WHERE (a > :1 OR (a = :2 AND b > :3)) ORDER BY a, b FETCH FIRST 1000 ROWS ONLY
+//	Oracle 11g: SELECT * FROM (SELECT ... ORDER BY a, b) WHERE ROWNUM <= 1000
+//
+// key는 유니크하고 NOT NULL이어야 합니다. row 위치(ROWID, ctid)는 UPDATE, VACUUM FULL,
+// 테이블 이동 등으로 바뀌어서 이어서 복사할 때 row를 건너뛰거나 두 번 넣을 수 있으므로 쓰지 않습니다.
+func CopyPageQuery(dbType DatabaseType, schema, table string, columns, key []ColumnInfo, after []interface{}, limit int) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+	if len(key) == 0 {
+		return "", nil, fmt.Errorf("%w: a key is required to read the source in order", ErrInvalidCopy)
+	}
+	if len(after) > 0 && len(after) != len(key) {
+		return "", nil, fmt.Errorf("%w: checkpoint has %d values for %d key columns", ErrInvalidCopy, len(after), len(key))
+	}
+
+	names := make([]string, len(key))
+	for i, k := range key {
+		names[i] = QuoteCatalogIdentifier(dbType, k.Name)
+	}
+
+	query := "SELECT " + columnList(dbType, columns) + " FROM " + rowTable(dbType, schema, table)
+	if len(after) > 0 {
+		query += " WHERE " + keysetCondition(b, names, after)
+	}
+	query += " ORDER BY " + strings.Join(names, ", ")
+
+	switch dbType {
+	case Oracle11g:
+		query = fmt.Sprintf("SELECT * FROM (%s) WHERE ROWNUM <= %d", query, limit)
+	case Oracle19c:
+		query += fmt.Sprintf(" FETCH FIRST %d ROWS ONLY", limit)
+	default:
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	return query, b.args, nil
+}
+
+// keysetCondition은 "(키 컬럼들) > (after 값들)" 조건을 만듭니다.
+// Postgres는 row 비교를 지원하고 인덱스도 타지만, Oracle은 지원하지 않으므로 풀어서 씁니다.
+func keysetCondition(b *binder, names []string, after []interface{}) string {
+	if b.dialect == DialectPostgres {
+		binds := make([]string, len(after))
+		for i, v := range after {
+			binds[i] = b.bind(v)
+		}
+		if len(names) == 1 {
+			return names[0] + " > " + binds[0]
+		}
+		return "(" + strings.Join(names, ", ") + ") > (" + strings.Join(binds, ", ") + ")"
+	}
+
+	// (a > :1) OR (a = :2 AND b > :3) OR ...
+	ors := make([]string, len(names))
+	for i := range names {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, names[j]+" = "+b.bind(after[j]))
+		}
+		ands = append(ands, names[i]+" > "+b.bind(after[i]))
+		ors[i] = "(" + strings.Join(ands, " AND ") + ")"
+	}
+	if len(ors) == 1 {
+		return ors[0]
+	}
+	return "(" + strings.Join(ors, " OR ") + ")"
+}
+
+// CopyCheckpoint는 페이지의 row에서 Key 값을 꺼내서 저장할 문자열로 바꿉니다.
+// 시각은 RFC3339Nano로 저장하므로 CopyKeyArgs에서 같은 값으로 되돌릴 수 있습니다.
+func CopyCheckpoint(row map[string]interface{}, key []ColumnInfo) []string {
+	checkpoint := make([]string, len(key))
+	for i, k := range key {
+		checkpoint[i] = copyString(profileValue(row, k.Name))
+	}
+	return checkpoint
+}
+
+// CopyKeyArgs는 저장한 Checkpoint를 원본 키 컬럼 타입의 바인드 값으로 되돌립니다.
+func CopyKeyArgs(dbType DatabaseType, key []ColumnInfo, checkpoint []string) ([]interface{}, error) {
+	if len(checkpoint) == 0 {
+		return nil, nil
+	}
+	if len(checkpoint) != len(key) {
+		return nil, fmt.Errorf("%w: checkpoint has %d values for %d key columns", ErrInvalidCopy, len(checkpoint), len(key))
+	}
+
+	args := make([]interface{}, len(key))
+	for i, k := range key {
+		value, err := CoerceValue(dbType, k, checkpoint[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: checkpoint: %v", ErrInvalidCopy, err)
+		}
+		args[i] = value
+	}
+	return args, nil
+}
+
+// CopyRowValues는 원본 row에서 columns 순서대로 값을 꺼내서 대상 컬럼 타입에 맞게 바꿉니다.
+//
+// 드라이버가 []byte로 돌려준 값(lib/pq의 numeric 등)은 대상이 바이너리 컬럼일 때만 그대로 두고
+// 나머지는 문자열로 바꾼 뒤 CoerceValue로 대상 타입에 맞춥니다.
+func CopyRowValues(dbType DatabaseType, row map[string]interface{}, columns, targets []ColumnInfo) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i, col := range columns {
+		value := profileValue(row, col.Name)
+		if b, ok := value.([]byte); ok && !isBinaryType(targets[i].BaseType) {
+			value = string(b)
+		}
+
+		coerced, err := CoerceValue(dbType, targets[i], value)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = coerced
+	}
+	return values, nil
+}
+
+// isBinaryType은 바이트를 그대로 저장하는 타입인지 확인합니다.
+func isBinaryType(baseType string) bool {
+	switch strings.ToUpper(baseType) {
+	case "BYTEA", "BLOB", "RAW", "LONG RAW":
+		return true
+	}
+	return false
+}
+
+// copyString은 키 값을 Checkpoint에 저장할 문자열로 바꿉니다.
+func copyString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCopyPageQuery(t *testing.T) {
+	id := ColumnInfo{Name: "id", BaseType: "integer"}
+	name := ColumnInfo{Name: "name", BaseType: "text"}
+	dept := ColumnInfo{Name: "dept_no", BaseType: "integer"}
+	seq := ColumnInfo{Name: "seq", BaseType: "integer"}
+
+	oraID := ColumnInfo{Name: "ID", BaseType: "NUMBER"}
+	oraName := ColumnInfo{Name: "NAME", BaseType: "VARCHAR2"}
+	oraDept := ColumnInfo{Name: "DEPT_NO", BaseType: "NUMBER"}
+	oraSeq := ColumnInfo{Name: "SEQ", BaseType: "NUMBER"}
+
+	tests := []struct {
+		name     string
+		dbType   DatabaseType
+		schema   string
+		columns  []ColumnInfo
+		key      []ColumnInfo
+		after    []interface{}
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "postgres first page",
+			dbType:  PostgreSQL,
+			schema:  "public",
+			columns: []ColumnInfo{id, name},
+			key:     []ColumnInfo{id},
+			want:    "SELECT id, name FROM public.users ORDER BY id LIMIT 100",
+		},
+		{
+			name:     "postgres single key",
+			dbType:   PostgreSQL,
+			schema:   "public",
+			columns:  []ColumnInfo{id, name},
+			key:      []ColumnInfo{id},
+			after:    []interface{}{int64(42)},
+			want:     "SELECT id, name FROM public.users WHERE id > $1 ORDER BY id LIMIT 100",
+			wantArgs: []interface{}{int64(42)},
+		},
+		{
+			name:     "postgres composite key uses a row comparison",
+			dbType:   PostgreSQL,
+			columns:  []ColumnInfo{dept, seq, name},
+			key:      []ColumnInfo{dept, seq},
+			after:    []interface{}{int64(10), int64(3)},
+			want:     "SELECT dept_no, seq, name FROM users WHERE (dept_no, seq) > ($1, $2) ORDER BY dept_no, seq LIMIT 100",
+			wantArgs: []interface{}{int64(10), int64(3)},
+		},
+		{
+			name:    "oracle 19c first page",
+			dbType:  Oracle19c,
+			schema:  "HR",
+			columns: []ColumnInfo{oraID, oraName},
+			key:     []ColumnInfo{oraID},
+			want:    "SELECT id, name FROM hr.users ORDER BY id FETCH FIRST 100 ROWS ONLY",
+		},
+		{
+			name:     "oracle 19c composite key expands the comparison",
+			dbType:   Oracle19c,
+			schema:   "HR",
+			columns:  []ColumnInfo{oraDept, oraSeq, oraName},
+			key:      []ColumnInfo{oraDept, oraSeq},
+			after:    []interface{}{int64(10), int64(3)},
+			want:     "SELECT dept_no, seq, name FROM hr.users WHERE ((dept_no > :1) OR (dept_no = :2 AND seq > :3)) ORDER BY dept_no, seq FETCH FIRST 100 ROWS ONLY",
+			wantArgs: []interface{}{int64(10), int64(10), int64(3)},
+		},
+		{
+			name:     "oracle 11g uses ROWNUM outside the ordered query",
+			dbType:   Oracle11g,
+			columns:  []ColumnInfo{oraID, oraName},
+			key:      []ColumnInfo{oraID},
+			after:    []interface{}{int64(42)},
+			want:     "SELECT * FROM (SELECT id, name FROM users WHERE (id > :1) ORDER BY id) WHERE ROWNUM <= 100",
+			wantArgs: []interface{}{int64(42)},
+		},
+		{
+			name:     "quoted key names",
+			dbType:   PostgreSQL,
+			columns:  []ColumnInfo{{Name: "Order", BaseType: "integer"}},
+			key:      []ColumnInfo{{Name: "Order", BaseType: "integer"}},
+			after:    []interface{}{int64(1)},
+			want:     `SELECT "Order" FROM users WHERE "Order" > $1 ORDER BY "Order" LIMIT 100`,
+			wantArgs: []interface{}{int64(1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := "users"
+			if DialectOf(tt.dbType) == DialectOracle {
+				table = "USERS"
+			}
+
+			got, args, err := CopyPageQuery(tt.dbType, tt.schema, table, tt.columns, tt.key, tt.after, 100)
+			if err != nil {
+				t.Fatalf("CopyPageQuery() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CopyPageQuery() query =\n%s\nwant\n%s", got, tt.want)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("CopyPageQuery() args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestCopyPageQueryErrors(t *testing.T) {
+	id := ColumnInfo{Name: "id", BaseType: "integer"}
+
+	tests := []struct {
+		name    string
+		dbType  DatabaseType
+		key     []ColumnInfo
+		after   []interface{}
+		wantErr error
+	}{
+		{"no key", PostgreSQL, nil, nil, ErrInvalidCopy},
+		{"checkpoint size mismatch", PostgreSQL, []ColumnInfo{id}, []interface{}{int64(1), int64(2)}, ErrInvalidCopy},
+		{"unsupported database", MariaDB, []ColumnInfo{id}, nil, ErrUnsupportedDialect},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := CopyPageQuery(tt.dbType, "", "users", []ColumnInfo{id}, tt.key, tt.after, 100)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CopyPageQuery() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeysetCondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect SQLDialect
+		names   []string
+		after   []interface{}
+		want    string
+		args    int
+	}{
+		{"postgres single", DialectPostgres, []string{"a"}, []interface{}{1}, "a > $1", 1},
+		{"postgres three columns", DialectPostgres, []string{"a", "b", "c"}, []interface{}{1, 2, 3}, "(a, b, c) > ($1, $2, $3)", 3},
+		{"oracle single", DialectOracle, []string{"a"}, []interface{}{1}, "(a > :1)", 1},
+		{"oracle three columns", DialectOracle, []string{"a", "b", "c"}, []interface{}{1, 2, 3},
+			"((a > :1) OR (a = :2 AND b > :3) OR (a = :4 AND b = :5 AND c > :6))", 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &binder{dialect: tt.dialect}
+			if got := keysetCondition(b, tt.names, tt.after); got != tt.want {
+				t.Errorf("keysetCondition() = %q, want %q", got, tt.want)
+			}
+			if len(b.args) != tt.args {
+				t.Errorf("keysetCondition() bound %d values, want %d", len(b.args), tt.args)
+			}
+		})
+	}
+}
+
+func TestHasUniqueConstraint(t *testing.T) {
+	metadata := &TableMetadata{
+		Constraints: []ConstraintInfo{
+			{Name: "users_pk", Type: ConstraintPrimaryKey, Columns: []string{"id"}},
+			{Name: "users_dept_seq_uk", Type: ConstraintUnique, Columns: []string{"dept_no", "seq"}},
+			{Name: "users_age_ck", Type: ConstraintCheck, Columns: []string{"age"}, Expression: "age > 0"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		columns []string
+		want    bool
+	}{
+		{"primary key", []string{"id"}, true},
+		{"primary key in another case", []string{"ID"}, true},
+		{"composite unique key", []string{"dept_no", "seq"}, true},
+		{"composite unique key in another order", []string{"SEQ", "DEPT_NO"}, true},
+		{"part of a composite key", []string{"dept_no"}, false},
+		{"superset of a key", []string{"id", "dept_no"}, false},
+		{"check constraint", []string{"age"}, false},
+		{"no constraint", []string{"name"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := metadata.HasUniqueConstraint(tt.columns); got != tt.want {
+				t.Errorf("HasUniqueConstraint(%v) = %v, want %v", tt.columns, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyKeyArgs(t *testing.T) {
+	key := []ColumnInfo{
+		{Name: "dept_no", BaseType: "integer"},
+		{Name: "code", BaseType: "character varying"},
+	}
+
+	args, err := CopyKeyArgs(PostgreSQL, key, CopyCheckpoint(map[string]interface{}{"dept_no": int64(10), "code": "A-1"}, key))
+	if err != nil {
+		t.Fatalf("CopyKeyArgs() error = %v", err)
+	}
+	if want := []interface{}{int64(10), "A-1"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("CopyKeyArgs() = %v, want %v", args, want)
+	}
+
+	if _, err := CopyKeyArgs(PostgreSQL, key, []string{"10"}); !errors.Is(err, ErrInvalidCopy) {
+		t.Errorf("CopyKeyArgs() error = %v, want %v", err, ErrInvalidCopy)
+	}
+}
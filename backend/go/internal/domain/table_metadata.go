@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// TableMetadata는 테이블의 인덱스, 제약조건, 외래 키 정보입니다.
+type TableMetadata struct {
+	Schema string
+	Table  string
+
+	Owner   string // 소유자 (Postgres: 소유 역할, Oracle: 스키마와 같음)
+	Comment string // 테이블 주석
+
+	Indexes     []IndexInfo
+	Constraints []ConstraintInfo // 기본 키, 유니크, 체크 (외래 키는 아래에 따로)
+
+	ForeignKeys  []ForeignKeyInfo // 이 테이블이 다른 테이블을 참조 (outgoing)
+	ReferencedBy []ForeignKeyInfo // 다른 테이블이 이 테이블을 참조 (incoming)
+}
+
+// IndexInfo는 인덱스 하나입니다.
+type IndexInfo struct {
+	Name string
+
+	// Columns는 인덱스 키 순서대로의 컬럼입니다.
+	// 함수 기반 인덱스는 컬럼 대신 식이 들어갑니다 (예: "lower(email)").
+	Columns []string
+
+	Unique  bool
+	Primary bool   // 기본 키 인덱스인지
+	Type    string // DB 원본 인덱스 종류 (Postgres: btree, gin / Oracle: NORMAL, BITMAP)
+
+	// Predicate는 부분 인덱스 조건입니다 (Postgres WHERE 절, 없으면 빈 문자열).
+	Predicate string
+
+	// Definition은 DB가 돌려준 CREATE INDEX 문입니다 (Postgres pg_get_indexdef, Oracle은 빈 문자열).
+	// 같은 DB 종류로 DDL을 만들 때는 INCLUDE 절 같은 세부 사항까지 그대로 살리기 위해 이 값을 씁니다.
+	Definition string
+}
+
+// ConstraintType은 제약조건 종류입니다.
+type ConstraintType string
+
+const (
+	ConstraintPrimaryKey ConstraintType = "primary_key"
+	ConstraintUnique     ConstraintType = "unique"
+	ConstraintCheck      ConstraintType = "check"
+)
+
+// ConstraintInfo는 기본 키, 유니크, 체크 제약조건 하나입니다.
+type ConstraintInfo struct {
+	Name       string
+	Type       ConstraintType
+	Columns    []string
+	Expression string // 체크 조건 (체크 제약조건만)
+	Deferrable bool
+}
+
+// ForeignKeyInfo는 외래 키 하나입니다.
+// 방향과 상관없이 "참조하는 쪽(Table) → 참조되는 쪽(RefTable)"으로 표현합니다.
+type ForeignKeyInfo struct {
+	Name string
+
+	Schema  string
+	Table   string
+	Columns []string
+
+	RefSchema  string
+	RefTable   string
+	RefColumns []string // Columns와 같은 순서
+
+	// 참조되는 row가 지워지거나 바뀔 때의 동작
+	// (NO ACTION, RESTRICT, CASCADE, SET NULL, SET DEFAULT)
+	// Oracle은 ON UPDATE를 지원하지 않으므로 OnUpdate가 비어 있습니다.
+	OnDelete string
+	OnUpdate string
+}
+
+// Clone은 슬라이스까지 복사한 사본을 반환합니다.
+// 캐시에 보관한 원본은 여러 요청이 공유하므로, 이름을 정규화하기 전에 복사합니다.
+func (m *TableMetadata) Clone() *TableMetadata {
+	clone := *m
+
+	if m.Indexes != nil {
+		clone.Indexes = make([]IndexInfo, len(m.Indexes))
+		for i, index := range m.Indexes {
+			index.Columns = append([]string(nil), index.Columns...)
+			clone.Indexes[i] = index
+		}
+	}
+
+	if m.Constraints != nil {
+		clone.Constraints = make([]ConstraintInfo, len(m.Constraints))
+		for i, constraint := range m.Constraints {
+			constraint.Columns = append([]string(nil), constraint.Columns...)
+			clone.Constraints[i] = constraint
+		}
+	}
+
+	clone.ForeignKeys = cloneForeignKeys(m.ForeignKeys)
+	clone.ReferencedBy = cloneForeignKeys(m.ReferencedBy)
+
+	return &clone
+}
+
+func cloneForeignKeys(fks []ForeignKeyInfo) []ForeignKeyInfo {
+	if fks == nil {
+		return nil
+	}
+	clone := make([]ForeignKeyInfo, len(fks))
+	for i, fk := range fks {
+		fk.Columns = append([]string(nil), fk.Columns...)
+		fk.RefColumns = append([]string(nil), fk.RefColumns...)
+		clone[i] = fk
+	}
+	return clone
+}
+
+// HasUniqueConstraint는 columns와 같은 컬럼(순서 무관, 대소문자 무시)의
+// 기본 키나 유니크 제약조건이 있는지 확인합니다.
+func (m *TableMetadata) HasUniqueConstraint(columns []string) bool {
+	for _, c := range m.Constraints {
+		if c.Type != ConstraintPrimaryKey && c.Type != ConstraintUnique {
+			continue
+		}
+		if len(c.Columns) != len(columns) {
+			continue
+		}
+
+		matched := true
+		for _, name := range columns {
+			if !slices.ContainsFunc(c.Columns, func(col string) bool { return strings.EqualFold(col, name) }) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,510 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 테이블 데이터 조회 관련 에러
+var (
+	ErrInvalidRowQuery = errors.New("invalid row query")
+	ErrInvalidValue    = errors.New("invalid column value")
+)
+
+// 데이터 조회 페이지 크기
+const (
+	DefaultRowLimit = 100
+	MaxRowLimit     = 1000
+
+	// maxInValues는 IN 목록의 최대 값 개수입니다 (Oracle의 IN 목록 제한 1000개).
+	maxInValues = 1000
+)
+
+// FilterOperator는 컬럼 필터의 비교 연산자입니다.
+type FilterOperator string
+
+const (
+	FilterEq      FilterOperator = "eq"       // =
+	FilterNe      FilterOperator = "ne"       // <> (NULL인 row는 제외됨)
+	FilterLt      FilterOperator = "lt"       // <
+	FilterLe      FilterOperator = "le"       // <=
+	FilterGt      FilterOperator = "gt"       // >
+	FilterGe      FilterOperator = "ge"       // >=
+	FilterIn      FilterOperator = "in"       // IN (...)
+	FilterNotIn   FilterOperator = "not_in"   // NOT IN (...)
+	FilterLike    FilterOperator = "like"     // LIKE (%, _ 사용)
+	FilterNotLike FilterOperator = "not_like" // NOT LIKE
+	FilterILike   FilterOperator = "ilike"    // 대소문자 무시 LIKE (LOWER(c) LIKE LOWER(v))
+	FilterIsNull  FilterOperator = "is_null"  // IS NULL (값 없음)
+	FilterNotNull FilterOperator = "not_null" // IS NOT NULL (값 없음)
+)
+
+// filterComparisons는 값 하나와 비교하는 연산자의 SQL 연산자입니다.
+var filterComparisons = map[FilterOperator]string{
+	FilterEq: "=",
+	FilterNe: "<>",
+	FilterLt: "<",
+	FilterLe: "<=",
+	FilterGt: ">",
+	FilterGe: ">=",
+}
+
+// IsValid는 지원하는 연산자인지 확인합니다.
+func (op FilterOperator) IsValid() bool {
+	if _, ok := filterComparisons[op]; ok {
+		return true
+	}
+	switch op {
+	case FilterIn, FilterNotIn, FilterLike, FilterNotLike, FilterILike, FilterIsNull, FilterNotNull:
+		return true
+	}
+	return false
+}
+
+// RowFilter는 컬럼 필터 하나입니다. 여러 필터는 AND로 묶습니다.
+type RowFilter struct {
+	Column   string
+	Operator FilterOperator
+	Values   []string // is_null/not_null은 없음, in/not_in은 여러 개, 그 외는 하나
+}
+
+// ParseRowFilter는 "컬럼:연산자[:값]" 형태의 필터를 해석합니다.
+//
+//	status:eq:active
+//	created_at:ge:2024-01-01
+//	dept_no:in:10,20,30
+//	name:ilike:%kim%
+//	deleted_at:is_null
+//
+// 값은 두 번째 콜론 뒤 전체이므로 시각(12:30:00)처럼 콜론이 있어도 됩니다.
+// in/not_in의 값은 쉼표로 나눕니다.
+func ParseRowFilter(s string) (RowFilter, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) < 2 || strings.TrimSpace(parts[0]) == "" {
+		return RowFilter{}, fmt.Errorf("%w: filter %q must be column:operator[:value]", ErrInvalidRowQuery, s)
+	}
+
+	filter := RowFilter{
+		Column:   strings.TrimSpace(parts[0]),
+		Operator: FilterOperator(strings.ToLower(strings.TrimSpace(parts[1]))),
+	}
+	if len(parts) == 3 {
+		if filter.Operator == FilterIn || filter.Operator == FilterNotIn {
+			filter.Values = strings.Split(parts[2], ",")
+		} else {
+			filter.Values = []string{parts[2]}
+		}
+	}
+
+	return filter, filter.Validate()
+}
+
+// Validate는 필터의 연산자와 값 개수를 검증합니다.
+func (f RowFilter) Validate() error {
+	if !f.Operator.IsValid() {
+		return fmt.Errorf("%w: unsupported operator %q (use eq, ne, lt, le, gt, ge, in, not_in, like, not_like, ilike, is_null, not_null)", ErrInvalidRowQuery, f.Operator)
+	}
+
+	switch f.Operator {
+	case FilterIsNull, FilterNotNull:
+		if len(f.Values) > 0 {
+			return fmt.Errorf("%w: %s on %s takes no value", ErrInvalidRowQuery, f.Operator, f.Column)
+		}
+	case FilterIn, FilterNotIn:
+		if len(f.Values) == 0 {
+			return fmt.Errorf("%w: %s on %s needs at least one value", ErrInvalidRowQuery, f.Operator, f.Column)
+		}
+		if len(f.Values) > maxInValues {
+			return fmt.Errorf("%w: %s on %s allows at most %d values", ErrInvalidRowQuery, f.Operator, f.Column, maxInValues)
+		}
+	default:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("%w: %s on %s needs exactly one value", ErrInvalidRowQuery, f.Operator, f.Column)
+		}
+	}
+	return nil
+}
+
+// RowSort는 정렬 기준 하나입니다.
+type RowSort struct {
+	Column     string
+	Descending bool
+}
+
+// ParseRowSort는 "name,-created_at" 형태의 정렬 목록을 해석합니다 (-는 내림차순).
+func ParseRowSort(s string) []RowSort {
+	var sorts []RowSort
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		sort := RowSort{Column: item}
+		if strings.HasPrefix(item, "-") {
+			sort = RowSort{Column: strings.TrimSpace(item[1:]), Descending: true}
+		} else if strings.HasPrefix(item, "+") {
+			sort.Column = strings.TrimSpace(item[1:])
+		}
+		sorts = append(sorts, sort)
+	}
+	return sorts
+}
+
+// RowQuery는 테이블 데이터 조회 요청입니다. 컬럼 이름은 GetColumns와 같은 규칙으로 찾습니다.
+type RowQuery struct {
+	Schema string // 비어 있으면 DB 기본 스키마
+	Table  string
+
+	Columns []string    // 가져올 컬럼 (비어 있으면 전체, 테이블 순서)
+	Filters []RowFilter // AND로 묶음
+	Sort    []RowSort   // 비어 있으면 기본 키 순서 (기본 키가 없으면 DB 순서)
+
+	Limit  int // 0이면 기본값 100, 최대 1000
+	Offset int
+
+	// CountTotal이면 필터에 맞는 전체 row 수도 셉니다 (COUNT(*) 쿼리 한 번 더).
+	CountTotal bool
+}
+
+// Validate는 조회 요청을 검증하고 비어 있는 값을 기본값으로 채웁니다.
+func (q *RowQuery) Validate() error {
+	if strings.TrimSpace(q.Table) == "" {
+		return fmt.Errorf("%w: table is required", ErrInvalidRowQuery)
+	}
+	if q.Limit < 0 || q.Limit > MaxRowLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidRowQuery, MaxRowLimit)
+	}
+	if q.Limit == 0 {
+		q.Limit = DefaultRowLimit
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("%w: offset must not be negative", ErrInvalidRowQuery)
+	}
+	for _, f := range q.Filters {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, s := range q.Sort {
+		if s.Column == "" {
+			return fmt.Errorf("%w: empty sort column", ErrInvalidRowQuery)
+		}
+	}
+	return nil
+}
+
+// RowCondition은 카탈로그 컬럼으로 해석한 필터입니다.
+type RowCondition struct {
+	Column   ColumnInfo // Name은 카탈로그에 저장된 그대로
+	Operator FilterOperator
+	Values   []string
+}
+
+// RowSelect는 SQL로 만들 데이터 조회입니다. 이름은 모두 카탈로그에 저장된 그대로입니다.
+type RowSelect struct {
+	Schema  string // 비어 있으면 현재 스키마
+	Table   string
+	Columns []ColumnInfo
+	Where   []RowCondition
+	OrderBy []RowSort
+	Limit   int
+	Offset  int
+}
+
+// RowPage는 테이블 데이터 한 페이지입니다.
+type RowPage struct {
+	Schema string
+	Table  string
+
+	Columns     []string                 // DisplayIdentifier로 정규화된 이름 (조회 순서)
+	ColumnTypes []string                 // 카탈로그 타입 (예: "VARCHAR2(100)")
+	Rows        []map[string]interface{} // 키는 Columns의 이름
+
+	Limit   int
+	Offset  int
+	HasMore bool   // 다음 페이지가 있는지 (Limit+1개를 읽어서 판단)
+	Total   *int64 // CountTotal일 때만
+
+	Statement     string // 실행한 SQL (값은 바인드 변수로 표시)
+	ExecutionTime time.Duration
+}
+
+// binder는 바인드 변수 자리표시자를 만들고 값을 모읍니다.
+// Postgres는 $1, $2 ..., Oracle은 :1, :2 ... 를 씁니다.
+type binder struct {
+	dialect SQLDialect
+	args    []interface{}
+}
+
+// bind는 값을 추가하고 그 자리표시자를 반환합니다.
+func (b *binder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	if b.dialect == DialectOracle {
+		return ":" + strconv.Itoa(len(b.args))
+	}
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// RowSelectQuery는 데이터 한 페이지를 읽는 SQL과 바인드 값을 만듭니다.
+// 다음 페이지가 있는지 알 수 있도록 Limit+1개를 읽습니다.
+//
+//	Postgres:   SELECT ... FROM t WHERE ... ORDER BY ... LIMIT 101 OFFSET 200
+//	Oracle 19c: SELECT ... FROM t WHERE ... ORDER BY ... OFFSET 200 ROWS FETCH NEXT 101 ROWS ONLY
+//	Oracle 11g: SELECT cols FROM (
+//	              SELECT q.*, ROWNUM AS dms_rn FROM (SELECT ... ORDER BY ...) q WHERE ROWNUM <= 301
+//	            ) WHERE dms_rn > 200
+//
+// 필터 값은 모두 바인드 변수로 넘기고, 식별자는 QuoteCatalogIdentifier로 감쌉니다.
+func RowSelectQuery(dbType DatabaseType, sel RowSelect) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	list := columnList(dbType, sel.Columns)
+
+	where, err := rowWhere(dbType, b, sel.Where)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query := "SELECT " + list + " FROM " + rowTable(dbType, sel.Schema, sel.Table) + where
+	if len(sel.OrderBy) > 0 {
+		order := make([]string, len(sel.OrderBy))
+		for i, s := range sel.OrderBy {
+			order[i] = QuoteCatalogIdentifier(dbType, s.Column)
+			if s.Descending {
+				order[i] += " DESC"
+			}
+		}
+		query += " ORDER BY " + strings.Join(order, ", ")
+	}
+
+	fetch := sel.Limit + 1
+	switch dbType {
+	case Oracle11g:
+		query = fmt.Sprintf("SELECT %s FROM (SELECT q.*, ROWNUM AS dms_rn FROM (%s) q WHERE ROWNUM <= %d) WHERE dms_rn > %d",
+			list, query, sel.Offset+fetch, sel.Offset)
+	case Oracle19c:
+		if sel.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d ROWS FETCH NEXT %d ROWS ONLY", sel.Offset, fetch)
+		} else {
+			query += fmt.Sprintf(" FETCH FIRST %d ROWS ONLY", fetch)
+		}
+	default:
+		query += fmt.Sprintf(" LIMIT %d", fetch)
+		if sel.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET %d", sel.Offset)
+		}
+	}
+
+	return query, b.args, nil
+}
+
+// RowCountQuery는 필터에 맞는 전체 row 수를 세는 SQL과 바인드 값을 만듭니다.
+func RowCountQuery(dbType DatabaseType, sel RowSelect) (string, []interface{}, error) {
+	b := &binder{dialect: DialectOf(dbType)}
+	if b.dialect == "" {
+		return "", nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dbType)
+	}
+
+	where, err := rowWhere(dbType, b, sel.Where)
+	if err != nil {
+		return "", nil, err
+	}
+	return "SELECT COUNT(*) AS total FROM " + rowTable(dbType, sel.Schema, sel.Table) + where, b.args, nil
+}
+
+// rowTable은 스키마를 붙인 테이블 이름을 만듭니다.
+func rowTable(dbType DatabaseType, schema, table string) string {
+	name := QuoteCatalogIdentifier(dbType, table)
+	if schema != "" {
+		name = QuoteCatalogIdentifier(dbType, schema) + "." + name
+	}
+	return name
+}
+
+// rowWhere는 조건들을 " WHERE a = $1 AND b IS NULL" 형태로 만듭니다 (조건이 없으면 빈 문자열).
+func rowWhere(dbType DatabaseType, b *binder, conditions []RowCondition) (string, error) {
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	parts := make([]string, 0, len(conditions))
+	for _, cond := range conditions {
+		part, err := rowCondition(dbType, b, cond)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, part)
+	}
+	return " WHERE " + strings.Join(parts, " AND "), nil
+}
+
+// rowCondition은 조건 하나를 SQL로 만듭니다.
+//
+// 비교 값은 컬럼 타입에 맞게 바꿔서 바인드합니다 (CoerceValue).
+// LIKE는 문자열 비교이므로 문자열이 아닌 컬럼은 문자열로 바꿔서 비교합니다.
+// LOB 컬럼은 Oracle에서 =, <, IN으로 비교할 수 없으므로 LIKE와 NULL 검사만 허용합니다.
+func rowCondition(dbType DatabaseType, b *binder, cond RowCondition) (string, error) {
+	name := QuoteCatalogIdentifier(dbType, cond.Column.Name)
+	category := ProfileCategoryOf(cond.Column.BaseType)
+
+	switch cond.Operator {
+	case FilterIsNull:
+		return name + " IS NULL", nil
+	case FilterNotNull:
+		return name + " IS NOT NULL", nil
+
+	case FilterLike, FilterNotLike, FilterILike:
+		expr := name
+		if category != ProfileText && category != ProfileLOB {
+			if b.dialect == DialectOracle {
+				expr = "TO_CHAR(" + name + ")"
+			} else {
+				expr = "CAST(" + name + " AS TEXT)"
+			}
+		}
+		switch cond.Operator {
+		case FilterILike:
+			return "LOWER(" + expr + ") LIKE LOWER(" + b.bind(cond.Values[0]) + ")", nil
+		case FilterNotLike:
+			return expr + " NOT LIKE " + b.bind(cond.Values[0]), nil
+		default:
+			return expr + " LIKE " + b.bind(cond.Values[0]), nil
+		}
+	}
+
+	if category == ProfileLOB {
+		return "", fmt.Errorf("%w: %s is a %s column and supports only like, is_null and not_null", ErrInvalidRowQuery, DisplayIdentifier(dbType, cond.Column.Name), cond.Column.DataType)
+	}
+
+	values := make([]string, len(cond.Values))
+	for i, raw := range cond.Values {
+		v, err := CoerceValue(dbType, cond.Column, strings.TrimSpace(raw))
+		if err != nil {
+			return "", err
+		}
+		values[i] = b.bind(v)
+	}
+
+	switch cond.Operator {
+	case FilterIn:
+		return name + " IN (" + strings.Join(values, ", ") + ")", nil
+	case FilterNotIn:
+		return name + " NOT IN (" + strings.Join(values, ", ") + ")", nil
+	default:
+		return name + " " + filterComparisons[cond.Operator] + " " + values[0], nil
+	}
+}
+
+// RowInt64는 row에서 숫자 값(COUNT 결과 등)을 int64로 읽습니다.
+// Oracle은 별칭을 대문자로 돌려주므로 이름은 대소문자 없이 찾습니다.
+func RowInt64(row map[string]interface{}, name string) (int64, bool) {
+	return profileInt(profileValue(row, name))
+}
+
+// timeLayouts는 날짜/시각 값으로 받는 형식입니다 (앞에서부터 시도).
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// CoerceValue는 요청 값(JSON 또는 문자열)을 컬럼 타입에 맞는 바인드 값으로 바꿉니다.
+//
+// 변환 규칙:
+//   - nil → NULL
+//   - 숫자 컬럼: json.Number와 문자열은 int64 범위의 정수면 int64, 그 외에는 원문 그대로의
+//     10진수 문자열 (float64로 바꾸면 2^53보다 큰 키나 NUMERIC의 자릿수가 틀어짐)
+//   - float64: 이미 Go 실수인 값(드라이버가 읽은 FLOAT 등)이므로 그대로 (문자열 컬럼이면 문자열)
+//   - 날짜/시각 컬럼(DATE, TIMESTAMP): RFC3339, "2006-01-02 15:04:05", "2006-01-02" → time.Time
+//     (Oracle은 문자열을 NLS_DATE_FORMAT으로 해석하므로 세션마다 결과가 달라질 수 있음)
+//   - boolean: Postgres는 bool 그대로, Oracle은 BOOLEAN이 없으므로 1/0
+//   - 그 외: 문자열
+//
+// 값이 타입에 맞지 않으면 ErrInvalidValue를 반환합니다 (DB 에러보다 원인을 알기 쉬움).
+func CoerceValue(dbType DatabaseType, col ColumnInfo, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case bool:
+		if DialectOf(dbType) == DialectOracle {
+			if v {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		}
+		return v, nil
+
+	case json.Number:
+		// 요청 본문을 UseNumber로 읽은 숫자: 원문으로 변환해서 자릿수를 지킵니다.
+		return coerceString(dbType, col, v.String())
+
+	case float64:
+		if ProfileCategoryOf(col.BaseType) == ProfileText {
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		}
+		return v, nil
+
+	case string:
+		return coerceString(dbType, col, v)
+
+	default:
+		return value, nil
+	}
+}
+
+// coerceString은 문자열 값을 컬럼 타입에 맞게 바꿉니다.
+func coerceString(dbType DatabaseType, col ColumnInfo, s string) (interface{}, error) {
+	upper := strings.ToUpper(col.BaseType)
+	name := DisplayIdentifier(dbType, col.Name)
+
+	switch ProfileCategoryOf(col.BaseType) {
+	case ProfileNumeric:
+		trimmed := strings.TrimSpace(s)
+		if n, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+			return n, nil
+		}
+		r, ok := new(big.Rat).SetString(trimmed)
+		if !ok || strings.ContainsAny(trimmed, "/") {
+			return nil, fmt.Errorf("%w: %s expects a number, got %q", ErrInvalidValue, name, s)
+		}
+		// 소수점 아래 자릿수를 지키면서 지수 표기(1e3)도 10진수로 바꿉니다.
+		if r.IsInt() {
+			return r.RatString(), nil
+		}
+		return trimmed, nil
+
+	case ProfileTemporal:
+		if !strings.HasPrefix(upper, "DATE") && !strings.HasPrefix(upper, "TIMESTAMP") {
+			return s, nil // TIME, INTERVAL은 DB가 문자열을 해석
+		}
+		trimmed := strings.TrimSpace(s)
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, trimmed); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: %s expects a date or timestamp (e.g. 2024-01-31 or 2024-01-31T09:00:00Z), got %q", ErrInvalidValue, name, s)
+	}
+
+	if upper == "BOOLEAN" || upper == "BOOL" {
+		b, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s expects true or false, got %q", ErrInvalidValue, name, s)
+		}
+		return CoerceValue(dbType, col, b)
+	}
+
+	return s, nil
+}
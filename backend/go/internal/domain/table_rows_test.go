@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCoerceValue(t *testing.T) {
+	integer := ColumnInfo{Name: "id", BaseType: "integer"}
+	numeric := ColumnInfo{Name: "amount", BaseType: "numeric"}
+	number := ColumnInfo{Name: "AMOUNT", BaseType: "NUMBER"}
+	text := ColumnInfo{Name: "code", BaseType: "character varying"}
+	date := ColumnInfo{Name: "created", BaseType: "DATE"}
+	timestamp := ColumnInfo{Name: "created_at", BaseType: "timestamp without time zone"}
+	timeOfDay := ColumnInfo{Name: "opens_at", BaseType: "time without time zone"}
+	boolean := ColumnInfo{Name: "active", BaseType: "boolean"}
+	binary := ColumnInfo{Name: "guid", BaseType: "bytea"}
+
+	tests := []struct {
+		name   string
+		dbType DatabaseType
+		col    ColumnInfo
+		value  interface{}
+		want   interface{}
+	}{
+		{"nil", PostgreSQL, integer, nil, nil},
+
+		// 숫자: int64 범위면 int64, 아니면 자릿수를 지킨 10진수 문자열
+		{"integer string", PostgreSQL, integer, "42", int64(42)},
+		{"integer string with spaces", PostgreSQL, integer, " 42 ", int64(42)},
+		{"json number", PostgreSQL, integer, json.Number("42"), int64(42)},
+		{"json number beyond 2^53", PostgreSQL, numeric, json.Number("9007199254740993"), int64(9007199254740993)},
+		{"json number beyond int64", PostgreSQL, numeric, json.Number("123456789012345678901234567890"), "123456789012345678901234567890"},
+		{"decimal keeps its digits", Oracle19c, number, json.Number("0.10"), "0.10"},
+		{"exponent becomes decimal", Oracle19c, number, "1e3", "1000"},
+		{"negative decimal", PostgreSQL, numeric, "-12.345", "-12.345"},
+		{"float64 passes through", PostgreSQL, numeric, float64(1.5), float64(1.5)},
+		{"float64 into text", PostgreSQL, text, float64(1.5), "1.5"},
+
+		// 날짜/시각
+		{"date", Oracle19c, date, "2024-01-31", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"timestamp with space", PostgreSQL, timestamp, "2024-01-31 09:30:00", time.Date(2024, 1, 31, 9, 30, 0, 0, time.UTC)},
+		{"timestamp rfc3339", PostgreSQL, timestamp, "2024-01-31T09:30:00Z", time.Date(2024, 1, 31, 9, 30, 0, 0, time.UTC)},
+		{"time stays a string", PostgreSQL, timeOfDay, "09:30", "09:30"},
+
+		// boolean: Oracle은 1/0
+		{"postgres bool", PostgreSQL, boolean, true, true},
+		{"postgres bool string", PostgreSQL, boolean, "false", false},
+		{"oracle bool", Oracle19c, boolean, true, int64(1)},
+		{"oracle bool string", Oracle11g, boolean, "false", int64(0)},
+
+		// 그 외
+		{"text keeps leading zeros", PostgreSQL, text, "00123", "00123"},
+		{"binary passes through", PostgreSQL, binary, []byte{1, 2}, []byte{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CoerceValue(tt.dbType, tt.col, tt.value)
+			if err != nil {
+				t.Fatalf("CoerceValue(%v) error = %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoerceValue(%v) = %#v, want %#v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoerceValueErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		col   ColumnInfo
+		value interface{}
+	}{
+		{"not a number", ColumnInfo{Name: "id", BaseType: "integer"}, "abc"},
+		{"fraction", ColumnInfo{Name: "id", BaseType: "numeric"}, "1/3"},
+		{"empty number", ColumnInfo{Name: "id", BaseType: "integer"}, ""},
+		{"bad date", ColumnInfo{Name: "created", BaseType: "date"}, "31/01/2024"},
+		{"bad boolean", ColumnInfo{Name: "active", BaseType: "boolean"}, "maybe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CoerceValue(PostgreSQL, tt.col, tt.value); !errors.Is(err, ErrInvalidValue) {
+				t.Errorf("CoerceValue(%v) error = %v, want %v", tt.value, err, ErrInvalidValue)
+			}
+		})
+	}
+}
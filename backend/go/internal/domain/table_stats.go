@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 테이블 통계 관련 에러
+var (
+	ErrInvalidStatsSort = errors.New("invalid table stats sort field")
+)
+
+// TableStats는 테이블 하나의 저장 공간/활동 통계입니다 (용량 계획용).
+//
+// 포인터 필드는 DB가 알려주지 않는 값입니다 (nil = 해당 없음/수집 안 됨).
+//   - Postgres: pg_class, pg_stat_user_tables (스캔/데드 튜플/VACUUM 시각)
+//   - Oracle: all_tables(num_rows, last_analyzed) + 세그먼트 크기
+//     (dba_segments 권한이 없으면 user_segments로 자기 스키마만)
+type TableStats struct {
+	Schema string
+	Table  string
+
+	// EstimatedRows는 통계 기준 추정 row 수입니다 (COUNT(*)가 아님).
+	// Postgres: reltuples, Oracle: num_rows (ANALYZE/DBMS_STATS 시점 값)
+	EstimatedRows *int64
+
+	TableBytes *int64 // 테이블 본체
+	IndexBytes *int64 // 모든 인덱스 합계
+	ToastBytes *int64 // Postgres: TOAST, Oracle: LOB 세그먼트
+	TotalBytes *int64 // 위의 합계
+
+	LastVacuum     *time.Time // Postgres만 (수동/자동 중 최근 것)
+	LastAnalyzed   *time.Time // Postgres: 수동/자동 ANALYZE 중 최근 것, Oracle: last_analyzed
+	DeadTuples     *int64     // Postgres만
+	SequentialScan *int64     // Postgres만: 순차 스캔 횟수
+	IndexScan      *int64     // Postgres만: 인덱스 스캔 횟수
+	Inserts        *int64     // Postgres: 통계 초기화 이후, Oracle: 마지막 통계 수집 이후 (all_tab_modifications)
+	Updates        *int64
+	Deletes        *int64
+}
+
+// TableStatsOptions는 통계 목록 조회 옵션입니다.
+type TableStatsOptions struct {
+	Sort       TableStatsSort
+	Descending bool
+	Limit      int // 0이면 전체
+}
+
+// TableStatsSort는 통계 목록 정렬 기준입니다.
+type TableStatsSort string
+
+const (
+	SortStatsName         TableStatsSort = "name"
+	SortStatsRows         TableStatsSort = "rows"
+	SortStatsTotalBytes   TableStatsSort = "total_bytes"
+	SortStatsTableBytes   TableStatsSort = "table_bytes"
+	SortStatsIndexBytes   TableStatsSort = "index_bytes"
+	SortStatsDeadTuples   TableStatsSort = "dead_tuples"
+	SortStatsSeqScans     TableStatsSort = "seq_scans"
+	SortStatsIndexScans   TableStatsSort = "index_scans"
+	SortStatsLastAnalyzed TableStatsSort = "last_analyzed"
+	SortStatsLastVacuum   TableStatsSort = "last_vacuum"
+)
+
+// tableStatsKeys는 정렬 기준별로 비교할 숫자 값을 꺼내는 함수입니다.
+// 시각은 Unix 시각으로 비교합니다. 값이 없으면 ok=false.
+var tableStatsKeys = map[TableStatsSort]func(s *TableStats) (int64, bool){
+	SortStatsRows:         func(s *TableStats) (int64, bool) { return int64Value(s.EstimatedRows) },
+	SortStatsTotalBytes:   func(s *TableStats) (int64, bool) { return int64Value(s.TotalBytes) },
+	SortStatsTableBytes:   func(s *TableStats) (int64, bool) { return int64Value(s.TableBytes) },
+	SortStatsIndexBytes:   func(s *TableStats) (int64, bool) { return int64Value(s.IndexBytes) },
+	SortStatsDeadTuples:   func(s *TableStats) (int64, bool) { return int64Value(s.DeadTuples) },
+	SortStatsSeqScans:     func(s *TableStats) (int64, bool) { return int64Value(s.SequentialScan) },
+	SortStatsIndexScans:   func(s *TableStats) (int64, bool) { return int64Value(s.IndexScan) },
+	SortStatsLastAnalyzed: func(s *TableStats) (int64, bool) { return timeValue(s.LastAnalyzed) },
+	SortStatsLastVacuum:   func(s *TableStats) (int64, bool) { return timeValue(s.LastVacuum) },
+}
+
+// ParseTableStatsSort는 문자열을 정렬 기준으로 바꿉니다 (비어 있으면 total_bytes).
+func ParseTableStatsSort(value string) (TableStatsSort, error) {
+	field := TableStatsSort(strings.ToLower(strings.TrimSpace(value)))
+	if field == "" {
+		return SortStatsTotalBytes, nil
+	}
+	if _, ok := tableStatsKeys[field]; ok || field == SortStatsName {
+		return field, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidStatsSort, value)
+}
+
+// SortTableStats는 통계 목록을 정렬합니다.
+// 값이 없는 테이블(nil)은 방향과 관계없이 항상 뒤로 보냅니다.
+// 값이 같으면 이름순입니다.
+func SortTableStats(stats []TableStats, field TableStatsSort, descending bool) {
+	key := tableStatsKeys[field]
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		a, b := &stats[i], &stats[j]
+
+		if key != nil {
+			av, aok := key(a)
+			bv, bok := key(b)
+			switch {
+			case aok != bok:
+				return aok
+			case aok && av != bv:
+				if descending {
+					return av > bv
+				}
+				return av < bv
+			}
+		} else if a.Table != b.Table {
+			if descending {
+				return a.Table > b.Table
+			}
+			return a.Table < b.Table
+		}
+
+		return a.Table < b.Table
+	})
+}
+
+// SumBytes는 nil이 아닌 크기를 더합니다 (모두 nil이면 nil).
+func SumBytes(values ...*int64) *int64 {
+	var total int64
+	found := false
+	for _, v := range values {
+		if v != nil {
+			total += *v
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return &total
+}
+
+func int64Value(v *int64) (int64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	return *v, true
+}
+
+func timeValue(t *time.Time) (int64, bool) {
+	if t == nil {
+		return 0, false
+	}
+	return t.Unix(), true
+}
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ConvertColumnType은 컬럼 타입을 다른 방언의 타입으로 바꿉니다.
+// 같은 방언이면 원래 타입(DataType)을 그대로 씁니다.
+//
+// 정확히 대응하는 타입이 없으면 가장 가까운 타입과 함께 경고 문구를 반환합니다.
+// (예: Postgres boolean → Oracle NUMBER(1))
+func ConvertColumnType(source, target SQLDialect, col ColumnInfo) (string, string) {
+	if source == target {
+		return col.DataType, ""
+	}
+
+	switch {
+	case source == DialectPostgres && target == DialectOracle:
+		return postgresToOracleType(col)
+	case source == DialectOracle && target == DialectPostgres:
+		return oracleToPostgresType(col)
+	default:
+		return col.DataType, fmt.Sprintf("type %s copied without translation", col.DataType)
+	}
+}
+
+// postgresToOracleType은 Postgres 타입을 Oracle 타입으로 바꿉니다.
+func postgresToOracleType(col ColumnInfo) (string, string) {
+	base := strings.ToLower(col.BaseType)
+
+	if strings.HasSuffix(base, "[]") {
+		return "CLOB", fmt.Sprintf("array type %s has no Oracle equivalent, mapped to CLOB", col.DataType)
+	}
+
+	switch base {
+	case "smallint":
+		return "NUMBER(5)", ""
+	case "integer":
+		return "NUMBER(10)", ""
+	case "bigint":
+		return "NUMBER(19)", ""
+	case "numeric":
+		switch {
+		case col.Precision == nil:
+			return "NUMBER", ""
+		case col.Scale == nil || *col.Scale == 0:
+			return fmt.Sprintf("NUMBER(%d)", *col.Precision), ""
+		default:
+			return fmt.Sprintf("NUMBER(%d,%d)", *col.Precision, *col.Scale), ""
+		}
+	case "real":
+		return "BINARY_FLOAT", ""
+	case "double precision":
+		return "BINARY_DOUBLE", ""
+	case "money":
+		return "NUMBER(19,2)", ""
+
+	case "character varying":
+		switch {
+		case col.Length == nil:
+			return "VARCHAR2(4000 CHAR)", "varchar without length mapped to VARCHAR2(4000 CHAR)"
+		case *col.Length > 4000:
+			return "CLOB", fmt.Sprintf("%s exceeds the VARCHAR2 limit, mapped to CLOB", col.DataType)
+		default:
+			return fmt.Sprintf("VARCHAR2(%d CHAR)", *col.Length), ""
+		}
+	case "character":
+		if col.Length == nil {
+			return "CHAR(1 CHAR)", ""
+		}
+		return fmt.Sprintf("CHAR(%d CHAR)", *col.Length), ""
+	case "text":
+		return "CLOB", ""
+
+	case "boolean":
+		return "NUMBER(1)", "boolean mapped to NUMBER(1) (1 = true, 0 = false)"
+	case "date":
+		return "DATE", ""
+	case "timestamp without time zone":
+		return "TIMESTAMP" + typePrecision(col.DataType), ""
+	case "timestamp with time zone":
+		return "TIMESTAMP" + typePrecision(col.DataType) + " WITH TIME ZONE", ""
+	case "time without time zone", "time with time zone":
+		return "INTERVAL DAY(0) TO SECOND" + typePrecision(col.DataType), fmt.Sprintf("%s mapped to INTERVAL DAY TO SECOND", col.DataType)
+	case "interval":
+		return "INTERVAL DAY TO SECOND", "interval mapped to INTERVAL DAY TO SECOND (year/month parts are lost)"
+
+	case "bytea":
+		return "BLOB", ""
+	case "uuid":
+		return "VARCHAR2(36)", "uuid mapped to VARCHAR2(36)"
+	case "json", "jsonb":
+		return "CLOB", fmt.Sprintf("%s mapped to CLOB (add an IS JSON check constraint if needed)", base)
+	case "xml":
+		return "XMLTYPE", ""
+	case "inet", "cidr", "macaddr":
+		return "VARCHAR2(43)", fmt.Sprintf("%s mapped to VARCHAR2(43)", base)
+	}
+
+	return "VARCHAR2(4000)", fmt.Sprintf("type %s has no Oracle equivalent, mapped to VARCHAR2(4000)", col.DataType)
+}
+
+// oracleToPostgresType은 Oracle 타입을 Postgres 타입으로 바꿉니다.
+//
+// Oracle DATE는 시각까지 저장하므로 date가 아니라 timestamp(0)로 바꿉니다.
+// NUMBER(p)는 자릿수에 맞는 정수 타입을 고릅니다 (NUMBER(10) → bigint).
+func oracleToPostgresType(col ColumnInfo) (string, string) {
+	base := strings.ToUpper(col.BaseType)
+
+	switch {
+	case base == "NUMBER":
+		switch {
+		case col.Precision == nil && (col.Scale == nil || *col.Scale != 0):
+			return "numeric", ""
+		case col.Precision == nil:
+			return "numeric(38)", "" // INTEGER = NUMBER(*,0)
+		case col.Scale != nil && *col.Scale > 0:
+			return fmt.Sprintf("numeric(%d,%d)", *col.Precision, *col.Scale), ""
+		case *col.Precision <= 4:
+			return "smallint", ""
+		case *col.Precision <= 9:
+			return "integer", ""
+		case *col.Precision <= 18:
+			return "bigint", ""
+		default:
+			return fmt.Sprintf("numeric(%d)", *col.Precision), ""
+		}
+	case base == "FLOAT", base == "BINARY_DOUBLE":
+		return "double precision", ""
+	case base == "BINARY_FLOAT":
+		return "real", ""
+
+	case base == "VARCHAR2", base == "NVARCHAR2":
+		if col.Length == nil {
+			return "varchar", ""
+		}
+		return fmt.Sprintf("varchar(%d)", *col.Length), ""
+	case base == "CHAR", base == "NCHAR":
+		if col.Length == nil {
+			return "char(1)", ""
+		}
+		return fmt.Sprintf("char(%d)", *col.Length), ""
+	case base == "CLOB", base == "NCLOB", base == "LONG":
+		return "text", ""
+
+	case base == "DATE":
+		return "timestamp(0)", ""
+	case strings.HasPrefix(base, "TIMESTAMP") && strings.HasSuffix(base, "TIME ZONE"):
+		// WITH TIME ZONE, WITH LOCAL TIME ZONE 둘 다
+		return "timestamptz" + typePrecision(base), ""
+	case strings.HasPrefix(base, "TIMESTAMP"):
+		return "timestamp" + typePrecision(base), ""
+	case strings.HasPrefix(base, "INTERVAL"):
+		return "interval", ""
+
+	case base == "BLOB", base == "RAW", base == "LONG RAW", base == "BFILE":
+		if base == "BFILE" {
+			return "bytea", "BFILE mapped to bytea (file contents are not stored in the database)"
+		}
+		return "bytea", ""
+	case base == "ROWID", base == "UROWID":
+		return "varchar(18)", fmt.Sprintf("%s mapped to varchar(18)", base)
+	case base == "XMLTYPE":
+		return "xml", ""
+	}
+
+	return "text", fmt.Sprintf("type %s has no PostgreSQL equivalent, mapped to text", col.DataType)
+}
+
+// precisionPattern은 "timestamp(3) without time zone", "TIMESTAMP(6)"의 (3), (6)입니다.
+var precisionPattern = regexp.MustCompile(`\(\d+\)`)
+
+// typePrecision은 타입 이름에서 "(n)" 부분을 찾아 반환합니다 (없으면 빈 문자열).
+func typePrecision(dataType string) string {
+	return precisionPattern.FindString(dataType)
+}
+
+// 기본값 변환에 쓰는 패턴
+var (
+	// 'abc'::character varying → 'abc' (Postgres 캐스트 제거)
+	castLiteralPattern = regexp.MustCompile(`^('(?:[^']|'')*')(?:::[\w ]+(?:\(\d+(?:,\d+)?\))?(?:\[\])?)+$`)
+	numberPattern      = regexp.MustCompile(`^\(?-?\d+(?:\.\d+)?\)?(?:::[\w ]+)?$`)
+)
+
+// defaultFunctions는 두 DB에서 뜻이 같은 기본값 함수입니다 (대문자로 비교).
+var defaultFunctions = map[SQLDialect]map[string]string{
+	// Postgres → Oracle
+	DialectOracle: {
+		"NOW()":             "SYSTIMESTAMP",
+		"CURRENT_TIMESTAMP": "SYSTIMESTAMP",
+		"LOCALTIMESTAMP":    "LOCALTIMESTAMP",
+		"CURRENT_DATE":      "TRUNC(SYSDATE)",
+		"CURRENT_USER":      "USER",
+		"TRUE":              "1",
+		"FALSE":             "0",
+		"NULL":              "NULL",
+	},
+	// Oracle → Postgres
+	DialectPostgres: {
+		"SYSDATE":           "LOCALTIMESTAMP(0)",
+		"SYSTIMESTAMP":      "CURRENT_TIMESTAMP",
+		"CURRENT_TIMESTAMP": "CURRENT_TIMESTAMP",
+		"LOCALTIMESTAMP":    "LOCALTIMESTAMP",
+		"CURRENT_DATE":      "LOCALTIMESTAMP(0)",
+		"TRUNC(SYSDATE)":    "CURRENT_DATE",
+		"USER":              "CURRENT_USER",
+		// SYS_GUID()는 RAW(16)이고 RAW는 bytea로 바뀌므로 uuid가 아니라 16바이트 bytea를 만듭니다.
+		// RAWTOHEX(SYS_GUID())는 대문자 16진수 32자입니다 (VARCHAR2 컬럼의 흔한 기본값).
+		"SYS_GUID()":           "decode(replace(gen_random_uuid()::text, '-', ''), 'hex')",
+		"RAWTOHEX(SYS_GUID())": "upper(replace(gen_random_uuid()::text, '-', ''))",
+		"NULL":                 "NULL",
+	},
+}
+
+// ConvertDefault는 컬럼 기본값 식을 다른 방언으로 바꿉니다.
+// 숫자/문자열 리터럴과 현재 시각 같은 흔한 함수만 바꾸고,
+// 나머지(nextval, 사용자 함수 등)는 ok = false를 반환합니다.
+func ConvertDefault(source, target SQLDialect, expr string) (string, bool) {
+	expr = strings.TrimSpace(expr)
+	if source == target {
+		return expr, true
+	}
+
+	if m := castLiteralPattern.FindStringSubmatch(expr); m != nil {
+		return m[1], true
+	}
+	if strings.HasPrefix(expr, "'") && strings.HasSuffix(expr, "'") && len(expr) >= 2 {
+		return expr, true
+	}
+	if numberPattern.MatchString(expr) {
+		number := strings.Trim(strings.SplitN(expr, "::", 2)[0], "()")
+		return number, true
+	}
+
+	if converted, ok := defaultFunctions[target][strings.ToUpper(expr)]; ok {
+		return converted, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,94 @@
+package domain
+
+import "testing"
+
+func TestConvertDefault(t *testing.T) {
+	tests := []struct {
+		name   string
+		source SQLDialect
+		target SQLDialect
+		expr   string
+		want   string
+		ok     bool
+	}{
+		{"same dialect", DialectPostgres, DialectPostgres, "nextval('s')", "nextval('s')", true},
+		{"string literal", DialectOracle, DialectPostgres, "'N'", "'N'", true},
+		{"cast literal", DialectPostgres, DialectOracle, "'N'::character varying", "'N'", true},
+		{"number", DialectOracle, DialectPostgres, "0", "0", true},
+		{"negative cast number", DialectPostgres, DialectOracle, "(-1)::integer", "-1", true},
+		{"now", DialectPostgres, DialectOracle, "now()", "SYSTIMESTAMP", true},
+		{"boolean", DialectPostgres, DialectOracle, "true", "1", true},
+		{"sysdate", DialectOracle, DialectPostgres, "SYSDATE ", "LOCALTIMESTAMP(0)", true},
+		{"sys_guid is bytea", DialectOracle, DialectPostgres, "sys_guid()", "decode(replace(gen_random_uuid()::text, '-', ''), 'hex')", true},
+		{"rawtohex sys_guid is text", DialectOracle, DialectPostgres, "RAWTOHEX(SYS_GUID())", "upper(replace(gen_random_uuid()::text, '-', ''))", true},
+		{"sequence", DialectPostgres, DialectOracle, "nextval('users_id_seq'::regclass)", "", false},
+		{"user function", DialectOracle, DialectPostgres, "my_pkg.next_code()", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ConvertDefault(tt.source, tt.target, tt.expr)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("ConvertDefault(%q) = (%q, %v), want (%q, %v)", tt.expr, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestConvertColumnType(t *testing.T) {
+	intp := func(n int) *int { return &n }
+	lenp := func(n int64) *int64 { return &n }
+
+	tests := []struct {
+		name     string
+		source   SQLDialect
+		target   SQLDialect
+		col      ColumnInfo
+		want     string
+		warnings bool
+	}{
+		{"same dialect keeps the type", DialectPostgres, DialectPostgres, ColumnInfo{DataType: "numeric(10,2)", BaseType: "numeric"}, "numeric(10,2)", false},
+
+		// Oracle → Postgres
+		{"number without precision", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER", BaseType: "NUMBER"}, "numeric", false},
+		{"integer is number(*,0)", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(*,0)", BaseType: "NUMBER", Scale: intp(0)}, "numeric(38)", false},
+		{"number(4) is smallint", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(4)", BaseType: "NUMBER", Precision: intp(4), Scale: intp(0)}, "smallint", false},
+		{"number(9) is integer", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(9)", BaseType: "NUMBER", Precision: intp(9), Scale: intp(0)}, "integer", false},
+		{"number(10) is bigint", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(10)", BaseType: "NUMBER", Precision: intp(10), Scale: intp(0)}, "bigint", false},
+		{"number(18) is bigint", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(18)", BaseType: "NUMBER", Precision: intp(18), Scale: intp(0)}, "bigint", false},
+		{"number(19) overflows bigint", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(19)", BaseType: "NUMBER", Precision: intp(19), Scale: intp(0)}, "numeric(19)", false},
+		{"number with scale", DialectOracle, DialectPostgres, ColumnInfo{DataType: "NUMBER(10,2)", BaseType: "NUMBER", Precision: intp(10), Scale: intp(2)}, "numeric(10,2)", false},
+		{"varchar2", DialectOracle, DialectPostgres, ColumnInfo{DataType: "VARCHAR2(100 CHAR)", BaseType: "VARCHAR2", Length: lenp(100)}, "varchar(100)", false},
+		{"date keeps the time", DialectOracle, DialectPostgres, ColumnInfo{DataType: "DATE", BaseType: "DATE"}, "timestamp(0)", false},
+		{"timestamp precision", DialectOracle, DialectPostgres, ColumnInfo{DataType: "TIMESTAMP(3)", BaseType: "TIMESTAMP(3)"}, "timestamp(3)", false},
+		{"local time zone", DialectOracle, DialectPostgres, ColumnInfo{DataType: "TIMESTAMP(6) WITH LOCAL TIME ZONE", BaseType: "TIMESTAMP(6) WITH LOCAL TIME ZONE"}, "timestamptz(6)", false},
+		{"raw is bytea", DialectOracle, DialectPostgres, ColumnInfo{DataType: "RAW(16)", BaseType: "RAW", Length: lenp(16)}, "bytea", false},
+		{"bfile warns", DialectOracle, DialectPostgres, ColumnInfo{DataType: "BFILE", BaseType: "BFILE"}, "bytea", true},
+		{"unknown oracle type", DialectOracle, DialectPostgres, ColumnInfo{DataType: "SDO_GEOMETRY", BaseType: "SDO_GEOMETRY"}, "text", true},
+
+		// Postgres → Oracle
+		{"integer", DialectPostgres, DialectOracle, ColumnInfo{DataType: "integer", BaseType: "integer"}, "NUMBER(10)", false},
+		{"numeric without precision", DialectPostgres, DialectOracle, ColumnInfo{DataType: "numeric", BaseType: "numeric"}, "NUMBER", false},
+		{"numeric with scale", DialectPostgres, DialectOracle, ColumnInfo{DataType: "numeric(12,3)", BaseType: "numeric", Precision: intp(12), Scale: intp(3)}, "NUMBER(12,3)", false},
+		{"varchar", DialectPostgres, DialectOracle, ColumnInfo{DataType: "character varying(50)", BaseType: "character varying", Length: lenp(50)}, "VARCHAR2(50 CHAR)", false},
+		{"varchar without length", DialectPostgres, DialectOracle, ColumnInfo{DataType: "character varying", BaseType: "character varying"}, "VARCHAR2(4000 CHAR)", true},
+		{"varchar over the limit", DialectPostgres, DialectOracle, ColumnInfo{DataType: "character varying(5000)", BaseType: "character varying", Length: lenp(5000)}, "CLOB", true},
+		{"boolean", DialectPostgres, DialectOracle, ColumnInfo{DataType: "boolean", BaseType: "boolean"}, "NUMBER(1)", true},
+		{"timestamptz precision", DialectPostgres, DialectOracle, ColumnInfo{DataType: "timestamp(3) with time zone", BaseType: "timestamp with time zone"}, "TIMESTAMP(3) WITH TIME ZONE", false},
+		{"array", DialectPostgres, DialectOracle, ColumnInfo{DataType: "integer[]", BaseType: "integer[]"}, "CLOB", true},
+		{"uuid", DialectPostgres, DialectOracle, ColumnInfo{DataType: "uuid", BaseType: "uuid"}, "VARCHAR2(36)", true},
+		{"jsonb", DialectPostgres, DialectOracle, ColumnInfo{DataType: "jsonb", BaseType: "jsonb"}, "CLOB", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warning := ConvertColumnType(tt.source, tt.target, tt.col)
+			if got != tt.want {
+				t.Errorf("ConvertColumnType(%s) = %q, want %q", tt.col.DataType, got, tt.want)
+			}
+			if (warning != "") != tt.warnings {
+				t.Errorf("ConvertColumnType(%s) warning = %q, want warning = %v", tt.col.DataType, warning, tt.warnings)
+			}
+		})
+	}
+}
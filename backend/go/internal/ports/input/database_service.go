@@ -5,6 +5,7 @@ package input
 
 import (
 	"context"
+	"time"
 
 	// Domain만 import! (안쪽만 의존)
 	"space/internal/domain"
@@ -39,15 +40,19 @@ type DatabaseService interface {
 	//   - ctx: context.Context - 쿼리 타임아웃 설정 가능
 	//   - dbID: string - 데이터베이스 고유 ID (예: "postgres-prod")
 	//   - query: string - 실행할 SQL 쿼리
+	//   - opts: domain.QueryOptions - 요청별 옵션 (가드 확인 여부 등)
 	//
 	// 반환값:
 	//   - *domain.QueryResult: 쿼리 실행 결과
 	//   - error: 에러 발생 시
+	//     가드에 걸리면 *domain.QueryGuardError
+	//     (errors.Is로 domain.ErrQueryRejected / domain.ErrConfirmationRequired 확인 가능)
 	//
 	// 주의사항:
 	//   - dbID에 해당하는 DB가 연결되어 있어야 함
+	//   - DB에 Guard가 설정되어 있으면 실행 전에 EXPLAIN으로 비용을 검사함
 	//   - 악의적인 쿼리 방지는 어댑터에서 처리 (여기는 계약만)
-	ExecuteQuery(ctx context.Context, dbID string, query string) (*domain.QueryResult, error)
+	ExecuteQuery(ctx context.Context, dbID string, query string, opts domain.QueryOptions) (*domain.QueryResult, error)
 
 	// ListDatabases는 현재 연결된 모든 데이터베이스 목록을 반환합니다.
 	//
@@ -74,6 +79,350 @@ type DatabaseService interface {
 	//   - *domain.Database: DB 정보 (비밀번호는 마스킹됨)
 	//   - error: DB를 찾을 수 없으면 domain.ErrDatabaseNotFound
 	GetDatabaseInfo(ctx context.Context, dbID string) (*domain.Database, error)
+
+	// ExecuteFederatedQuery는 여러 DB의 서브 쿼리 결과를 조인합니다.
+	//
+	// 파라미터:
+	//   - fq: *domain.FederatedQuery - 소스(서브 쿼리 + DB ID) 목록과 최종 SQL
+	//
+	// 반환값:
+	//   - *domain.FederatedResult: 최종 결과와 소스별 결과
+	//   - error: 검증 실패 시 domain.ErrInvalidFederation,
+	//     한도 초과 시 domain.ErrFederationLimitExceeded
+	//
+	// 주의사항:
+	//   - 모든 소스 DB가 연결되어 있어야 함
+	//   - 최종 SQL은 임베디드 엔진(SQLite) 문법으로 작성
+	ExecuteFederatedQuery(ctx context.Context, fq *domain.FederatedQuery) (*domain.FederatedResult, error)
+
+	// DiffQueryResults는 두 DB에서 실행한 쿼리 결과를 키 컬럼 기준으로 비교합니다.
+	//
+	// 파라미터:
+	//   - req: *domain.ResultDiffRequest - 양쪽 DB/쿼리, 키 컬럼, 비교 방식
+	//
+	// 반환값:
+	//   - *domain.ResultDiff: 추가/삭제/변경 row와 컬럼별 차이
+	//   - error: 검증 실패나 키 중복 시 domain.ErrInvalidDiff
+	DiffQueryResults(ctx context.Context, req *domain.ResultDiffRequest) (*domain.ResultDiff, error)
+
+	// ExplainQuery는 쿼리의 실행 계획을 공통 트리 형태로 반환합니다.
+	//
+	// 파라미터:
+	//   - dbID: string - 대상 DB ID
+	//   - query: string - 계획을 볼 SQL
+	//   - analyze: bool - 실제 실행 여부 (롤백되는 트랜잭션 안에서 실행)
+	//
+	// 반환값:
+	//   - *domain.QueryPlan: 계획 트리 (operation, object, cost, rows, children)
+	ExplainQuery(ctx context.Context, dbID string, query string, analyze bool) (*domain.QueryPlan, error)
+
+	// GetSchemas는 스키마 브라우저에서 볼 수 있는 스키마 목록을 반환합니다.
+	//
+	// 반환값:
+	//   - []string: 스키마 이름 (Oracle은 소유자 이름)
+	GetSchemas(ctx context.Context, dbID string) ([]string, error)
+
+	// GetTables는 스키마의 테이블, 뷰, 머티리얼라이즈드 뷰 목록을 반환합니다.
+	//
+	// 파라미터:
+	//   - schema: string - 스키마 이름 (빈 문자열이면 DB 설정의 Schema, 그것도 없으면 세션 기본값)
+	//
+	// 식별자 대소문자:
+	//   - 따옴표 없이 만든 이름은 DB 종류와 상관없이 소문자로 반환 (Oracle USERS → users)
+	//   - 따옴표가 필요한 이름("MixedCase")은 저장된 그대로 반환
+	//
+	// 반환값:
+	//   - []domain.TableInfo: 스키마, 이름, 종류(table/view/...)
+	GetTables(ctx context.Context, dbID string, schema string) ([]domain.TableInfo, error)
+
+	// GetColumns는 테이블(또는 뷰)의 컬럼 목록을 반환합니다.
+	//
+	// 파라미터:
+	//   - schema: string - 스키마 이름 (GetTables와 동일한 기본값 규칙)
+	//   - tableName: string - GetTables가 반환한 이름 (대소문자 규칙은 GetTables와 동일)
+	//
+	// 반환값:
+	//   - []domain.ColumnInfo: 컬럼 목록 (순서대로) - 타입/길이/정밀도, NULL 허용,
+	//     기본값, 주석, 기본 키 순서, identity(자동 증가) 정보 포함
+	//   - error: 스키마가 없으면 domain.ErrSchemaNotFound, 테이블이 없으면 domain.ErrTableNotFound
+	GetColumns(ctx context.Context, dbID string, schema string, tableName string) ([]domain.ColumnInfo, error)
+
+	// GetTableMetadata는 테이블의 인덱스, 제약조건, 외래 키를 반환합니다.
+	//
+	// 파라미터:
+	//   - schema, tableName: GetColumns와 같은 규칙
+	//
+	// 반환값:
+	//   - *domain.TableMetadata: 인덱스(컬럼, 유니크, 종류, 부분 인덱스 조건),
+	//     기본 키/유니크/체크 제약조건, 나가는 외래 키와 들어오는 외래 키
+	GetTableMetadata(ctx context.Context, dbID string, schema string, tableName string) (*domain.TableMetadata, error)
+
+	// ListObjects는 스키마의 뷰, 머티리얼라이즈드 뷰, 함수, 프로시저, 패키지, 트리거, 시퀀스 목록을 반환합니다.
+	//
+	// 파라미터:
+	//   - schema: string - 스키마 이름 (GetTables와 동일한 기본값 규칙)
+	//   - types: []domain.ObjectType - 조회할 종류 (비어 있으면 전체)
+	//
+	// 반환값:
+	//   - []domain.DatabaseObject: 이름, 종류, 상태(Oracle), 트리거 테이블, 함수 인자
+	ListObjects(ctx context.Context, dbID string, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error)
+
+	// GetObject는 객체 하나의 정의(소스)를 반환합니다.
+	//
+	// 파라미터:
+	//   - objectType: domain.ObjectType - 객체 종류
+	//   - name: string - ListObjects가 반환한 이름 (대소문자 규칙은 GetTables와 동일)
+	//     Postgres 함수는 "add_user(name text)"처럼 인자를 붙여 오버로딩을 고를 수 있습니다.
+	//
+	// 반환값:
+	//   - error: 종류가 잘못되면 domain.ErrInvalidObjectType, 객체가 없으면 domain.ErrObjectNotFound
+	GetObject(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error)
+
+	// GenerateDDL은 테이블, 뷰, 인덱스, 시퀀스 등을 다시 만드는 DDL(CREATE 문)을 반환합니다.
+	//
+	// 파라미터:
+	//   - objectType: domain.ObjectType - table, index, view, materialized_view, sequence,
+	//     function, procedure, package, trigger
+	//   - name: string - 객체 이름 (대소문자 규칙은 GetTables와 동일)
+	//   - dialect: domain.SQLDialect - 대상 방언 (비어 있으면 DB 자신의 방언)
+	//     다른 방언을 주면 마이그레이션 계획용으로 변환합니다 (테이블, 뷰만).
+	//
+	// 반환값:
+	//   - *domain.DDLResult: DDL 문과 변환 경고
+	//   - error: 객체가 없으면 domain.ErrObjectNotFound,
+	//     변환할 수 없는 종류면 domain.ErrDDLNotSupported
+	GenerateDDL(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string, dialect domain.SQLDialect) (*domain.DDLResult, error)
+
+	// DiffSchemas는 두 DB(스키마)의 테이블 구조를 비교합니다.
+	//
+	// 파라미터:
+	//   - source: domain.SchemaRef - 기준 (DatabaseID, Schema)
+	//   - target: domain.SchemaRef - 맞출 대상
+	//   - options: domain.SchemaDiffOptions - AllowDrop (지우는 DDL 포함 여부)
+	//
+	// 반환값:
+	//   - *domain.SchemaDiff: 없는/남는 테이블, 컬럼(타입, NULL 허용, 기본값),
+	//     인덱스/제약조건 차이, target을 source와 같게 만드는 DDL (같은 종류 DB끼리만)
+	DiffSchemas(ctx context.Context, source, target domain.SchemaRef, options domain.SchemaDiffOptions) (*domain.SchemaDiff, error)
+
+	// CompleteSQL은 SQL 에디터의 커서 위치에 맞는 자동완성 후보를 반환합니다.
+	//
+	// 파라미터:
+	//   - dbID: 데이터베이스 ID
+	//   - req: domain.CompletionRequest - SQL, 커서 위치(문자 단위), 기준 스키마, 최대 개수
+	//
+	// 반환값:
+	//   - *domain.CompletionResult: 문맥(table, column, qualified ...), 바꿀 구간, 후보 목록
+	//   - error: 커서가 SQL 밖이면 domain.ErrInvalidCursor
+	//
+	// 후보: 스키마, 테이블/뷰, 문장 안의 alias, 참조한 테이블의 컬럼, 함수, 방언별 키워드
+	CompleteSQL(ctx context.Context, dbID string, req domain.CompletionRequest) (*domain.CompletionResult, error)
+
+	// SearchSchema는 연결된 모든 DB(또는 지정한 DB)에서 테이블/컬럼 이름과 주석을 검색합니다.
+	//
+	// 파라미터:
+	//   - query: domain.SchemaSearchQuery - 패턴(부분 문자열 또는 정규식), 검색 항목, 대상 DB, 최대 개수
+	//
+	// 반환값:
+	//   - *domain.SchemaSearchResult: 결과 (DB, 스키마, 테이블, 컬럼, 타입)와 검색하지 못한 DB
+	//   - error: 패턴이 잘못되면 domain.ErrInvalidSearchPattern
+	//
+	// 메타데이터 인덱스는 캐시해서 쓰므로 검색할 때마다 DB를 조회하지 않습니다.
+	SearchSchema(ctx context.Context, query domain.SchemaSearchQuery) (*domain.SchemaSearchResult, error)
+
+	// GetTableStats는 테이블 하나의 크기/활동 통계를 반환합니다.
+	//
+	// 반환값:
+	//   - error: 테이블이 없으면 domain.ErrTableNotFound
+	GetTableStats(ctx context.Context, dbID string, schema string, table string) (*domain.TableStats, error)
+
+	// ListTableStats는 스키마의 모든 테이블 통계를 정렬해서 반환합니다 (용량 계획용).
+	//
+	// 파라미터:
+	//   - options: domain.TableStatsOptions - 정렬 기준(total_bytes, rows, dead_tuples 등), 방향, 개수
+	ListTableStats(ctx context.Context, dbID string, schema string, options domain.TableStatsOptions) ([]domain.TableStats, error)
+
+	// GenerateERDiagram은 외래 키 정보로 ER 다이어그램을 만듭니다.
+	//
+	// 파라미터:
+	//   - options: domain.ERDiagramOptions
+	//     Tables(비어 있으면 스키마 전체), Hops(외래 키를 따라갈 단계), Format(mermaid, dot)
+	//
+	// 반환값:
+	//   - *domain.ERDiagram: 다이어그램 텍스트와 포함된 테이블 목록
+	//   - error: 지정한 테이블이 없으면 domain.ErrTableNotFound
+	GenerateERDiagram(ctx context.Context, dbID string, schema string, options domain.ERDiagramOptions) (*domain.ERDiagram, error)
+
+	// GenerateDataDictionary는 감사 제출, 인수인계용 데이터 사전을 만듭니다.
+	// 캐시가 아니라 실제 DB를 다시 조회해서 만듭니다.
+	//
+	// 파라미터:
+	//   - options: domain.DataDictionaryOptions
+	//     Schemas(스키마 패턴, 비어 있으면 기본 스키마), Tables/Exclude(테이블 이름 패턴),
+	//     Types(테이블 종류, 비어 있으면 table만)
+	//
+	// 반환값:
+	//   - *domain.DataDictionary: 테이블 주석, 컬럼(타입, NULL 허용, 기본값, 주석), 키, 인덱스
+	//     (문서 파일은 domain.RenderDataDictionary로 만듭니다)
+	//   - error: 패턴이 잘못되면 domain.ErrInvalidSearchPattern
+	GenerateDataDictionary(ctx context.Context, dbID string, options domain.DataDictionaryOptions) (*domain.DataDictionary, error)
+
+	// SetComment는 테이블 또는 컬럼 주석을 바꾸고 변경 이력을 남깁니다.
+	//
+	// 파라미터:
+	//   - change: domain.CommentChange - 대상(Schema, Table, Column), 새 주석(빈 문자열이면 지움), Author
+	//
+	// 반환값:
+	//   - *domain.ChangeLogEntry: 저장된 변경 이력 (전/후 값, 실행한 SQL)
+	//   - error: Author가 없으면 domain.ErrAuthorRequired,
+	//     DB 쓰기 권한(comments)이 없으면 domain.ErrWriteNotAllowed,
+	//     컬럼이 없으면 domain.ErrColumnNotFound
+	SetComment(ctx context.Context, dbID string, change domain.CommentChange) (*domain.ChangeLogEntry, error)
+
+	// ListChangeLog는 DMS로 바꾼 내용의 변경 이력을 최신순으로 반환합니다.
+	//
+	// 파라미터:
+	//   - query: domain.ChangeLogQuery - DB, 스키마, 테이블, 작성자, 시각, 개수 조건
+	ListChangeLog(ctx context.Context, query domain.ChangeLogQuery) ([]domain.ChangeLogEntry, error)
+
+	// ProfileColumns는 테이블 또는 쿼리 결과의 컬럼별 데이터 프로파일을 만듭니다.
+	// 계산은 DB에서 집계 함수로 하고 row는 가져오지 않습니다.
+	//
+	// 파라미터:
+	//   - req: domain.ProfileRequest - 대상(Table 또는 Query), 컬럼, 표본 비율, 최빈값 개수 등
+	//
+	// 반환값:
+	//   - *domain.TableProfile: row 수, 컬럼별 NULL 비율, 고유값 개수, 최소/최대,
+	//     평균/표준편차(숫자), 길이 통계와 분포(문자열), 최빈값
+	//   - error: 요청이 잘못되면 domain.ErrInvalidProfile, 컬럼이 없으면 domain.ErrColumnNotFound
+	ProfileColumns(ctx context.Context, dbID string, req domain.ProfileRequest) (*domain.TableProfile, error)
+
+	// BrowseRows는 테이블 데이터 한 페이지를 필터/정렬해서 반환합니다 (SQL을 쓰지 않는 데이터 조회).
+	//
+	// 파라미터:
+	//   - query: domain.RowQuery - 컬럼, 필터(AND), 정렬(없으면 기본 키 순서), limit/offset
+	//
+	// 반환값:
+	//   - *domain.RowPage: row 목록, 다음 페이지 여부, 실행한 SQL (값은 바인드 변수)
+	//   - error: 필터/정렬이 잘못되면 domain.ErrInvalidRowQuery, 값이 컬럼 타입에 맞지 않으면
+	//     domain.ErrInvalidValue, 컬럼이 없으면 domain.ErrColumnNotFound
+	BrowseRows(ctx context.Context, dbID string, query domain.RowQuery) (*domain.RowPage, error)
+
+	// ChangeRow는 기본 키로 row 하나를 추가/수정/삭제하고 변경 이력을 남깁니다.
+	// 한 트랜잭션 안에서 실행하며, 정확히 1개 row가 바뀌지 않으면 롤백합니다.
+	//
+	// 파라미터:
+	//   - change: domain.RowChange - 작업(insert/update/delete), 기본 키, 값, 변경한 사람
+	//
+	// 반환값:
+	//   - *domain.RowChangeResult: 바꾸기 전/후 row, 실행한 SQL, 변경 이력 ID
+	//   - error: Author가 없으면 domain.ErrAuthorRequired,
+	//     DB 쓰기 권한(rows)이 없으면 domain.ErrWriteNotAllowed,
+	//     기본 키가 없는 테이블이면 domain.ErrNoPrimaryKey,
+	//     기본 키에 맞는 row가 없으면 domain.ErrRowNotFound,
+	//     여러 row가 바뀌려고 하면 domain.ErrUnexpectedRowCount (롤백됨)
+	ChangeRow(ctx context.Context, dbID string, change domain.RowChange) (*domain.RowChangeResult, error)
+
+	// ImportRows는 CSV/JSON Lines 데이터를 기존 테이블에 넣고 변경 이력을 남깁니다.
+	// 한 트랜잭션으로 실행하며 Postgres는 COPY FROM STDIN, Oracle은 배열 바인드로 묶음 단위로 넣습니다.
+	//
+	// 파라미터:
+	//   - req: domain.ImportRequest - 형식, 데이터, 먼저 비울지, upsert 키, 허용 오류 수, dry-run
+	//
+	// 반환값:
+	//   - *domain.ImportResult: 읽은/넣은/건너뛴 row 수, row별 오류, 실행한 SQL
+	//     (오류가 허용 수를 넘어 롤백했으면 Aborted에 이유)
+	//   - error: 요청이나 헤더가 잘못되면 domain.ErrInvalidImport, 헤더의 컬럼이 없으면
+	//     domain.ErrColumnNotFound, DB 쓰기 권한(rows)이 없으면 domain.ErrWriteNotAllowed
+	ImportRows(ctx context.Context, dbID string, req domain.ImportRequest) (*domain.ImportResult, error)
+
+	// StartCopyJob은 등록된 두 DB 사이에서 테이블 하나를 복사하는 작업을 시작합니다.
+	// 대상 테이블이 없으면 원본 구조를 대상 방언의 타입으로 바꿔서 만들고,
+	// 데이터는 백그라운드에서 기본 키(또는 지정한 유니크 키) 순서로 묶음 단위로 복사합니다 (묶음마다 커밋).
+	//
+	// 파라미터:
+	//   - req: domain.CopyRequest - 원본/대상 DB와 테이블, 대상을 먼저 비울지, 묶음 크기, 읽는 순서의 키
+	//
+	// 반환값:
+	//   - *domain.CopyJob: 시작한 작업 (ID, 대상 DDL, 변환 경고, 원본 row 수)
+	//   - error: 원본에 기본 키가 없고 키도 지정하지 않으면 domain.ErrNoPrimaryKey,
+	//     요청이 잘못되거나 데이터가 있는 대상에 truncate 없이 복사하면 domain.ErrInvalidCopy,
+	//     대상 DB 쓰기 권한(rows, 테이블을 만들면 tables)이 없으면 domain.ErrWriteNotAllowed
+	StartCopyJob(ctx context.Context, req domain.CopyRequest) (*domain.CopyJob, error)
+
+	// GetCopyJob은 복사 작업의 진행 상황(단계, 복사한 row 수, 검증 결과)을 반환합니다.
+	//
+	// 반환값:
+	//   - error: 작업이 없으면 domain.ErrCopyJobNotFound
+	GetCopyJob(ctx context.Context, id string) (*domain.CopyJob, error)
+
+	// ListCopyJobs는 모든 복사 작업을 최신순으로 반환합니다.
+	ListCopyJobs(ctx context.Context) ([]domain.CopyJob, error)
+
+	// ResumeCopyJob은 실패하거나 서버가 멈춰서 끊긴 작업을 마지막으로 커밋한 묶음 다음부터 이어서 실행합니다.
+	//
+	// 파라미터:
+	//   - author: string - 이어서 실행하는 사람 (대상 DB 쓰기 권한 검사)
+	//
+	// 반환값:
+	//   - error: 이미 실행 중이면 domain.ErrCopyJobRunning, 이미 끝났으면 domain.ErrInvalidCopy
+	ResumeCopyJob(ctx context.Context, id string, author string) (*domain.CopyJob, error)
+
+	// CaptureSnapshot은 스키마 스냅샷을 찍어서 이전 버전과 비교하고, 바뀌었으면 새 버전으로 저장합니다.
+	//
+	// 파라미터:
+	//   - schema: string - 스키마 (비어 있으면 DB 기본 스키마)
+	//
+	// 반환값:
+	//   - *domain.SnapshotResult: 저장 여부(Stored), 버전 정보, 이전 버전 대비 변경 로그
+	CaptureSnapshot(ctx context.Context, dbID string, schema string) (*domain.SnapshotResult, error)
+
+	// ListSnapshots는 저장된 스냅샷 버전 목록을 반환합니다 (최신순).
+	// schema가 비어 있으면 그 DB의 모든 스키마를 반환합니다.
+	ListSnapshots(ctx context.Context, dbID string, schema string) ([]domain.SnapshotVersion, error)
+
+	// GetSnapshot은 특정 버전의 스냅샷 전체(테이블, 컬럼, 인덱스, 제약조건)를 반환합니다.
+	//
+	// 반환값:
+	//   - error: 버전이 없거나 보관 한도로 지워졌으면 domain.ErrSnapshotNotFound
+	GetSnapshot(ctx context.Context, dbID string, schema string, version int) (*domain.SchemaSnapshot, error)
+
+	// ListSchemaChanges는 스냅샷 사이에서 발견한 구조 변경 이력을 반환합니다 (최신순).
+	//
+	// 파라미터:
+	//   - since: time.Time - 이 시각 이후의 변경만 (0이면 전체)
+	ListSchemaChanges(ctx context.Context, dbID string, schema string, since time.Time) ([]domain.SchemaChangeLog, error)
+
+	// InvalidateCache는 결과 캐시를 비웁니다.
+	//
+	// 파라미터:
+	//   - dbID: string - 비울 DB ID (빈 문자열이면 전체)
+	//
+	// 반환값:
+	//   - int: 지운 항목 수
+	InvalidateCache(ctx context.Context, dbID string) (int, error)
+
+	// CacheStats는 결과 캐시의 현재 상태(항목 수, 크기, 적중률)를 반환합니다.
+	CacheStats(ctx context.Context) domain.CacheStats
+
+	// RefreshMetadata는 DB의 메타데이터 캐시를 비우고 메타데이터 인덱스를 다시 읽습니다.
+	// 다른 도구로 스키마를 바꾼 뒤 TTL을 기다리지 않고 반영할 때 씁니다.
+	//
+	// 반환값:
+	//   - *domain.MetadataRefresh: 지운 항목 수, 새로 읽은 테이블/컬럼 수
+	RefreshMetadata(ctx context.Context, dbID string) (*domain.MetadataRefresh, error)
+
+	// InvalidateMetadata는 메타데이터 캐시를 비웁니다 (다시 읽지는 않음).
+	//
+	// 파라미터:
+	//   - dbID: string - 비울 DB ID (빈 문자열이면 전체)
+	//
+	// 반환값:
+	//   - int: 지운 항목 수
+	InvalidateMetadata(ctx context.Context, dbID string) (int, error)
+
+	// MetadataCacheStats는 메타데이터 캐시의 현재 상태(항목 수, 크기, 적중률)를 반환합니다.
+	MetadataCacheStats(ctx context.Context) domain.CacheStats
 }
 
 // Go 인터페이스 핵심 개념:
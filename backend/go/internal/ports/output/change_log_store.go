@@ -0,0 +1,22 @@
+package output
+
+import (
+	"context"
+
+	"space/internal/domain"
+)
+
+// ChangeLogStore는 DMS로 바꾼 내용(주석 편집 등)의 변경 이력 저장소 인터페이스입니다.
+// 감사 대응용이므로 이력은 추가만 하고 고치거나 지우지 않습니다.
+//
+// 구현 책임:
+//   - Append할 때 ID를 붙임 (저장소 안에서 고유)
+//   - 서버를 재시작해도 이력이 남아 있어야 함
+//   - 동시 접근에 안전해야 함
+type ChangeLogStore interface {
+	// Append는 이력 하나를 추가하고 붙인 ID를 entry.ID에 채웁니다.
+	Append(ctx context.Context, entry *domain.ChangeLogEntry) error
+
+	// List는 조건에 맞는 이력을 최신순으로 반환합니다 (최대 query.Limit개).
+	List(ctx context.Context, query domain.ChangeLogQuery) ([]domain.ChangeLogEntry, error)
+}
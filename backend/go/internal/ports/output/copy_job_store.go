@@ -0,0 +1,29 @@
+package output
+
+import (
+	"context"
+
+	"space/internal/domain"
+)
+
+// CopyJobStore는 테이블 복사 작업의 저장소 인터페이스입니다.
+// 작업은 묶음을 커밋할 때마다 저장하므로, 서버가 죽어도 마지막으로 커밋한 위치부터 이어서 복사할 수 있습니다.
+//
+// 구현 책임:
+//   - Create할 때 ID를 붙임 (저장소 안에서 고유)
+//   - 서버를 재시작해도 작업이 남아 있어야 함
+//   - 저장하는 도중에 죽어도 이전 상태가 깨지지 않아야 함
+//   - 동시 접근에 안전해야 함
+type CopyJobStore interface {
+	// Create는 새 작업을 저장하고 붙인 ID를 job.ID에 채웁니다.
+	Create(ctx context.Context, job *domain.CopyJob) error
+
+	// Save는 작업의 현재 상태로 덮어씁니다.
+	Save(ctx context.Context, job *domain.CopyJob) error
+
+	// Get은 작업 하나를 반환합니다. 없으면 domain.ErrCopyJobNotFound입니다.
+	Get(ctx context.Context, id string) (*domain.CopyJob, error)
+
+	// List는 모든 작업을 최신순으로 반환합니다.
+	List(ctx context.Context) ([]domain.CopyJob, error)
+}
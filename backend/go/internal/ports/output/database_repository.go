@@ -48,6 +48,7 @@ type DatabaseRepository interface {
 	// 파라미터:
 	//   - dbID: string - 대상 DB ID
 	//   - query: string - 실행할 SQL
+	//   - args: 바인드 변수 값 (Postgres는 $1, $2 ..., Oracle은 :1, :2 ... 순서대로)
 	//
 	// 반환값:
 	//   - *domain.QueryResult: 결과
@@ -58,7 +59,44 @@ type DatabaseRepository interface {
 	//   - conn.QueryContext() 실행
 	//   - 결과를 domain.QueryResult로 변환
 	//   - 실행 시간 측정
-	ExecuteQuery(ctx context.Context, dbID string, query string) (*domain.QueryResult, error)
+	ExecuteQuery(ctx context.Context, dbID string, query string, args ...interface{}) (*domain.QueryResult, error)
+
+	// ExecuteStatement는 결과 집합이 없는 문장(COMMENT ON 등)을 실행합니다.
+	// DMS가 직접 만든 쓰기 문장에만 쓰고, 사용자가 입력한 SQL은 ExecuteQuery로 실행합니다.
+	//
+	// 반환값:
+	//   - int64: 영향받은 row 수 (DDL은 0)
+	//   - error: 실행 실패 시
+	ExecuteStatement(ctx context.Context, dbID string, statement string) (int64, error)
+
+	// ExecuteTransaction은 문장들을 한 트랜잭션 안에서 순서대로 실행합니다.
+	//
+	// 파라미터:
+	//   - statements: []domain.TxStatement - SQL, 바인드 값, 결과 row를 읽을지, 예상 row 수
+	//
+	// 반환값:
+	//   - []domain.TxResult: 문장별 결과 (읽은 row, 영향받은 row 수)
+	//   - error: 예상 row 수와 다르면 *domain.RowCountError (전체 롤백)
+	//
+	// 구현 책임:
+	//   - 모두 성공하면 커밋, 하나라도 실패하면 롤백
+	ExecuteTransaction(ctx context.Context, dbID string, statements []domain.TxStatement) ([]domain.TxResult, error)
+
+	// ImportRows는 next가 돌려주는 row 묶음을 한 트랜잭션 안에서 테이블에 넣습니다.
+	//
+	// 파라미터:
+	//   - plan: *domain.ImportPlan - 대상 테이블, 컬럼, 먼저 비울지, 방언별 SQL
+	//   - next: 다음 row 묶음 (빈 묶음이면 끝)
+	//   - report: 넣은 row 수와 오류 row를 기록할 곳
+	//
+	// 반환값:
+	//   - error: 오류 row가 report.MaxErrors를 넘으면 domain.ErrTooManyImportErrors (전체 롤백)
+	//
+	// 구현 책임:
+	//   - Postgres는 COPY FROM STDIN, Oracle은 배열 바인드로 묶음을 한 번에 넣기
+	//   - 묶음이 실패하면 그 묶음만 되돌리고 row 하나씩 다시 넣어서 실패한 row를 report에 기록
+	//   - plan.DryRun이면 끝까지 실행한 뒤 롤백
+	ImportRows(ctx context.Context, dbID string, plan *domain.ImportPlan, next domain.ImportBatchFunc, report *domain.ImportReport) error
 
 	// IsConnected는 특정 DB가 연결되어 있는지 확인합니다.
 	//
@@ -73,32 +111,132 @@ type DatabaseRepository interface {
 	//   - 실제 Ping으로 연결 상태 확인
 	IsConnected(ctx context.Context, dbID string) bool
 
-	// GetTables는 특정 DB의 테이블 목록을 조회합니다.
+	// GetSchemas는 테이블 목록을 볼 수 있는 스키마 이름들을 조회합니다.
+	//
+	// 반환값:
+	//   - []string: 스키마 이름 목록 (카탈로그에 저장된 그대로)
+	//
+	// 구현 책임:
+	//   - Postgres: pg_namespace (USAGE 권한이 있는 스키마, 시스템 스키마 제외)
+	//   - Oracle: all_users (스키마 = 사용자)
+	GetSchemas(ctx context.Context, dbID string) ([]string, error)
+
+	// GetTables는 특정 DB의 테이블과 뷰 목록을 조회합니다.
 	//
 	// 파라미터:
 	//   - dbID: string - 대상 DB ID
+	//   - schema: string - 스키마 이름 (빈 문자열이면 세션의 현재 스키마)
 	//
 	// 반환값:
-	//   - []string: 테이블 이름 목록
+	//   - []domain.TableInfo: 테이블/뷰 목록 (이름은 카탈로그에 저장된 그대로)
 	//   - error: 조회 실패 시
 	//
 	// 구현 책임:
 	//   - DB 타입별로 다른 쿼리 실행
-	//   - Postgres: SELECT tablename FROM pg_tables WHERE schemaname='public'
-	//   - Oracle: SELECT table_name FROM user_tables
-	//   - MariaDB: SHOW TABLES
-	GetTables(ctx context.Context, dbID string) ([]string, error)
+	//   - Postgres: pg_class (relkind r, p, v, m, f), 기본값 current_schema()
+	//   - Oracle: all_tables + all_views + all_mviews (권한 있는 객체만 보임),
+	//     기본값 CURRENT_SCHEMA
+	//   - 종류를 domain.TableType으로 변환
+	GetTables(ctx context.Context, dbID string, schema string) ([]domain.TableInfo, error)
 
 	// GetColumns는 특정 테이블의 컬럼 정보를 조회합니다.
 	//
 	// 파라미터:
 	//   - dbID: string - 대상 DB ID
-	//   - tableName: string - 테이블 이름
+	//   - schema: string - 스키마 이름 (빈 문자열이면 세션의 현재 스키마)
+	//   - tableName: string - 테이블 이름 (카탈로그에 저장된 그대로, 대소문자 구분)
 	//
 	// 반환값:
-	//   - []string: 컬럼 이름 목록
+	//   - []domain.ColumnInfo: 컬럼 목록 (순서대로), 테이블이 없으면 빈 슬라이스
 	//   - error: 조회 실패 시
-	GetColumns(ctx context.Context, dbID string, tableName string) ([]string, error)
+	//
+	// 구현 책임:
+	//   - Postgres: pg_attribute, pg_attrdef, pg_constraint, col_description()
+	//   - Oracle: all_tab_columns, all_col_comments, all_cons_columns, all_tab_identity_cols
+	GetColumns(ctx context.Context, dbID string, schema string, tableName string) ([]domain.ColumnInfo, error)
+
+	// GetTableMetadata는 테이블의 인덱스, 제약조건, 외래 키를 조회합니다.
+	//
+	// 파라미터:
+	//   - schema: string - 스키마 이름 (빈 문자열이면 세션의 현재 스키마)
+	//   - tableName: string - 테이블 이름 (카탈로그에 저장된 그대로)
+	//
+	// 반환값:
+	//   - *domain.TableMetadata: 인덱스, 제약조건, 나가는/들어오는 외래 키
+	//
+	// 구현 책임:
+	//   - Postgres: pg_index, pg_constraint
+	//   - Oracle: all_indexes, all_ind_columns, all_constraints, all_cons_columns
+	GetTableMetadata(ctx context.Context, dbID string, schema string, tableName string) (*domain.TableMetadata, error)
+
+	// ListObjects는 스키마의 뷰, 함수, 프로시저, 패키지, 트리거, 시퀀스 목록을 조회합니다.
+	//
+	// 파라미터:
+	//   - schema: string - 스키마 이름 (빈 문자열이면 세션의 현재 스키마)
+	//   - types: []domain.ObjectType - 조회할 종류 (비어 있으면 전체)
+	//
+	// 반환값:
+	//   - []domain.DatabaseObject: 객체 목록 (Definition 없이 이름/종류/상태만)
+	ListObjects(ctx context.Context, dbID string, schema string, types []domain.ObjectType) ([]domain.DatabaseObject, error)
+
+	// GetObject는 객체 하나의 정의(소스)를 포함한 상세 정보를 조회합니다.
+	//
+	// 반환값:
+	//   - error: 객체가 없으면 domain.ErrObjectNotFound
+	//
+	// 구현 책임:
+	//   - Postgres: pg_get_viewdef, pg_get_functiondef, pg_get_triggerdef, pg_sequences
+	//   - Oracle: all_views.text, all_mviews.query, all_source, all_sequences
+	GetObject(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (*domain.DatabaseObject, error)
+
+	// GetDDL은 객체를 다시 만드는 DDL(CREATE 문)을 DB 자신의 방언으로 조회합니다.
+	//
+	// 파라미터:
+	//   - objectType: domain.ObjectType - table, index, view, sequence, function 등
+	//
+	// 반환값:
+	//   - error: 객체가 없으면 domain.ErrObjectNotFound
+	//
+	// 구현 책임:
+	//   - Oracle: DBMS_METADATA.GET_DDL (+ 인덱스, 주석)
+	//   - Postgres: 테이블은 카탈로그(컬럼, 기본값, 제약조건, 인덱스, 주석, 소유자)로 재구성,
+	//     나머지는 pg_get_viewdef, pg_get_indexdef, pg_get_functiondef 등
+	GetDDL(ctx context.Context, dbID string, schema string, objectType domain.ObjectType, name string) (string, error)
+
+	// GetCatalog는 접근할 수 있는 모든 스키마의 테이블/컬럼 이름, 타입, 주석을 한 번에 조회합니다.
+	//
+	// 구현 책임:
+	//   - 테이블마다 왕복하지 않도록 쿼리 한두 번으로 읽기
+	//   - 시스템 스키마(pg_catalog, SYS 등)는 제외
+	//   - 결과는 (스키마, 테이블) 순서, 컬럼은 컬럼 순서대로
+	//   - 세션의 현재 스키마(CurrentSchema)도 함께 반환
+	GetCatalog(ctx context.Context, dbID string) (*domain.DatabaseCatalog, error)
+
+	// GetTableStats는 테이블 크기/활동 통계를 조회합니다.
+	//
+	// 파라미터:
+	//   - tableName: string - 테이블 이름 (비어 있으면 스키마의 모든 테이블)
+	//
+	// 구현 책임:
+	//   - Postgres: pg_relation_size/pg_indexes_size, pg_stat_user_tables
+	//   - Oracle: all_tables.num_rows/last_analyzed, 세그먼트 크기 (dba_segments 또는 user_segments)
+	//   - 수집되지 않은 값은 nil로 남김 (0과 구분)
+	GetTableStats(ctx context.Context, dbID string, schema string, tableName string) ([]domain.TableStats, error)
+
+	// Explain은 쿼리의 실행 계획을 조회합니다.
+	//
+	// 파라미터:
+	//   - query: string - 계획을 볼 SQL
+	//   - analyze: bool - true면 실제로 실행해서 실측값(row 수, 시간)을 포함
+	//
+	// 반환값:
+	//   - *domain.QueryPlan: DB 종류와 상관없는 공통 계획 트리
+	//
+	// 구현 책임:
+	//   - Postgres: EXPLAIN (FORMAT JSON [, ANALYZE])
+	//   - Oracle: EXPLAIN PLAN FOR + PLAN_TABLE / DBMS_XPLAN
+	//   - ANALYZE는 반드시 롤백되는 트랜잭션 안에서 실행
+	Explain(ctx context.Context, dbID string, query string, analyze bool) (*domain.QueryPlan, error)
 
 	// ListConnections는 현재 관리 중인 모든 DB 연결 목록을 반환합니다.
 	//
@@ -0,0 +1,23 @@
+package output
+
+import (
+	"context"
+
+	"space/internal/domain"
+)
+
+// FederationEngine은 여러 DB에서 가져온 결과를 한곳에 적재하고
+// 그 위에서 최종 SQL을 실행하는 임베디드 엔진 인터페이스입니다.
+//
+// 구현 책임:
+//   - 요청마다 격리된 임시 저장소 생성 (예: in-memory SQLite)
+//   - 소스별 컬럼 타입을 최대한 보존해서 테이블 생성
+//   - limits를 넘으면 domain.ErrFederationLimitExceeded 반환
+//   - 실행이 끝나면 임시 저장소 정리
+type FederationEngine interface {
+	Execute(ctx context.Context, tables []domain.FederatedTable, query string, limits domain.FederationLimits) (*domain.QueryResult, error)
+
+	// Limits는 엔진에 설정된 상한을 반환합니다.
+	// 요청별 한도는 이 값을 넘을 수 없습니다.
+	Limits() domain.FederationLimits
+}
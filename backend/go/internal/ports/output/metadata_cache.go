@@ -0,0 +1,33 @@
+package output
+
+import (
+	"space/internal/domain"
+)
+
+// MetadataCache는 DB별 메타데이터(스키마/테이블/컬럼 목록, 테이블 메타데이터, DDL,
+// 메타데이터 인덱스 등) 캐시 인터페이스입니다.
+// 스키마 브라우저, 검색, 자동완성이 매번 DB 카탈로그를 조회하지 않게 합니다.
+//
+// 값은 Repository가 돌려준 원본 그대로 저장합니다 (interface{}).
+// 꺼낸 쪽은 값을 직접 고치면 안 됩니다 (복사해서 사용).
+//
+// 구현 책임:
+//   - 만료된 항목은 Get에서 반환하지 않음
+//   - 항목 수/전체 크기 한도를 넘으면 오래 안 쓴 항목부터 제거 (LRU)
+//   - 동시 접근에 안전해야 함
+type MetadataCache interface {
+	// Get은 키에 해당하는 값을 반환합니다. 없거나 만료되었으면 false.
+	Get(key domain.MetadataKey) (interface{}, bool)
+
+	// Set은 값을 저장합니다 (같은 키의 기존 항목은 교체). 크기 한도보다 큰 값은 저장하지 않습니다.
+	Set(key domain.MetadataKey, value interface{})
+
+	// InvalidateDatabase는 특정 DB의 모든 항목을 지우고 지운 개수를 반환합니다.
+	InvalidateDatabase(dbID string) int
+
+	// InvalidateAll은 모든 항목을 지우고 지운 개수를 반환합니다.
+	InvalidateAll() int
+
+	// Stats는 캐시 상태를 반환합니다.
+	Stats() domain.CacheStats
+}
@@ -0,0 +1,29 @@
+package output
+
+import (
+	"space/internal/domain"
+)
+
+// ResultCache는 쿼리 결과 캐시 인터페이스입니다.
+// Core(Service)와 Repository 사이에서 같은 SELECT의 반복 실행을 줄입니다.
+//
+// 구현 책임:
+//   - 만료된 항목은 Get에서 반환하지 않음
+//   - 항목 수/전체 크기 한도를 넘으면 오래 안 쓴 항목부터 제거 (LRU)
+//   - 동시 접근에 안전해야 함
+type ResultCache interface {
+	// Get은 키에 해당하는 항목을 반환합니다. 없거나 만료되었으면 false.
+	Get(key domain.CacheKey) (*domain.CacheEntry, bool)
+
+	// Set은 항목을 저장합니다. 크기 한도보다 큰 항목은 저장하지 않습니다.
+	Set(entry *domain.CacheEntry)
+
+	// InvalidateDatabase는 특정 DB의 모든 항목을 지우고 지운 개수를 반환합니다.
+	InvalidateDatabase(dbID string) int
+
+	// InvalidateAll은 모든 항목을 지우고 지운 개수를 반환합니다.
+	InvalidateAll() int
+
+	// Stats는 캐시 상태를 반환합니다.
+	Stats() domain.CacheStats
+}
@@ -0,0 +1,35 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"space/internal/domain"
+)
+
+// SnapshotStore는 스키마 스냅샷 이력 저장소 인터페이스입니다.
+// Core(Service)는 스냅샷을 찍고 비교만 하고, 어디에 어떻게 남기는지는 모릅니다.
+//
+// 구현 책임:
+//   - 버전은 (DB, 스키마)마다 1부터 증가
+//   - 저장한 스냅샷과 변경 로그는 서버를 재시작해도 남아 있어야 함
+//   - 동시 접근에 안전해야 함
+type SnapshotStore interface {
+	// Save는 스냅샷을 다음 버전으로 저장하고 저장된 버전 정보를 반환합니다.
+	// changes는 이전 버전과 비교한 변경 목록입니다 (첫 버전이면 nil).
+	Save(ctx context.Context, snapshot *domain.SchemaSnapshot, changes []domain.SchemaChange) (*domain.SnapshotVersion, error)
+
+	// Latest는 가장 최근 스냅샷을 반환합니다. 없으면 domain.ErrSnapshotNotFound.
+	Latest(ctx context.Context, dbID string, schema string) (*domain.SchemaSnapshot, *domain.SnapshotVersion, error)
+
+	// Get은 특정 버전의 스냅샷을 반환합니다. 없으면 domain.ErrSnapshotNotFound.
+	Get(ctx context.Context, dbID string, schema string, version int) (*domain.SchemaSnapshot, error)
+
+	// ListVersions는 저장된 버전 목록을 반환합니다 (최신 버전이 앞).
+	// schema가 비어 있으면 그 DB의 모든 스키마를 반환합니다.
+	ListVersions(ctx context.Context, dbID string, schema string) ([]domain.SnapshotVersion, error)
+
+	// ListChanges는 변경 로그를 반환합니다 (최신이 앞).
+	// since가 0이 아니면 그 시각 이후의 로그만 반환합니다.
+	ListChanges(ctx context.Context, dbID string, schema string, since time.Time) ([]domain.SchemaChangeLog, error)
+}